@@ -83,6 +83,42 @@ func PubKeyContext(next http.Handler) http.Handler {
 	})
 }
 
+// OptionalPubKeyContext adds the pubkey to the context when a valid token is
+// sent, but lets anonymous requests through
+func OptionalPubKeyContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("token")
+		if token == "" {
+			token = r.Header.Get("x-jwt")
+		}
+
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		isJwt := strings.Contains(token, ".") && !strings.HasPrefix(token, ".")
+
+		pubkey := ""
+		if isJwt {
+			claims, err := DecodeJwt(token)
+			if err == nil && !claims.VerifyExpiresAt(time.Now().UnixNano(), true) {
+				pubkey, _ = claims["pubkey"].(string)
+			}
+		} else {
+			pubkey, _ = VerifyTribeUUID(token, true)
+		}
+
+		if pubkey == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), ContextKey, pubkey)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 // PubKeyContext parses pukey from signed timestamp
 func PubKeyContextSuperAdmin(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -35,6 +35,7 @@ var AdminCheck string
 var AdminDevFreePass = "FREE_PASS"
 var Connection_Auth string
 var AdminStrings string
+var StrictWorkspaceTags bool
 
 var S3Client *s3.Client
 var PresignClient *s3.PresignClient
@@ -54,6 +55,7 @@ func InitConfig() {
 	S3Url = os.Getenv("S3_URL")
 	AdminCheck = os.Getenv("ADMIN_CHECK")
 	Connection_Auth = os.Getenv("CONNECTION_AUTH")
+	StrictWorkspaceTags = os.Getenv("STRICT_WORKSPACE_TAGS") == "true"
 
 	// Add to super admins
 	SuperAdmins = StripSuperAdmins(AdminStrings)
@@ -154,7 +154,7 @@ describe('Old Get Features for Workspace', () => {
             }).then((resp) => {
                 expect(resp.status).to.eq(200);
                 if (resp.status === 200) {
-                    resp.body.forEach((feature) => {
+                    resp.body.items.forEach((feature) => {
                         const expectedFeature = Features.find(f => f.uuid === feature.uuid);
                         expect(feature).to.have.property('name', expectedFeature.name.trim() + " _addtext");
                         expect(feature).to.have.property('brief', expectedFeature.brief.trim() + " _addtext");
@@ -101,8 +101,8 @@ describe('Get Bounties for Phase', () => {
                 console.log(resp.body);
                 const responseBody = typeof resp.body === 'string' ? JSON.parse(resp.body) : resp.body;
                 console.log(responseBody);
-                if (Array.isArray(responseBody)) {
-                    responseBody.forEach((bounty) => {
+                if (Array.isArray(responseBody.items)) {
+                    responseBody.items.forEach((bounty) => {
                         expect(bounty).to.have.property('bounty').to.have.property('phase_uuid');
                         expect(bounty).to.have.property('bounty').to.have.property('phase_priority');
                     });
@@ -71,6 +71,12 @@ func InitDB() {
 	db.AutoMigrate(&WorkspaceFeatures{})
 	db.AutoMigrate(&FeaturePhase{})
 	db.AutoMigrate(&FeatureStory{})
+	db.AutoMigrate(&FeatureDependency{})
+	db.AutoMigrate(&FeatureWatcher{})
+	db.AutoMigrate(&FeatureSnapshot{})
+	db.AutoMigrate(&WorkspaceInvite{})
+	db.AutoMigrate(&WorkspaceTag{})
+	db.AutoMigrate(&WorkspaceFeatureStatus{})
 
 	DB.MigrateTablesWithOrgUuid()
 	DB.MigrateOrganizationToWorkspace()
@@ -20,6 +20,7 @@ import (
 
 	"github.com/stakwork/sphinx-tribes/auth"
 	"github.com/stakwork/sphinx-tribes/utils"
+	"gorm.io/gorm"
 )
 
 // check that update owner_pub_key does in fact throw error
@@ -589,164 +590,166 @@ func (db database) GetFilterStatusCount() FilterStattuCount {
 }
 
 func (db database) GetWorkspaceBounties(r *http.Request, workspace_uuid string) []NewBounty {
-	keys := r.URL.Query()
-	tags := keys.Get("tags") // this is a string of tags separated by commas
-	offset, limit, sortBy, direction, search := utils.GetPaginationParams(r)
-	open := keys.Get("Open")
-	assingned := keys.Get("Assigned")
-	completed := keys.Get("Completed")
-	paid := keys.Get("Paid")
-	languages := keys.Get("languages")
-	languageArray := strings.Split(languages, ",")
-	languageLength := len(languageArray)
+	offset, limit, sortBy, direction, _ := utils.GetPaginationParams(r)
 
 	ms := []NewBounty{}
 
-	orderQuery := ""
-	limitQuery := ""
-	searchQuery := ""
-	languageQuery := ""
+	query := db.db.Model(&Bounty{}).Where("workspace_uuid = ?", workspace_uuid)
 
-	if sortBy != "" && direction != "" {
-		orderQuery = "ORDER BY " + sortBy + " " + direction
+	if IsValidBountySort(sortBy, direction) {
+		query = query.Order(sortBy + " " + strings.ToUpper(direction))
 	} else {
-		orderQuery = " ORDER BY " + sortBy + "" + "DESC"
+		query = query.Order("created DESC")
 	}
 	if limit > 0 {
-		limitQuery = fmt.Sprintf("LIMIT %d", limit)
+		query = query.Limit(limit)
 	}
 	if offset > 0 {
-		limitQuery += fmt.Sprintf(" OFFSET %d", offset)
-	}
-	if search != "" {
-		searchQuery = fmt.Sprintf("AND LOWER(title) LIKE %s", "'%"+strings.ToLower(search)+"%'")
+		query = query.Offset(offset)
 	}
 
-	var statusConditions []string
+	query = applyBountyFilters(query, r)
 
-	if open == "true" {
-		statusConditions = append(statusConditions, "assignee = '' AND paid != true")
-	}
-	if assingned == "true" {
-		statusConditions = append(statusConditions, "assignee != '' AND paid = false")
-	}
-	if completed == "true" {
-		statusConditions = append(statusConditions, "assignee != '' AND completed = true AND paid = false")
+	query.Find(&ms)
+
+	return ms
+}
+
+func (db database) GetWorkspaceBountiesCount(r *http.Request, workspace_uuid string) int64 {
+	query := db.db.Model(&Bounty{}).Where("workspace_uuid = ?", workspace_uuid)
+
+	query = applyBountyFilters(query, r)
+
+	var count int64
+
+	query.Count(&count)
+
+	return count
+}
+
+// GetWorkspaceBountiesByStatus returns a page of the workspace bounties in a
+// single status, newest first
+func (db database) GetWorkspaceBountiesByStatus(r *http.Request, workspace_uuid string, status string) []NewBounty {
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
+
+	ms := []NewBounty{}
+
+	query := db.db.Model(&Bounty{}).Where("workspace_uuid = ?", workspace_uuid).Where(bountyStatusConditions[status]).Order("created DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
 	}
-	if paid == "true" {
-		statusConditions = append(statusConditions, "paid = true")
+	if offset > 0 {
+		query = query.Offset(offset)
 	}
 
-	var statusQuery string
-	if len(statusConditions) > 0 {
-		statusQuery = " AND (" + strings.Join(statusConditions, " OR ") + ")"
-	} else {
-		statusQuery = ""
-	}
+	query.Find(&ms)
 
-	if languageLength > 0 {
-		langs := ""
-		for i, val := range languageArray {
-			if val != "" {
-				if i == 0 {
-					langs = "'" + val + "'"
-				} else {
-					langs = langs + ", '" + val + "'"
-				}
-				languageQuery = "AND coding_languages && ARRAY[" + langs + "]"
-			}
-		}
-	}
+	return ms
+}
 
-	query := `SELECT * FROM bounty WHERE workspace_uuid = '` + workspace_uuid + `'`
-	allQuery := query + " " + statusQuery + " " + searchQuery + " " + languageQuery + " " + orderQuery + " " + limitQuery
-	theQuery := db.db.Raw(allQuery)
+// GetWorkspacePendingBounties lists the workspace bounties held back for
+// approval, oldest first so they are reviewed in the order they came in
+func (db database) GetWorkspacePendingBounties(r *http.Request, workspace_uuid string) []NewBounty {
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
 
-	if tags != "" {
-		// pull out the tags and add them in here
-		t := strings.Split(tags, ",")
-		for _, s := range t {
-			theQuery = theQuery.Where("'" + s + "'" + " = any (tags)")
-		}
+	ms := []NewBounty{}
+
+	query := db.db.Model(&Bounty{}).Where("workspace_uuid = ? AND pending_approval = true AND show = false", workspace_uuid).Order("created ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
 	}
 
-	theQuery.Scan(&ms)
+	query.Find(&ms)
 
 	return ms
 }
 
-func (db database) GetWorkspaceBountiesCount(r *http.Request, workspace_uuid string) int64 {
-	keys := r.URL.Query()
-	tags := keys.Get("tags") // this is a string of tags separated by commas
-	search := keys.Get("search")
-	open := keys.Get("Open")
-	assingned := keys.Get("Assigned")
-	completed := keys.Get("Completed")
-	paid := keys.Get("Paid")
-	languages := keys.Get("languages")
-	languageArray := strings.Split(languages, ",")
-	languageLength := len(languageArray)
+func (db database) GetWorkspacePendingBountiesCount(workspace_uuid string) int64 {
+	var count int64
+	db.db.Model(&Bounty{}).Where("workspace_uuid = ? AND pending_approval = true AND show = false", workspace_uuid).Count(&count)
+	return count
+}
 
-	searchQuery := ""
-	languageQuery := ""
+// GetWorkspaceBountiesStatusTotals counts the workspace bounties in a status
+// and sums their price in sats
+func (db database) GetWorkspaceBountiesStatusTotals(workspace_uuid string, status string) (int64, uint) {
+	totals := struct {
+		Count int64
+		Sats  uint
+	}{}
 
-	if search != "" {
-		searchQuery = fmt.Sprintf("AND LOWER(title) LIKE %s", "'%"+strings.ToLower(search)+"%'")
-	}
+	db.db.Model(&Bounty{}).
+		Select("COUNT(*) AS count, COALESCE(SUM(price), 0) AS sats").
+		Where("workspace_uuid = ?", workspace_uuid).
+		Where(bountyStatusConditions[status]).
+		Scan(&totals)
 
-	var statusConditions []string
+	return totals.Count, totals.Sats
+}
 
-	if open == "true" {
-		statusConditions = append(statusConditions, "assignee = '' AND paid != true")
-	}
-	if assingned == "true" {
-		statusConditions = append(statusConditions, "assignee != '' AND paid = false")
-	}
-	if completed == "true" {
-		statusConditions = append(statusConditions, "assignee != '' AND completed = true AND paid = false")
-	}
-	if paid == "true" {
-		statusConditions = append(statusConditions, "paid = true")
-	}
+// GetWorkspaceBountiesCountByLanguage counts the workspace's open bounties
+// per coding language. A bounty with several languages counts once for each.
+func (db database) GetWorkspaceBountiesCountByLanguage(workspace_uuid string) map[string]int64 {
+	rows := []struct {
+		Language string
+		Count    int64
+	}{}
 
-	var statusQuery string
-	if len(statusConditions) > 0 {
-		statusQuery = " AND (" + strings.Join(statusConditions, " OR ") + ")"
-	} else {
-		statusQuery = ""
+	db.db.Raw(`SELECT language, COUNT(*) AS count
+		FROM public.bounty, unnest(coding_languages) AS language
+		WHERE workspace_uuid = ? AND `+bountyStatusConditions["open"]+`
+		GROUP BY language`, workspace_uuid).Scan(&rows)
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Language] = row.Count
 	}
 
-	if languageLength > 0 {
-		langs := ""
-		for i, val := range languageArray {
-			if val != "" {
-				if i == 0 {
-					langs = "'" + val + "'"
-				} else {
-					langs = langs + ", '" + val + "'"
-				}
-				languageQuery = "AND coding_languages && ARRAY[" + langs + "]"
-			}
-		}
+	return counts
+}
+
+// SearchWorkspacesBounties matches the search param against the title and
+// description of bounties in the given workspaces. The status, language and
+// tag params filter as they do for a single workspace. It returns a page of
+// bounties, newest first, and the total number of matches.
+func (db database) SearchWorkspacesBounties(r *http.Request, workspace_uuids []string) ([]NewBounty, int64) {
+	ms := []NewBounty{}
+	if len(workspace_uuids) == 0 {
+		return ms, 0
 	}
 
-	var count int64
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
 
-	query := `SELECT COUNT(*) FROM bounty WHERE workspace_uuid = '` + workspace_uuid + `'`
-	allQuery := query + " " + statusQuery + " " + searchQuery + " " + languageQuery
-	theQuery := db.db.Raw(allQuery)
+	// applyBountyFilters only searches titles, so the search is applied here
+	keys := r.URL.Query()
+	search := strings.ToLower(strings.TrimSpace(keys.Get("search")))
+	keys.Del("search")
+	filterRequest := r.Clone(r.Context())
+	filterRequest.URL.RawQuery = keys.Encode()
 
-	if tags != "" {
-		// pull out the tags and add them in here
-		t := strings.Split(tags, ",")
-		for _, s := range t {
-			theQuery = theQuery.Where("'" + s + "'" + " = any (tags)")
-		}
+	query := db.db.Model(&Bounty{}).Where("workspace_uuid IN ?", workspace_uuids)
+	if search != "" {
+		pattern := "%" + search + "%"
+		query = query.Where("LOWER(title) LIKE ? OR LOWER(description) LIKE ?", pattern, pattern)
 	}
+	query = applyBountyFilters(query, filterRequest)
 
-	theQuery.Scan(&count)
+	var total int64
+	query.Session(&gorm.Session{}).Count(&total)
 
-	return count
+	query = query.Order("created DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	query.Find(&ms)
+
+	return ms, total
 }
 
 func (db database) GetAssignedBounties(r *http.Request) ([]NewBounty, error) {
@@ -1249,6 +1252,26 @@ func (db database) CreateOrEditBounty(b NewBounty) (NewBounty, error) {
 	return b, nil
 }
 
+// ApproveBounty publishes a bounty that was held for approval
+func (db database) ApproveBounty(id uint) (NewBounty, error) {
+	now := time.Now()
+	result := db.db.Model(&NewBounty{}).
+		Where("id = ? AND pending_approval = true", id).
+		Updates(map[string]interface{}{
+			"show":             true,
+			"pending_approval": false,
+			"updated":          &now,
+		})
+	if result.Error != nil {
+		return NewBounty{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return NewBounty{}, errors.New("no bounty pending approval")
+	}
+
+	return db.GetBounty(id), nil
+}
+
 func (db database) UpdateBountyNullColumn(b NewBounty, column string) NewBounty {
 	columnMap := make(map[string]interface{})
 	columnMap[column] = ""
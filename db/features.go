@@ -3,26 +3,133 @@ package db
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/stakwork/sphinx-tribes/utils"
+	"gorm.io/gorm"
 )
 
+// toUTC returns a copy of t in UTC so feature timestamps don't depend on
+// the server or database session time zone
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func (f *WorkspaceFeatures) AfterFind(tx *gorm.DB) error {
+	f.Created = toUTC(f.Created)
+	f.Updated = toUTC(f.Updated)
+	return nil
+}
+
+func (p *FeaturePhase) AfterFind(tx *gorm.DB) error {
+	p.Created = toUTC(p.Created)
+	p.Updated = toUTC(p.Updated)
+	return nil
+}
+
+func (s *FeatureStory) AfterFind(tx *gorm.DB) error {
+	s.Created = toUTC(s.Created)
+	s.Updated = toUTC(s.Updated)
+	return nil
+}
+
+// featureSortColumns lists the columns a workspace's features can be sorted by
+var featureSortColumns = map[string]bool{
+	"priority": true,
+	"name":     true,
+	"created":  true,
+	"updated":  true,
+}
+
+// IsValidFeatureSort reports whether sortBy and direction are accepted by
+// GetFeaturesByWorkspaceUuid; empty values fall back to the default order
+func IsValidFeatureSort(sortBy string, direction string) bool {
+	if sortBy != "" && !featureSortColumns[sortBy] {
+		return false
+	}
+	direction = strings.ToLower(direction)
+	return direction == "" || direction == "asc" || direction == "desc"
+}
+
+// workspaceFeatureFilters builds the search, created_by, owner and tags conditions
+// shared by the workspace feature list and its count
+func workspaceFeatureFilters(r *http.Request) (string, []interface{}) {
+	_, _, _, _, search := utils.GetPaginationParams(r)
+	createdBy := r.URL.Query().Get("created_by")
+	owner := r.URL.Query().Get("owner")
+	tags := r.URL.Query().Get("tags")
+	dueBefore := r.URL.Query().Get("due_before")
+
+	filterQuery := ""
+	filterArgs := []interface{}{}
+
+	if search != "" {
+		filterQuery += " AND LOWER(name) LIKE ?"
+		filterArgs = append(filterArgs, "%"+strings.ToLower(search)+"%")
+	}
+
+	if createdBy != "" {
+		filterQuery += " AND created_by = ?"
+		filterArgs = append(filterArgs, createdBy)
+	}
+
+	if owner != "" {
+		filterQuery += " AND owner_pub_key = ?"
+		filterArgs = append(filterArgs, owner)
+	}
+
+	if tags != "" {
+		filterQuery += " AND tags && ?"
+		filterArgs = append(filterArgs, pq.StringArray(strings.Split(tags, ",")))
+	}
+
+	if due, err := ParseFeatureDate(dueBefore); err == nil {
+		filterQuery += " AND target_date < ?"
+		filterArgs = append(filterArgs, due)
+	}
+
+	return filterQuery, filterArgs
+}
+
+// ParseFeatureDate parses a date param given either as 2006-01-02, meaning
+// midnight UTC, or as a full RFC 3339 timestamp
+func ParseFeatureDate(value string) (time.Time, error) {
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
 func (db database) GetFeaturesByWorkspaceUuid(uuid string, r *http.Request) []WorkspaceFeatures {
-	offset, limit, sortBy, direction, _ := utils.GetPaginationParams(r)
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
+	sortBy := r.URL.Query().Get("sortBy")
+	direction := strings.ToLower(r.URL.Query().Get("direction"))
 
 	orderQuery := ""
 	limitQuery := ""
+	filterQuery, filterArgs := workspaceFeatureFilters(r)
 
 	ms := []WorkspaceFeatures{}
 
-	if sortBy != "" && direction != "" {
-		orderQuery = "ORDER BY " + sortBy + " " + direction
-	} else {
-		orderQuery = "ORDER BY priority ASC"
+	if !IsValidFeatureSort(sortBy, direction) || sortBy == "" {
+		sortBy = "priority"
+	}
+	if direction != "desc" {
+		direction = "asc"
 	}
+	// pinned features stay on top whatever the sort
+	orderQuery = "ORDER BY pinned DESC, " + sortBy + " " + strings.ToUpper(direction) + ", id ASC"
 
 	if limit > 1 {
 		limitQuery = fmt.Sprintf("LIMIT %d  OFFSET %d", limit, offset)
@@ -30,21 +137,86 @@ func (db database) GetFeaturesByWorkspaceUuid(uuid string, r *http.Request) []Wo
 
 	query := `SELECT * FROM public.workspace_features WHERE workspace_uuid = '` + uuid + `'`
 
-	allQuery := query + " " + orderQuery + " " + limitQuery
+	allQuery := query + filterQuery + " " + orderQuery + " " + limitQuery
 
-	theQuery := db.db.Raw(allQuery)
+	theQuery := db.db.Raw(allQuery, filterArgs...)
 
 	theQuery.Scan(&ms)
 
+	// Raw scans skip the AfterFind hook
+	for i := range ms {
+		ms[i].AfterFind(nil)
+	}
+
 	return ms
 }
 
+// GetFeaturesCountByWorkspaceUuid counts the features matching the same
+// filters as GetFeaturesByWorkspaceUuid, ignoring pagination
+func (db database) GetFeaturesCountByWorkspaceUuid(uuid string, r *http.Request) int64 {
+	filterQuery, filterArgs := workspaceFeatureFilters(r)
+
+	var count int64
+
+	args := append([]interface{}{uuid}, filterArgs...)
+	db.db.Raw(`SELECT COUNT(*) FROM public.workspace_features WHERE workspace_uuid = ?`+filterQuery, args...).Scan(&count)
+
+	return count
+}
+
+// GetFeaturesLastUpdated returns the latest update time among the features
+// matching the GetFeaturesByWorkspaceUuid filters, or the zero time when
+// there are none
+func (db database) GetFeaturesLastUpdated(uuid string, r *http.Request) time.Time {
+	filterQuery, filterArgs := workspaceFeatureFilters(r)
+
+	var lastUpdated *time.Time
+
+	args := append([]interface{}{uuid}, filterArgs...)
+	db.db.Raw(`SELECT MAX(COALESCE(updated, created)) FROM public.workspace_features WHERE workspace_uuid = ?`+filterQuery, args...).Scan(&lastUpdated)
+
+	if lastUpdated == nil {
+		return time.Time{}
+	}
+	return lastUpdated.UTC()
+}
+
 func (db database) GetWorkspaceFeaturesCount(uuid string) int64 {
+	if count, err := Store.GetFeaturesCountCache(uuid); err == nil {
+		return count
+	}
+
 	var count int64
 	db.db.Model(&WorkspaceFeatures{}).Where("workspace_uuid = ?", uuid).Count(&count)
+	Store.SetFeaturesCountCache(uuid, count)
 	return count
 }
 
+// GetWorkspacesFeaturesCount counts the features of several workspaces in one
+// query. Workspaces without features are left out of the map.
+func (db database) GetWorkspacesFeaturesCount(uuids []string) map[string]int64 {
+	counts := make(map[string]int64, len(uuids))
+	if len(uuids) == 0 {
+		return counts
+	}
+
+	rows := []struct {
+		WorkspaceUuid string
+		Count         int64
+	}{}
+	db.db.Model(&WorkspaceFeatures{}).
+		Select("workspace_uuid, COUNT(*) AS count").
+		Where("workspace_uuid IN ?", uuids).
+		Group("workspace_uuid").
+		Scan(&rows)
+
+	for _, row := range rows {
+		counts[row.WorkspaceUuid] = row.Count
+	}
+
+	return counts
+}
+
 func (db database) GetFeatureByUuid(uuid string) WorkspaceFeatures {
 	ms := WorkspaceFeatures{}
 
@@ -53,12 +225,35 @@ func (db database) GetFeatureByUuid(uuid string) WorkspaceFeatures {
 	return ms
 }
 
+// GetWorkspaceStoryCounts counts the stories of every feature in the
+// workspace in one query. Features without stories are left out of the map.
+func (db database) GetWorkspaceStoryCounts(workspaceUuid string) map[string]int64 {
+	counts := map[string]int64{}
+
+	rows := []struct {
+		FeatureUuid string
+		Count       int64
+	}{}
+	db.db.Model(&FeatureStory{}).
+		Select("feature_stories.feature_uuid, COUNT(*) AS count").
+		Joins("INNER JOIN workspace_features ON workspace_features.uuid = feature_stories.feature_uuid").
+		Where("workspace_features.workspace_uuid = ?", workspaceUuid).
+		Group("feature_stories.feature_uuid").
+		Scan(&rows)
+
+	for _, row := range rows {
+		counts[row.FeatureUuid] = row.Count
+	}
+
+	return counts
+}
+
 func (db database) CreateOrEditFeature(m WorkspaceFeatures) (WorkspaceFeatures, error) {
 	m.Name = strings.TrimSpace(m.Name)
 	m.Brief = strings.TrimSpace(m.Brief)
 	m.Requirements = strings.TrimSpace(m.Requirements)
 	m.Architecture = strings.TrimSpace(m.Architecture)
-	now := time.Now()
+	now := time.Now().UTC()
 	m.Updated = &now
 
 	var existing WorkspaceFeatures
@@ -68,18 +263,58 @@ func (db database) CreateOrEditFeature(m WorkspaceFeatures) (WorkspaceFeatures,
 		db.db.Create(&m)
 	} else {
 		db.db.Model(&WorkspaceFeatures{}).Where("uuid = ?", m.Uuid).Updates(m)
+		Store.DeleteFeaturesCountCache(existing.WorkspaceUuid)
 	}
+	Store.DeleteFeaturesCountCache(m.WorkspaceUuid)
 
 	db.db.Model(&WorkspaceFeatures{}).Where("uuid = ?", m.Uuid).First(&m)
 	return m, nil
 }
 
+func (db database) UpdateFeatureStatus(uuid string, status FeatureStatus) (WorkspaceFeatures, error) {
+	result := db.db.Model(&WorkspaceFeatures{}).
+		Where("uuid = ?", uuid).
+		Updates(map[string]interface{}{
+			"feat_status": status,
+			"updated":     time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return WorkspaceFeatures{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return WorkspaceFeatures{}, errors.New("no feature found")
+	}
+
+	return db.GetFeatureByUuid(uuid), nil
+}
+
+// SetFeaturePinned pins or unpins a feature. CreateOrEditFeature skips zero
+// values on update, so unpinning has to go through here.
+func (db database) SetFeaturePinned(uuid string, pinned bool) (WorkspaceFeatures, error) {
+	result := db.db.Model(&WorkspaceFeatures{}).
+		Where("uuid = ?", uuid).
+		Updates(map[string]interface{}{
+			"pinned":  pinned,
+			"updated": time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return WorkspaceFeatures{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return WorkspaceFeatures{}, errors.New("no feature found")
+	}
+
+	return db.GetFeatureByUuid(uuid), nil
+}
+
 func (db database) DeleteFeatureByUuid(uuid string) error {
+	feature := db.GetFeatureByUuid(uuid)
 	result := db.db.Where("uuid = ?", uuid).Delete(&WorkspaceFeatures{})
 
 	if result.RowsAffected == 0 {
 		return errors.New("no feature found to delete")
 	}
+	Store.DeleteFeaturesCountCache(feature.WorkspaceUuid)
 	return nil
 
 }
@@ -87,7 +322,7 @@ func (db database) DeleteFeatureByUuid(uuid string) error {
 func (db database) CreateOrEditFeaturePhase(phase FeaturePhase) (FeaturePhase, error) {
 	phase.Name = strings.TrimSpace(phase.Name)
 
-	now := time.Now()
+	now := time.Now().UTC()
 	phase.Updated = &now
 
 	existingPhase := FeaturePhase{}
@@ -100,6 +335,8 @@ func (db database) CreateOrEditFeaturePhase(phase FeaturePhase) (FeaturePhase, e
 	} else {
 
 		db.db.Model(&FeaturePhase{}).Where("uuid = ?", phase.Uuid).Updates(phase)
+		// Updates skips zero values, so auto complete could never be turned off
+		db.db.Model(&FeaturePhase{}).Where("uuid = ?", phase.Uuid).Update("auto_complete", phase.AutoComplete)
 	}
 
 	db.db.Model(&FeaturePhase{}).Where("uuid = ?", phase.Uuid).Find(&phase)
@@ -113,6 +350,42 @@ func (db database) GetPhasesByFeatureUuid(featureUuid string) []FeaturePhase {
 	return phases
 }
 
+// GetWorkspacePhases lists the phases of every feature in the workspace,
+// grouped by feature in board order and by priority within a feature
+func (db database) GetWorkspacePhases(workspaceUuid string, r *http.Request) []WorkspacePhase {
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
+
+	phases := []WorkspacePhase{}
+
+	query := db.db.Table("feature_phases").
+		Select("feature_phases.*, workspace_features.name AS feature_name").
+		Joins("INNER JOIN workspace_features ON workspace_features.uuid = feature_phases.feature_uuid").
+		Where("workspace_features.workspace_uuid = ?", workspaceUuid).
+		Order("workspace_features.priority ASC, workspace_features.id ASC, feature_phases.priority ASC, feature_phases.created ASC")
+
+	if limit > 1 {
+		query = query.Limit(limit).Offset(offset)
+	}
+
+	query.Scan(&phases)
+
+	// Table scans skip the AfterFind hook
+	for i := range phases {
+		phases[i].AfterFind(nil)
+	}
+
+	return phases
+}
+
+func (db database) GetWorkspacePhasesCount(workspaceUuid string) int64 {
+	var count int64
+	db.db.Table("feature_phases").
+		Joins("INNER JOIN workspace_features ON workspace_features.uuid = feature_phases.feature_uuid").
+		Where("workspace_features.workspace_uuid = ?", workspaceUuid).
+		Count(&count)
+	return count
+}
+
 func (db database) GetFeaturePhaseByUuid(featureUuid, phaseUuid string) (FeaturePhase, error) {
 	phase := FeaturePhase{}
 	result := db.db.Model(&FeaturePhase{}).Where("feature_uuid = ? AND uuid = ?", featureUuid, phaseUuid).First(&phase)
@@ -122,6 +395,23 @@ func (db database) GetFeaturePhaseByUuid(featureUuid, phaseUuid string) (Feature
 	return phase, nil
 }
 
+func (db database) UpdateFeaturePhaseStatus(featureUuid string, phaseUuid string, status PhaseStatus) (FeaturePhase, error) {
+	result := db.db.Model(&FeaturePhase{}).
+		Where("feature_uuid = ? AND uuid = ?", featureUuid, phaseUuid).
+		Updates(map[string]interface{}{
+			"phase_status": status,
+			"updated":      time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return FeaturePhase{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return FeaturePhase{}, errors.New("no phase found")
+	}
+
+	return db.GetFeaturePhaseByUuid(featureUuid, phaseUuid)
+}
+
 func (db database) DeleteFeaturePhase(featureUuid, phaseUuid string) error {
 	result := db.db.Where("feature_uuid = ? AND uuid = ?", featureUuid, phaseUuid).Delete(&FeaturePhase{})
 	if result.RowsAffected == 0 {
@@ -133,7 +423,7 @@ func (db database) DeleteFeaturePhase(featureUuid, phaseUuid string) error {
 func (db database) CreateOrEditFeatureStory(story FeatureStory) (FeatureStory, error) {
 	story.Description = strings.TrimSpace(story.Description)
 
-	now := time.Now()
+	now := time.Now().UTC()
 	story.Updated = &now
 
 	existingStory := FeatureStory{}
@@ -153,7 +443,10 @@ func (db database) CreateOrEditFeatureStory(story FeatureStory) (FeatureStory, e
 
 func (db database) GetFeatureStoriesByFeatureUuid(featureUuid string) ([]FeatureStory, error) {
 	var stories []FeatureStory
-	result := db.db.Where("feature_uuid = ?", featureUuid).Order("priority ASC").Find(&stories)
+	// stories saved before Order existed have none and sort after ordered ones
+	result := db.db.Where("feature_uuid = ?", featureUuid).
+		Order(`COALESCE("order", 2147483647) ASC, priority ASC, created ASC`).
+		Find(&stories)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -181,16 +474,142 @@ func (db database) DeleteFeatureStoryByUuid(featureUuid, storyUuid string) error
 	return nil
 }
 
+// DeleteFeatureStories deletes each story of the feature in one transaction,
+// reporting the uuids that did not match a story of that feature
+func (db database) DeleteFeatureStories(featureUuid string, storyUuids []string) (FeatureStoriesDeletion, error) {
+	deletion := FeatureStoriesDeletion{NotFound: []string{}}
+
+	err := db.db.Transaction(func(tx *gorm.DB) error {
+		for _, storyUuid := range storyUuids {
+			result := tx.Where("feature_uuid = ? AND uuid = ?", featureUuid, storyUuid).Delete(&FeatureStory{})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				deletion.NotFound = append(deletion.NotFound, storyUuid)
+				continue
+			}
+			deletion.Removed += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return FeatureStoriesDeletion{}, err
+	}
+
+	return deletion, nil
+}
+
+func (db database) MoveFeatureStory(featureUuid, storyUuid, targetFeatureUuid string) (FeatureStory, error) {
+	now := time.Now().UTC()
+	result := db.db.Model(&FeatureStory{}).
+		Where("feature_uuid = ? AND uuid = ?", featureUuid, storyUuid).
+		Updates(map[string]interface{}{
+			"feature_uuid": targetFeatureUuid,
+			"updated":      &now,
+		})
+	if result.Error != nil {
+		return FeatureStory{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return FeatureStory{}, errors.New("no story found to move")
+	}
+
+	return db.GetFeatureStoryByUuid(targetFeatureUuid, storyUuid)
+}
+
+// MoveFeatureToWorkspace moves a feature and the bounties on its phases to
+// another workspace in one transaction. Phases and stories only reference the
+// feature so they follow it as is. Dependencies have to stay within a
+// workspace, so the ones involving the feature are removed.
+func (db database) MoveFeatureToWorkspace(featureUuid, targetWorkspaceUuid string) (WorkspaceFeatures, error) {
+	now := time.Now().UTC()
+	sourceWorkspaceUuid := db.GetFeatureByUuid(featureUuid).WorkspaceUuid
+
+	err := db.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&WorkspaceFeatures{}).
+			Where("uuid = ?", featureUuid).
+			Updates(map[string]interface{}{
+				"workspace_uuid": targetWorkspaceUuid,
+				"updated":        &now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("no feature found to move")
+		}
+
+		phaseUuids := tx.Model(&FeaturePhase{}).Select("uuid").Where("feature_uuid = ?", featureUuid)
+		if err := tx.Model(&Bounty{}).
+			Where("phase_uuid IN (?)", phaseUuids).
+			Update("workspace_uuid", targetWorkspaceUuid).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("feature_uuid = ? OR depends_on_uuid = ?", featureUuid, featureUuid).
+			Delete(&FeatureDependency{}).Error
+	})
+	if err != nil {
+		return WorkspaceFeatures{}, err
+	}
+	Store.DeleteFeaturesCountCache(sourceWorkspaceUuid)
+	Store.DeleteFeaturesCountCache(targetWorkspaceUuid)
+
+	return db.GetFeatureByUuid(featureUuid), nil
+}
+
+// AssignPhaseBounties assigns the open bounties of the phase to assignee in
+// one transaction, bounties outside the phase or already taken are skipped
+func (db database) AssignPhaseBounties(phaseUuid string, bountyIds []uint, assignee string) ([]PhaseBountyAssignment, error) {
+	now := time.Now().UTC()
+	results := make([]PhaseBountyAssignment, 0, len(bountyIds))
+
+	err := db.db.Transaction(func(tx *gorm.DB) error {
+		for _, id := range bountyIds {
+			result := PhaseBountyAssignment{BountyID: id}
+
+			var bounty NewBounty
+			if err := tx.Model(&Bounty{}).Where("id = ? AND phase_uuid = ?", id, phaseUuid).First(&bounty).Error; err != nil {
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					return err
+				}
+				result.Error = "bounty is not in this phase"
+				results = append(results, result)
+				continue
+			}
+
+			// the open condition is repeated in the update so a bounty taken
+			// since the read above is not overwritten
+			update := tx.Model(&Bounty{}).
+				Where("id = ? AND "+bountyStatusConditions["open"], id).
+				Updates(map[string]interface{}{
+					"assignee":      assignee,
+					"assigned_date": &now,
+					"updated":       &now,
+				})
+			if update.Error != nil {
+				return update.Error
+			}
+
+			if update.RowsAffected == 0 {
+				result.Error = "bounty is not open"
+			} else {
+				result.Assigned = true
+			}
+			results = append(results, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 func (db database) GetBountiesByFeatureAndPhaseUuid(featureUuid string, phaseUuid string, r *http.Request) ([]NewBounty, error) {
-	keys := r.URL.Query()
-	tags := keys.Get("tags")
-	offset, limit, sortBy, direction, search := utils.GetPaginationParams(r)
-	open := keys.Get("Open")
-	assigned := keys.Get("Assigned")
-	completed := keys.Get("Completed")
-	paid := keys.Get("Paid")
-	languages := keys.Get("languages")
-	languageArray := strings.Split(languages, ",")
+	offset, limit, sortBy, direction, _ := utils.GetPaginationParams(r)
 
 	var bounties []NewBounty
 
@@ -206,111 +625,226 @@ func (db database) GetBountiesByFeatureAndPhaseUuid(featureUuid string, phaseUui
 	}
 
 	// Add sorting if applicable
-	if sortBy != "" && direction != "" {
-		query = query.Order(fmt.Sprintf("%s %s", sortBy, direction))
+	if IsValidBountySort(sortBy, direction) {
+		query = query.Order("bounty." + sortBy + " " + strings.ToUpper(direction))
 	} else {
-		query = query.Order("created_at DESC")
+		query = query.Order("bounty.created DESC")
 	}
 
-	// Add search filter
-	if search != "" {
-		searchQuery := fmt.Sprintf("LOWER(title) LIKE %s", "'%"+strings.ToLower(search)+"%'")
-		query = query.Where(searchQuery)
-	}
+	query = applyBountyFilters(query, r)
 
-	// Add language filter
-	if len(languageArray) > 0 {
-		langs := ""
-		for i, val := range languageArray {
-			if val != "" {
-				if i == 0 {
-					langs = "'" + val + "'"
-				} else {
-					langs = langs + ", '" + val + "'"
-				}
-				query = query.Where("coding_languages && ARRAY[" + langs + "]")
-			}
-		}
+	// Execute the query
+	result := query.Find(&bounties)
+
+	if result.RowsAffected == 0 {
+		return bounties, errors.New("no bounty found")
 	}
 
-	// Add status filters
-	var statusConditions []string
+	return bounties, nil
+}
 
-	if open == "true" {
-		statusConditions = append(statusConditions, "assignee = '' AND paid != true AND completed != true")
-	}
-	if assigned == "true" {
-		statusConditions = append(statusConditions, "assignee != '' AND paid = false AND completed = false")
+func (db database) GetBountiesCountByFeatureAndPhaseUuid(featureUuid string, phaseUuid string, r *http.Request) int64 {
+	// Initialize the query with the necessary joins and initial filters
+	query := db.db.Model(&Bounty{}).
+		Select("COUNT(*)").
+		Joins(`INNER JOIN "feature_phases" ON "feature_phases"."uuid" = "bounty"."phase_uuid"`).
+		Where(`"feature_phases"."feature_uuid" = ? AND "feature_phases"."uuid" = ?`, featureUuid, phaseUuid)
+
+	query = applyBountyFilters(query, r)
+
+	var count int64
+
+	query.Count(&count)
+
+	return count
+}
+
+// GetBountiesByFeatureUuid lists the bounties of every phase of a feature,
+// with the same filters, sorting and pagination as the phase bounty list
+func (db database) GetBountiesByFeatureUuid(featureUuid string, r *http.Request) ([]NewBounty, error) {
+	offset, limit, sortBy, direction, _ := utils.GetPaginationParams(r)
+
+	bounties := []NewBounty{}
+
+	query := db.db.Model(&Bounty{}).
+		Select("bounty.*").
+		Joins(`INNER JOIN "feature_phases" ON "feature_phases"."uuid" = "bounty"."phase_uuid"`).
+		Where(`"feature_phases"."feature_uuid" = ?`, featureUuid)
+
+	if limit > 1 {
+		query = query.Limit(limit).Offset(offset)
 	}
-	if completed == "true" {
-		statusConditions = append(statusConditions, "assignee != '' AND completed = true AND paid = false")
+
+	if IsValidBountySort(sortBy, direction) {
+		query = query.Order("bounty." + sortBy + " " + strings.ToUpper(direction))
+	} else {
+		query = query.Order("bounty.created DESC")
 	}
-	if paid == "true" {
-		statusConditions = append(statusConditions, "paid = true")
+
+	query = applyBountyFilters(query, r)
+
+	if err := query.Find(&bounties).Error; err != nil {
+		return bounties, err
 	}
 
-	if len(statusConditions) > 0 {
-		query = query.Where(strings.Join(statusConditions, " OR "))
+	return bounties, nil
+}
+
+func (db database) GetBountiesCountByFeatureUuid(featureUuid string, r *http.Request) int64 {
+	query := db.db.Model(&Bounty{}).
+		Select("COUNT(*)").
+		Joins(`INNER JOIN "feature_phases" ON "feature_phases"."uuid" = "bounty"."phase_uuid"`).
+		Where(`"feature_phases"."feature_uuid" = ?`, featureUuid)
+
+	query = applyBountyFilters(query, r)
+
+	var count int64
+
+	query.Count(&count)
+
+	return count
+}
+
+// GetOldestOpenBountyPerFeature returns every non-archived feature of the
+// workspace with its earliest created open bounty, or a nil bounty when the
+// feature has none
+func (db database) GetOldestOpenBountyPerFeature(workspaceUuid string) ([]FeatureOldestBounty, error) {
+	features := []WorkspaceFeatures{}
+	err := db.db.Where("workspace_uuid = ?", workspaceUuid).
+		Where("feat_status IS NULL OR feat_status <> ?", ArchivedFeature).
+		Order("priority ASC").
+		Find(&features).Error
+	if err != nil {
+		return nil, err
 	}
 
-	// Execute the query
-	result := query.Find(&bounties)
+	oldest := []struct {
+		NewBounty
+		FeatureUuid string
+	}{}
+	err = db.db.Raw(`SELECT DISTINCT ON (feature_phases.feature_uuid) bounty.*, feature_phases.feature_uuid AS feature_uuid
+		FROM bounty
+		INNER JOIN feature_phases ON feature_phases.uuid = bounty.phase_uuid
+		INNER JOIN workspace_features ON workspace_features.uuid = feature_phases.feature_uuid
+		WHERE workspace_features.workspace_uuid = ?
+		AND bounty.assignee = '' AND bounty.paid = false AND bounty.completed = false
+		ORDER BY feature_phases.feature_uuid, bounty.created ASC, bounty.id ASC`, workspaceUuid).
+		Scan(&oldest).Error
+	if err != nil {
+		return nil, err
+	}
 
-	if result.RowsAffected == 0 {
-		return bounties, errors.New("no bounty found")
+	byFeature := map[string]NewBounty{}
+	for _, row := range oldest {
+		byFeature[row.FeatureUuid] = row.NewBounty
 	}
 
-	// Handle tags if any
-	if tags != "" {
-		// pull out the tags and add them in here
-		t := strings.Split(tags, ",")
-		for _, s := range t {
-			query = query.Where("'" + s + "'" + " = any (tags)")
+	result := make([]FeatureOldestBounty, 0, len(features))
+	for _, feature := range features {
+		entry := FeatureOldestBounty{Feature: feature}
+		if bounty, ok := byFeature[feature.Uuid]; ok {
+			entry.Bounty = &bounty
 		}
-		query.Scan(&bounties)
+		result = append(result, entry)
 	}
 
-	return bounties, nil
+	return result, nil
 }
 
-func (db database) GetBountiesCountByFeatureAndPhaseUuid(featureUuid string, phaseUuid string, r *http.Request) int64 {
+// bountyStatusConditions maps each bounty status to the where clause that
+// selects it
+var bountyStatusConditions = map[string]string{
+	"open":      "assignee = '' AND paid != true AND completed != true",
+	"assigned":  "assignee != '' AND paid = false AND completed = false",
+	"completed": "assignee != '' AND completed = true AND paid = false",
+	"paid":      "paid = true",
+}
+
+// IsValidBountyStatus reports whether status is one of open, assigned,
+// completed or paid
+func IsValidBountyStatus(status string) bool {
+	_, ok := bountyStatusConditions[status]
+	return ok
+}
+
+// bountySortColumns lists the columns a workspace's bounties can be sorted by
+var bountySortColumns = map[string]bool{
+	"created": true,
+	"updated": true,
+	"price":   true,
+	"title":   true,
+}
+
+// IsValidBountySort reports whether sortBy and direction are accepted by
+// the workspace, feature and phase bounty lists; empty values fall back to
+// the default order
+func IsValidBountySort(sortBy string, direction string) bool {
+	if sortBy != "" && !bountySortColumns[sortBy] {
+		return false
+	}
+	direction = strings.ToLower(direction)
+	return direction == "" || direction == "asc" || direction == "desc"
+}
+
+// applyBountyFilters adds the search, language, status and tag filters from
+// the request, so the workspace, feature and phase bounty lists and their
+// counts all accept the same params
+func applyBountyFilters(query *gorm.DB, r *http.Request) *gorm.DB {
 	keys := r.URL.Query()
+	tags := keys.Get("tags")
+	search := keys.Get("search")
 	open := keys.Get("Open")
 	assigned := keys.Get("Assigned")
 	completed := keys.Get("Completed")
 	paid := keys.Get("Paid")
+	languages := keys.Get("languages")
 
-	// Initialize the query with the necessary joins and initial filters
-	query := db.db.Model(&Bounty{}).
-		Select("COUNT(*)").
-		Joins(`INNER JOIN "feature_phases" ON "feature_phases"."uuid" = "bounty"."phase_uuid"`).
-		Where(`"feature_phases"."feature_uuid" = ? AND "feature_phases"."uuid" = ?`, featureUuid, phaseUuid)
+	// Add search filter
+	if search != "" {
+		query = query.Where("LOWER(title) LIKE ?", "%"+strings.ToLower(search)+"%")
+	}
+
+	// Add language filter
+	languageArray := pq.StringArray{}
+	for _, val := range strings.Split(languages, ",") {
+		if val != "" {
+			languageArray = append(languageArray, val)
+		}
+	}
+	if len(languageArray) > 0 {
+		query = query.Where("coding_languages && ?", languageArray)
+	}
 
 	// Add status filters
 	var statusConditions []string
 
 	if open == "true" {
-		statusConditions = append(statusConditions, "assignee = '' AND paid != true AND completed != true")
+		statusConditions = append(statusConditions, bountyStatusConditions["open"])
 	}
 	if assigned == "true" {
-		statusConditions = append(statusConditions, "assignee != '' AND paid = false AND completed = false")
+		statusConditions = append(statusConditions, bountyStatusConditions["assigned"])
 	}
 	if completed == "true" {
-		statusConditions = append(statusConditions, "assignee != '' AND completed = true AND paid = false")
+		statusConditions = append(statusConditions, bountyStatusConditions["completed"])
 	}
 	if paid == "true" {
-		statusConditions = append(statusConditions, "paid = true")
+		statusConditions = append(statusConditions, bountyStatusConditions["paid"])
 	}
 
 	if len(statusConditions) > 0 {
 		query = query.Where(strings.Join(statusConditions, " OR "))
 	}
 
-	var count int64
-
-	query.Count(&count)
+	// Handle tags if any
+	if tags != "" {
+		// pull out the tags and add them in here
+		t := strings.Split(tags, ",")
+		for _, s := range t {
+			query = query.Where("? = any (tags)", s)
+		}
+	}
 
-	return count
+	return query
 }
 
 func (db database) GetPhaseByUuid(phaseUuid string) (FeaturePhase, error) {
@@ -327,3 +861,382 @@ func (db database) GetBountiesByPhaseUuid(phaseUuid string) []Bounty {
 	db.db.Model(&Bounty{}).Where("phase_uuid = ?", phaseUuid).Find(&bounties)
 	return bounties
 }
+
+func (db database) AddFeatureDependency(dependency FeatureDependency) (FeatureDependency, error) {
+	existing := FeatureDependency{}
+	result := db.db.Model(&FeatureDependency{}).Where("feature_uuid = ? AND depends_on_uuid = ?", dependency.FeatureUuid, dependency.DependsOnUuid).Find(&existing)
+	if result.RowsAffected != 0 {
+		return existing, nil
+	}
+
+	now := time.Now()
+	dependency.Created = &now
+
+	if err := db.db.Create(&dependency).Error; err != nil {
+		return FeatureDependency{}, err
+	}
+	return dependency, nil
+}
+
+func (db database) DeleteFeatureDependency(featureUuid, dependsOnUuid string) error {
+	result := db.db.Where("feature_uuid = ? AND depends_on_uuid = ?", featureUuid, dependsOnUuid).Delete(&FeatureDependency{})
+	if result.RowsAffected == 0 {
+		return errors.New("no feature dependency found to delete")
+	}
+	return nil
+}
+
+// GetUpstreamFeatures returns the features the given feature depends on
+func (db database) GetUpstreamFeatures(featureUuid string) []WorkspaceFeatures {
+	features := []WorkspaceFeatures{}
+	db.db.Model(&WorkspaceFeatures{}).
+		Joins(`INNER JOIN "feature_dependencies" ON "feature_dependencies"."depends_on_uuid" = "workspace_features"."uuid"`).
+		Where(`"feature_dependencies"."feature_uuid" = ?`, featureUuid).
+		Order(`"workspace_features"."priority" ASC`).
+		Find(&features)
+	return features
+}
+
+// GetDownstreamFeatures returns the features that depend on the given feature
+func (db database) GetDownstreamFeatures(featureUuid string) []WorkspaceFeatures {
+	features := []WorkspaceFeatures{}
+	db.db.Model(&WorkspaceFeatures{}).
+		Joins(`INNER JOIN "feature_dependencies" ON "feature_dependencies"."feature_uuid" = "workspace_features"."uuid"`).
+		Where(`"feature_dependencies"."depends_on_uuid" = ?`, featureUuid).
+		Order(`"workspace_features"."priority" ASC`).
+		Find(&features)
+	return features
+}
+
+// WatchFeature subscribes a user to a feature, refreshing the websocket
+// token if the user is already watching it
+func (db database) WatchFeature(watcher FeatureWatcher) (FeatureWatcher, error) {
+	now := time.Now()
+	watcher.Updated = &now
+
+	existing := FeatureWatcher{}
+	result := db.db.Model(&FeatureWatcher{}).Where("feature_uuid = ? AND owner_pub_key = ?", watcher.FeatureUuid, watcher.OwnerPubKey).Find(&existing)
+	if result.RowsAffected != 0 {
+		if err := db.db.Model(&existing).Updates(map[string]interface{}{
+			"websocket_token": watcher.WebsocketToken,
+			"updated":         &now,
+		}).Error; err != nil {
+			return FeatureWatcher{}, err
+		}
+		return existing, nil
+	}
+
+	watcher.Created = &now
+	if err := db.db.Create(&watcher).Error; err != nil {
+		return FeatureWatcher{}, err
+	}
+	return watcher, nil
+}
+
+func (db database) UnwatchFeature(featureUuid, pubkey string) error {
+	result := db.db.Where("feature_uuid = ? AND owner_pub_key = ?", featureUuid, pubkey).Delete(&FeatureWatcher{})
+	if result.RowsAffected == 0 {
+		return errors.New("no feature watcher found to delete")
+	}
+	return nil
+}
+
+func (db database) GetFeatureWatchers(featureUuid string) []FeatureWatcher {
+	watchers := []FeatureWatcher{}
+	db.db.Model(&FeatureWatcher{}).Where("feature_uuid = ?", featureUuid).Order("created ASC").Find(&watchers)
+	return watchers
+}
+
+// featurePhaseBounties returns the bounties on all of a feature's phases
+func (db database) featurePhaseBounties(featureUuid string) []NewBounty {
+	bounties := []NewBounty{}
+	db.db.Model(&NewBounty{}).
+		Select("bounty.*").
+		Joins(`INNER JOIN "feature_phases" ON "feature_phases"."uuid" = "bounty"."phase_uuid"`).
+		Where(`"feature_phases"."feature_uuid" = ?`, featureUuid).
+		Find(&bounties)
+	return bounties
+}
+
+// bountyDoneDate is when a bounty stopped being open work, the earlier of its
+// completion and paid dates
+func bountyDoneDate(bounty NewBounty) *time.Time {
+	doneDate := bounty.CompletionDate
+	if bounty.PaidDate != nil && (doneDate == nil || bounty.PaidDate.Before(*doneDate)) {
+		doneDate = bounty.PaidDate
+	}
+	return doneDate
+}
+
+var sessionLengthHours = regexp.MustCompile(`\d+`)
+
+// bountyEstimatedHours reads a bounty's hour estimate from its assigned hours,
+// or else the first number in its estimated session length ("< 3 hours"
+// reads as 3). It returns false when neither holds an estimate.
+func bountyEstimatedHours(bounty NewBounty) (int64, bool) {
+	if bounty.AssignedHours > 0 {
+		return int64(bounty.AssignedHours), true
+	}
+
+	hours, err := strconv.ParseInt(sessionLengthHours.FindString(bounty.EstimatedSessionLength), 10, 64)
+	if err != nil || hours <= 0 {
+		return 0, false
+	}
+	return hours, true
+}
+
+// GetFeatureEstimatedHours sums the hour estimates of the bounties on the
+// feature's phases, phases without bounties are listed with zero hours
+func (db database) GetFeatureEstimatedHours(featureUuid string) FeatureEstimatedHours {
+	estimate := FeatureEstimatedHours{
+		FeatureUuid: featureUuid,
+		Phases:      []PhaseEstimatedHours{},
+	}
+
+	phaseIndex := map[string]int{}
+	for _, phase := range db.GetPhasesByFeatureUuid(featureUuid) {
+		phaseIndex[phase.Uuid] = len(estimate.Phases)
+		estimate.Phases = append(estimate.Phases, PhaseEstimatedHours{
+			PhaseUuid: phase.Uuid,
+			PhaseName: phase.Name,
+		})
+	}
+
+	for _, bounty := range db.featurePhaseBounties(featureUuid) {
+		i, ok := phaseIndex[bounty.PhaseUuid]
+		if !ok {
+			continue
+		}
+		phase := &estimate.Phases[i]
+
+		hours, ok := bountyEstimatedHours(bounty)
+		if !ok {
+			phase.UnestimatedBounties++
+			estimate.UnestimatedBounties++
+			continue
+		}
+
+		phase.Hours += hours
+		phase.EstimatedBounties++
+		estimate.TotalHours += hours
+		estimate.EstimatedBounties++
+	}
+
+	return estimate
+}
+
+// GetFeatureBurndown returns, for every day between start and end, how many
+// bounties across the feature's phases were open and how many were completed
+// by the end of that day. A bounty counts as completed from its completion
+// or paid date, whichever comes first.
+func (db database) GetFeatureBurndown(featureUuid string, start time.Time, end time.Time) []FeatureBurndown {
+	bounties := db.featurePhaseBounties(featureUuid)
+
+	burndown := []FeatureBurndown{}
+
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		endOfDay := day.AddDate(0, 0, 1)
+		point := FeatureBurndown{Date: day.Format("2006-01-02")}
+
+		for _, bounty := range bounties {
+			if !time.Unix(bounty.Created, 0).Before(endOfDay) {
+				continue
+			}
+
+			doneDate := bountyDoneDate(bounty)
+			if doneDate != nil && doneDate.Before(endOfDay) {
+				point.Completed++
+			} else {
+				point.Open++
+			}
+		}
+
+		burndown = append(burndown, point)
+	}
+
+	return burndown
+}
+
+// GetFeatureVelocity counts the feature's bounties completed in each week of
+// the range, starting on the start date. The finish is projected from the end
+// of the range at the average weekly pace.
+func (db database) GetFeatureVelocity(featureUuid string, start time.Time, end time.Time) FeatureVelocity {
+	bounties := db.featurePhaseBounties(featureUuid)
+
+	velocity := FeatureVelocity{Weeks: []FeatureVelocityWeek{}}
+
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	var completed int64
+	for week := start; !week.After(end); week = week.AddDate(0, 0, 7) {
+		weekEnd := week.AddDate(0, 0, 7)
+		point := FeatureVelocityWeek{WeekStart: week.Format("2006-01-02")}
+
+		for _, bounty := range bounties {
+			doneDate := bountyDoneDate(bounty)
+			if doneDate != nil && !doneDate.Before(week) && doneDate.Before(weekEnd) && !doneDate.After(end) {
+				point.Completed++
+			}
+		}
+
+		completed += point.Completed
+		velocity.Weeks = append(velocity.Weeks, point)
+	}
+
+	for _, bounty := range bounties {
+		if bountyDoneDate(bounty) == nil {
+			velocity.OpenBounties++
+		}
+	}
+
+	velocity.AverageWeekly = float64(completed) / float64(len(velocity.Weeks))
+	if velocity.AverageWeekly > 0 && velocity.OpenBounties > 0 {
+		weeksLeft := math.Ceil(float64(velocity.OpenBounties) / velocity.AverageWeekly)
+		velocity.ProjectedFinish = end.AddDate(0, 0, int(weeksLeft)*7).Format("2006-01-02")
+	}
+
+	return velocity
+}
+
+// ArchiveStaleFeatures archives the workspace features that have not been
+// updated since cutoff and returns how many were archived
+func (db database) ArchiveStaleFeatures(workspaceUuid string, cutoff time.Time) (int64, error) {
+	result := db.db.Model(&WorkspaceFeatures{}).
+		Where("workspace_uuid = ?", workspaceUuid).
+		Where("COALESCE(updated, created) < ?", cutoff).
+		Where("feat_status IS NULL OR feat_status <> ?", ArchivedFeature).
+		Updates(map[string]interface{}{
+			"feat_status": ArchivedFeature,
+			"updated":     time.Now().UTC(),
+		})
+
+	return result.RowsAffected, result.Error
+}
+
+// ArchiveAllWorkspaceFeatures archives every feature of the workspace that
+// is not archived yet, custom statuses included, and returns how many were
+// archived
+func (db database) ArchiveAllWorkspaceFeatures(workspaceUuid string) (int64, error) {
+	var archived int64
+
+	err := db.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&WorkspaceFeatures{}).
+			Where("workspace_uuid = ?", workspaceUuid).
+			Where("feat_status IS NULL OR feat_status <> ?", ArchivedFeature).
+			Updates(map[string]interface{}{
+				"feat_status": ArchivedFeature,
+				"updated":     time.Now().UTC(),
+			})
+		archived = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return archived, nil
+}
+
+// GetFeatureActivity builds a newest-first timeline for a feature from the
+// feature, its phases, stories and dependencies, and the created, assigned,
+// completed and paid dates of the bounties in its phases. A since query param
+// (unix seconds) limits the feed to events after that time.
+func (db database) GetFeatureActivity(featureUuid string, r *http.Request) []FeatureActivity {
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
+	since, _ := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
+
+	activity := []FeatureActivity{}
+	addEvent := func(eventType string, itemId string, title string, actor string, date *time.Time) {
+		if date == nil || (since > 0 && date.Unix() <= since) {
+			return
+		}
+		activity = append(activity, FeatureActivity{
+			Type:   eventType,
+			ItemId: itemId,
+			Title:  title,
+			Actor:  actor,
+			Date:   date.UTC(),
+		})
+	}
+
+	feature := db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		return activity
+	}
+	addEvent("feature_created", feature.Uuid, feature.Name, feature.CreatedBy, feature.Created)
+	if feature.Updated != nil && feature.Created != nil && feature.Updated.Sub(*feature.Created) > time.Second {
+		addEvent("feature_updated", feature.Uuid, feature.Name, feature.UpdatedBy, feature.Updated)
+	}
+
+	for _, phase := range db.GetPhasesByFeatureUuid(featureUuid) {
+		addEvent("phase_created", phase.Uuid, phase.Name, phase.CreatedBy, phase.Created)
+	}
+
+	stories, _ := db.GetFeatureStoriesByFeatureUuid(featureUuid)
+	for _, story := range stories {
+		addEvent("story_created", story.Uuid, story.Description, story.CreatedBy, story.Created)
+	}
+
+	dependencies := []FeatureDependency{}
+	db.db.Model(&FeatureDependency{}).Where("feature_uuid = ?", featureUuid).Find(&dependencies)
+	for _, dependency := range dependencies {
+		addEvent("dependency_added", dependency.DependsOnUuid, "", dependency.CreatedBy, dependency.Created)
+	}
+
+	bounties := []NewBounty{}
+	db.db.Model(&NewBounty{}).
+		Select("bounty.*").
+		Joins(`INNER JOIN "feature_phases" ON "feature_phases"."uuid" = "bounty"."phase_uuid"`).
+		Where(`"feature_phases"."feature_uuid" = ?`, featureUuid).
+		Find(&bounties)
+	for _, bounty := range bounties {
+		bountyId := strconv.FormatUint(uint64(bounty.ID), 10)
+		created := time.Unix(bounty.Created, 0)
+		addEvent("bounty_created", bountyId, bounty.Title, bounty.OwnerID, &created)
+		addEvent("bounty_assigned", bountyId, bounty.Title, bounty.Assignee, bounty.AssignedDate)
+		addEvent("bounty_completed", bountyId, bounty.Title, bounty.Assignee, bounty.CompletionDate)
+		addEvent("bounty_paid", bountyId, bounty.Title, bounty.OwnerID, bounty.PaidDate)
+	}
+
+	sort.SliceStable(activity, func(i, j int) bool {
+		return activity[i].Date.After(activity[j].Date)
+	})
+
+	if limit > 1 {
+		if offset >= len(activity) {
+			return []FeatureActivity{}
+		}
+		end := offset + limit
+		if end > len(activity) {
+			end = len(activity)
+		}
+		activity = activity[offset:end]
+	}
+
+	return activity
+}
+
+func (db database) CreateFeatureSnapshot(snapshot FeatureSnapshot) (FeatureSnapshot, error) {
+	now := time.Now().UTC()
+	snapshot.Created = &now
+
+	if err := db.db.Create(&snapshot).Error; err != nil {
+		return FeatureSnapshot{}, err
+	}
+	return snapshot, nil
+}
+
+func (db database) GetFeatureSnapshots(featureUuid string) []FeatureSnapshot {
+	snapshots := []FeatureSnapshot{}
+	db.db.Where("feature_uuid = ?", featureUuid).Order("created DESC").Find(&snapshots)
+	return snapshots
+}
+
+func (db database) GetFeatureSnapshot(featureUuid string, snapshotUuid string) (FeatureSnapshot, error) {
+	snapshot := FeatureSnapshot{}
+	result := db.db.Where("feature_uuid = ? AND uuid = ?", featureUuid, snapshotUuid).First(&snapshot)
+	if result.Error != nil {
+		return FeatureSnapshot{}, result.Error
+	}
+	return snapshot, nil
+}
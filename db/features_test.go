@@ -0,0 +1,471 @@
+package db
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveStaleFeatures(t *testing.T) {
+	InitTestDB()
+
+	workspaceUuid := xid.New().String()
+
+	oldFeature, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: workspaceUuid,
+		Name:          "Old feature",
+	})
+	recentFeature, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: workspaceUuid,
+		Name:          "Recent feature",
+	})
+
+	oldDate := time.Now().AddDate(0, 0, -60)
+	TestDB.db.Model(&WorkspaceFeatures{}).Where("uuid = ?", oldFeature.Uuid).Update("updated", oldDate)
+
+	archived, err := TestDB.ArchiveStaleFeatures(workspaceUuid, time.Now().AddDate(0, 0, -30))
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), archived)
+	assert.Equal(t, ArchivedFeature, TestDB.GetFeatureByUuid(oldFeature.Uuid).FeatStatus)
+	assert.Equal(t, ActiveFeature, TestDB.GetFeatureByUuid(recentFeature.Uuid).FeatStatus)
+}
+
+func TestArchiveAllWorkspaceFeatures(t *testing.T) {
+	InitTestDB()
+
+	workspaceUuid := xid.New().String()
+
+	features := []WorkspaceFeatures{}
+	for _, name := range []string{"Payments", "Onboarding", "Search"} {
+		feature, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+			Uuid:          xid.New().String(),
+			WorkspaceUuid: workspaceUuid,
+			Name:          name,
+		})
+		features = append(features, feature)
+	}
+	otherFeature, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: xid.New().String(),
+		Name:          "Other workspace",
+	})
+
+	archived, err := TestDB.ArchiveAllWorkspaceFeatures(workspaceUuid)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), archived)
+	for _, feature := range features {
+		assert.Equal(t, ArchivedFeature, TestDB.GetFeatureByUuid(feature.Uuid).FeatStatus)
+	}
+	assert.Equal(t, ActiveFeature, TestDB.GetFeatureByUuid(otherFeature.Uuid).FeatStatus)
+
+	archived, err = TestDB.ArchiveAllWorkspaceFeatures(workspaceUuid)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), archived)
+}
+
+func TestGetFeatureStoriesOrdering(t *testing.T) {
+	InitTestDB()
+
+	featureUuid := xid.New().String()
+	first, second := 0, 1
+
+	stories := []FeatureStory{
+		{Description: "unordered high priority", Priority: 0},
+		{Description: "second by order", Order: &second, Priority: 5},
+		{Description: "unordered low priority", Priority: 3},
+		{Description: "first by order", Order: &first, Priority: 9},
+	}
+	for _, story := range stories {
+		story.Uuid = xid.New().String()
+		story.FeatureUuid = featureUuid
+		_, err := TestDB.CreateOrEditFeatureStory(story)
+		assert.NoError(t, err)
+	}
+
+	ordered, err := TestDB.GetFeatureStoriesByFeatureUuid(featureUuid)
+	assert.NoError(t, err)
+
+	descriptions := []string{}
+	for _, story := range ordered {
+		descriptions = append(descriptions, story.Description)
+	}
+	assert.Equal(t, []string{
+		"first by order",
+		"second by order",
+		"unordered high priority",
+		"unordered low priority",
+	}, descriptions)
+}
+
+func TestDeleteFeatureStories(t *testing.T) {
+	InitTestDB()
+
+	featureUuid := xid.New().String()
+
+	storyUuids := []string{}
+	for i := 0; i < 3; i++ {
+		story, err := TestDB.CreateOrEditFeatureStory(FeatureStory{
+			Uuid:        xid.New().String(),
+			FeatureUuid: featureUuid,
+			Description: "story",
+		})
+		assert.NoError(t, err)
+		storyUuids = append(storyUuids, story.Uuid)
+	}
+
+	deletion, err := TestDB.DeleteFeatureStories(featureUuid, []string{storyUuids[0], storyUuids[1], "unknown-story"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), deletion.Removed)
+	assert.Equal(t, []string{"unknown-story"}, deletion.NotFound)
+
+	remaining, _ := TestDB.GetFeatureStoriesByFeatureUuid(featureUuid)
+	assert.Len(t, remaining, 1)
+	assert.Equal(t, storyUuids[2], remaining[0].Uuid)
+}
+
+func TestAssignPhaseBounties(t *testing.T) {
+	InitTestDB()
+
+	workspace := xid.New().String()
+	phaseUuid := xid.New().String()
+
+	// bounties are matched on owner and created, so each needs its own
+	created := time.Now().Unix()
+	createBounty := func(title string, phase string, assignee string) NewBounty {
+		created++
+		bounty, _ := TestDB.CreateOrEditBounty(NewBounty{
+			Type:          "coding",
+			Title:         title,
+			WorkspaceUuid: workspace,
+			PhaseUuid:     phase,
+			OwnerID:       "owner",
+			Assignee:      assignee,
+			Created:       created,
+		})
+		return bounty
+	}
+
+	first := createBounty("first", phaseUuid, "")
+	second := createBounty("second", phaseUuid, "")
+	taken := createBounty("taken", phaseUuid, "other-hunter")
+	elsewhere := createBounty("elsewhere", xid.New().String(), "")
+
+	results, err := TestDB.AssignPhaseBounties(phaseUuid, []uint{first.ID, second.ID, taken.ID, elsewhere.ID}, "hunter")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []PhaseBountyAssignment{
+		{BountyID: first.ID, Assigned: true},
+		{BountyID: second.ID, Assigned: true},
+		{BountyID: taken.ID, Error: "bounty is not open"},
+		{BountyID: elsewhere.ID, Error: "bounty is not in this phase"},
+	}, results)
+
+	bounties := []NewBounty{}
+	TestDB.db.Model(&NewBounty{}).Where("phase_uuid = ?", phaseUuid).Order("id").Find(&bounties)
+	assert.Len(t, bounties, 3)
+	assert.Equal(t, "hunter", bounties[0].Assignee)
+	assert.NotNil(t, bounties[0].AssignedDate)
+	assert.Equal(t, "hunter", bounties[1].Assignee)
+	assert.Equal(t, "other-hunter", bounties[2].Assignee)
+}
+
+func TestBountyEstimatedHours(t *testing.T) {
+	tests := []struct {
+		name     string
+		bounty   NewBounty
+		hours    int64
+		estimate bool
+	}{
+		{"assigned hours win", NewBounty{AssignedHours: 5, EstimatedSessionLength: "< 3 hours"}, 5, true},
+		{"session length", NewBounty{EstimatedSessionLength: "< 3 hours"}, 3, true},
+		{"session length without a number", NewBounty{EstimatedSessionLength: "More than a day"}, 0, false},
+		{"zero session length", NewBounty{EstimatedSessionLength: "0 hours"}, 0, false},
+		{"no estimate", NewBounty{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hours, ok := bountyEstimatedHours(tt.bounty)
+			assert.Equal(t, tt.hours, hours)
+			assert.Equal(t, tt.estimate, ok)
+		})
+	}
+}
+
+func TestGetFeatureEstimatedHours(t *testing.T) {
+	InitTestDB()
+
+	feature, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: xid.New().String(),
+		Name:          "Estimated feature",
+	})
+	build, _ := TestDB.CreateOrEditFeaturePhase(FeaturePhase{
+		Uuid:        xid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "Build",
+		Priority:    0,
+	})
+	launch, _ := TestDB.CreateOrEditFeaturePhase(FeaturePhase{
+		Uuid:        xid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "Launch",
+		Priority:    1,
+	})
+
+	created := time.Now().Unix()
+	bounties := []NewBounty{
+		{Title: "assigned hours", PhaseUuid: build.Uuid, AssignedHours: 4},
+		{Title: "session length", PhaseUuid: build.Uuid, EstimatedSessionLength: "< 3 hours"},
+		{Title: "no estimate", PhaseUuid: build.Uuid},
+	}
+	for i, bounty := range bounties {
+		bounty.Type = "coding"
+		bounty.OwnerID = "owner"
+		bounty.WorkspaceUuid = feature.WorkspaceUuid
+		bounty.Created = created + int64(i)
+		TestDB.CreateOrEditBounty(bounty)
+	}
+
+	estimate := TestDB.GetFeatureEstimatedHours(feature.Uuid)
+
+	assert.Equal(t, FeatureEstimatedHours{
+		FeatureUuid:         feature.Uuid,
+		TotalHours:          7,
+		EstimatedBounties:   2,
+		UnestimatedBounties: 1,
+		Phases: []PhaseEstimatedHours{
+			{PhaseUuid: build.Uuid, PhaseName: "Build", Hours: 7, EstimatedBounties: 2, UnestimatedBounties: 1},
+			{PhaseUuid: launch.Uuid, PhaseName: "Launch"},
+		},
+	}, estimate)
+}
+
+func TestMoveFeatureToWorkspace(t *testing.T) {
+	InitTestDB()
+
+	sourceWorkspace := xid.New().String()
+	targetWorkspace := xid.New().String()
+
+	feature, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: sourceWorkspace,
+		Name:          "Misplaced feature",
+	})
+	otherFeature, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: sourceWorkspace,
+		Name:          "Staying feature",
+	})
+	phase, _ := TestDB.CreateOrEditFeaturePhase(FeaturePhase{
+		Uuid:        xid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "Phase",
+	})
+	story, _ := TestDB.CreateOrEditFeatureStory(FeatureStory{
+		Uuid:        xid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Description: "story",
+	})
+	TestDB.CreateOrEditBounty(NewBounty{
+		Type:          "coding",
+		Title:         "phase bounty",
+		WorkspaceUuid: sourceWorkspace,
+		PhaseUuid:     phase.Uuid,
+		OwnerID:       "owner",
+	})
+	TestDB.AddFeatureDependency(FeatureDependency{
+		FeatureUuid:   otherFeature.Uuid,
+		DependsOnUuid: feature.Uuid,
+	})
+
+	assert.Equal(t, int64(2), TestDB.GetWorkspaceFeaturesCount(sourceWorkspace))
+	assert.Equal(t, int64(0), TestDB.GetWorkspaceFeaturesCount(targetWorkspace))
+
+	moved, err := TestDB.MoveFeatureToWorkspace(feature.Uuid, targetWorkspace)
+
+	assert.NoError(t, err)
+	assert.Equal(t, targetWorkspace, moved.WorkspaceUuid)
+
+	phases := TestDB.GetPhasesByFeatureUuid(feature.Uuid)
+	assert.Len(t, phases, 1)
+
+	movedStory, err := TestDB.GetFeatureStoryByUuid(feature.Uuid, story.Uuid)
+	assert.NoError(t, err)
+	assert.Equal(t, story.Uuid, movedStory.Uuid)
+
+	bounties := []NewBounty{}
+	TestDB.db.Model(&NewBounty{}).Where("phase_uuid = ?", phase.Uuid).Find(&bounties)
+	assert.Len(t, bounties, 1)
+	assert.Equal(t, targetWorkspace, bounties[0].WorkspaceUuid)
+
+	assert.Empty(t, TestDB.GetUpstreamFeatures(otherFeature.Uuid))
+
+	assert.Equal(t, int64(1), TestDB.GetWorkspaceFeaturesCount(sourceWorkspace))
+	assert.Equal(t, int64(1), TestDB.GetWorkspaceFeaturesCount(targetWorkspace))
+
+	_, err = TestDB.MoveFeatureToWorkspace("unknown-feature", targetWorkspace)
+	assert.Error(t, err)
+}
+
+func TestGetWorkspacesFeaturesCount(t *testing.T) {
+	InitTestDB()
+
+	busyWorkspace := xid.New().String()
+	quietWorkspace := xid.New().String()
+	emptyWorkspace := xid.New().String()
+
+	for i := 0; i < 3; i++ {
+		TestDB.CreateOrEditFeature(WorkspaceFeatures{
+			Uuid:          xid.New().String(),
+			WorkspaceUuid: busyWorkspace,
+			Name:          "Busy feature",
+		})
+	}
+	TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: quietWorkspace,
+		Name:          "Quiet feature",
+	})
+
+	counts := TestDB.GetWorkspacesFeaturesCount([]string{busyWorkspace, quietWorkspace, emptyWorkspace})
+
+	assert.Equal(t, int64(3), counts[busyWorkspace])
+	assert.Equal(t, int64(1), counts[quietWorkspace])
+	assert.Equal(t, int64(0), counts[emptyWorkspace])
+
+	assert.Empty(t, TestDB.GetWorkspacesFeaturesCount([]string{}))
+}
+
+func TestGetWorkspaceStoryCounts(t *testing.T) {
+	InitTestDB()
+
+	workspaceUuid := xid.New().String()
+
+	features := []WorkspaceFeatures{}
+	for _, name := range []string{"Payments", "Onboarding", "Search"} {
+		feature, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+			Uuid:          xid.New().String(),
+			WorkspaceUuid: workspaceUuid,
+			Name:          name,
+		})
+		features = append(features, feature)
+	}
+	otherFeature, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: xid.New().String(),
+		Name:          "Other workspace",
+	})
+
+	for featureIndex, storyCount := range []int{2, 1} {
+		for i := 0; i < storyCount; i++ {
+			TestDB.CreateOrEditFeatureStory(FeatureStory{
+				Uuid:        xid.New().String(),
+				FeatureUuid: features[featureIndex].Uuid,
+				Description: "story",
+			})
+		}
+	}
+	TestDB.CreateOrEditFeatureStory(FeatureStory{
+		Uuid:        xid.New().String(),
+		FeatureUuid: otherFeature.Uuid,
+		Description: "story",
+	})
+
+	counts := TestDB.GetWorkspaceStoryCounts(workspaceUuid)
+
+	assert.Equal(t, map[string]int64{
+		features[0].Uuid: 2,
+		features[1].Uuid: 1,
+	}, counts)
+}
+
+func TestGetFeaturesByWorkspaceUuidPinnedFirst(t *testing.T) {
+	InitTestDB()
+
+	workspaceUuid := xid.New().String()
+
+	first, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: workspaceUuid,
+		Name:          "Alpha",
+		Priority:      1,
+	})
+	pinned, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: workspaceUuid,
+		Name:          "Zulu",
+		Priority:      5,
+		Pinned:        true,
+	})
+	last, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: workspaceUuid,
+		Name:          "Mike",
+		Priority:      3,
+	})
+
+	byPriority := TestDB.GetFeaturesByWorkspaceUuid(workspaceUuid, httptest.NewRequest("GET", "/features", nil))
+	assert.Len(t, byPriority, 3)
+	assert.Equal(t, []string{pinned.Uuid, first.Uuid, last.Uuid}, []string{byPriority[0].Uuid, byPriority[1].Uuid, byPriority[2].Uuid})
+
+	byName := TestDB.GetFeaturesByWorkspaceUuid(workspaceUuid, httptest.NewRequest("GET", "/features?sortBy=name&direction=asc", nil))
+	assert.Len(t, byName, 3)
+	assert.Equal(t, []string{pinned.Uuid, first.Uuid, last.Uuid}, []string{byName[0].Uuid, byName[1].Uuid, byName[2].Uuid})
+
+	_, err := TestDB.SetFeaturePinned(pinned.Uuid, false)
+	assert.NoError(t, err)
+
+	unpinned := TestDB.GetFeaturesByWorkspaceUuid(workspaceUuid, httptest.NewRequest("GET", "/features", nil))
+	assert.Equal(t, []string{first.Uuid, last.Uuid, pinned.Uuid}, []string{unpinned[0].Uuid, unpinned[1].Uuid, unpinned[2].Uuid})
+}
+
+func TestGetWorkspacePhases(t *testing.T) {
+	InitTestDB()
+
+	workspaceUuid := xid.New().String()
+
+	second, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: workspaceUuid,
+		Name:          "Second feature",
+		Priority:      2,
+	})
+	first, _ := TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: workspaceUuid,
+		Name:          "First feature",
+		Priority:      1,
+	})
+	TestDB.CreateOrEditFeature(WorkspaceFeatures{
+		Uuid:          xid.New().String(),
+		WorkspaceUuid: xid.New().String(),
+		Name:          "Other workspace feature",
+	})
+
+	TestDB.CreateOrEditFeaturePhase(FeaturePhase{Uuid: xid.New().String(), FeatureUuid: second.Uuid, Name: "Second A", Priority: 1})
+	TestDB.CreateOrEditFeaturePhase(FeaturePhase{Uuid: xid.New().String(), FeatureUuid: first.Uuid, Name: "First B", Priority: 2})
+	TestDB.CreateOrEditFeaturePhase(FeaturePhase{Uuid: xid.New().String(), FeatureUuid: first.Uuid, Name: "First A", Priority: 1})
+
+	phases := TestDB.GetWorkspacePhases(workspaceUuid, httptest.NewRequest("GET", "/phases", nil))
+
+	names := []string{}
+	for _, phase := range phases {
+		names = append(names, phase.FeatureName+": "+phase.Name)
+	}
+	assert.Equal(t, []string{"First feature: First A", "First feature: First B", "Second feature: Second A"}, names)
+	assert.Equal(t, int64(3), TestDB.GetWorkspacePhasesCount(workspaceUuid))
+
+	page := TestDB.GetWorkspacePhases(workspaceUuid, httptest.NewRequest("GET", "/phases?limit=2&page=2", nil))
+	assert.Len(t, page, 1)
+	assert.Equal(t, "Second A", page[0].Name)
+}
@@ -33,6 +33,12 @@ type Database interface {
 	GetBountiesCount(r *http.Request) int64
 	GetWorkspaceBounties(r *http.Request, workspace_uuid string) []NewBounty
 	GetWorkspaceBountiesCount(r *http.Request, workspace_uuid string) int64
+	GetWorkspaceBountiesByStatus(r *http.Request, workspace_uuid string, status string) []NewBounty
+	GetWorkspacePendingBounties(r *http.Request, workspace_uuid string) []NewBounty
+	GetWorkspacePendingBountiesCount(workspace_uuid string) int64
+	GetWorkspaceBountiesStatusTotals(workspace_uuid string, status string) (int64, uint)
+	GetWorkspaceBountiesCountByLanguage(workspace_uuid string) map[string]int64
+	SearchWorkspacesBounties(r *http.Request, workspace_uuids []string) ([]NewBounty, int64)
 	GetAssignedBounties(r *http.Request) ([]NewBounty, error)
 	GetCreatedBounties(r *http.Request) ([]NewBounty, error)
 	GetBountyById(id string) ([]NewBounty, error)
@@ -45,6 +51,7 @@ type Database interface {
 	AddBounty(b Bounty) (Bounty, error)
 	GetAllBounties(r *http.Request) []NewBounty
 	CreateOrEditBounty(b NewBounty) (NewBounty, error)
+	ApproveBounty(id uint) (NewBounty, error)
 	UpdateBountyNullColumn(b NewBounty, column string) NewBounty
 	UpdateBountyBoolColumn(b NewBounty, column string) NewBounty
 	DeleteBounty(pubkey string, created string) (NewBounty, error)
@@ -90,20 +97,37 @@ type Database interface {
 	CreateOrEditWorkspace(m Workspace) (Workspace, error)
 	GetWorkspaceUsers(uuid string) ([]WorkspaceUsersData, error)
 	GetWorkspaceUsersCount(uuid string) int64
+	GetWorkspaceTeam(uuid string, r *http.Request) ([]WorkspaceTeamMember, error)
+	UpdateWorkspaceProductBrief(uuid string, brief WorkspaceProductBrief) (Workspace, error)
 	GetWorkspaceBountyCount(uuid string) int64
 	GetWorkspaceUser(pubkey string, workspace_uuid string) WorkspaceUsers
 	CreateWorkspaceUser(orgUser WorkspaceUsers) WorkspaceUsers
+	CreateWorkspaceUserWithRoles(orgUser WorkspaceUsers) (WorkspaceUsers, error)
 	DeleteWorkspaceUser(orgUser WorkspaceUsersData, org string) WorkspaceUsersData
+	RemoveWorkspaceUsers(workspace_uuid string, pubkeys []string) error
 	GetBountyRoles() []BountyRoles
 	CreateUserRoles(roles []WorkspaceUserRoles, uuid string, pubkey string) []WorkspaceUserRoles
+	GetUserRoles(uuid string, pubkey string) []WorkspaceUserRoles
+	CreateWorkspaceInvite(invite WorkspaceInvite) (WorkspaceInvite, error)
+	GetWorkspaceInviteByToken(token string) WorkspaceInvite
+	RedeemWorkspaceInvite(invite WorkspaceInvite, pubkey string) error
+	CreateWorkspaceTag(tag WorkspaceTag) (WorkspaceTag, error)
+	GetWorkspaceTags(workspace_uuid string) []WorkspaceTag
+	DeleteWorkspaceTag(workspace_uuid string, name string) error
+	CreateWorkspaceFeatureStatus(status WorkspaceFeatureStatus) (WorkspaceFeatureStatus, error)
+	GetWorkspaceFeatureStatuses(workspace_uuid string) []WorkspaceFeatureStatus
+	DeleteWorkspaceFeatureStatus(workspace_uuid string, name string) error
 	GetUserCreatedWorkspaces(pubkey string) []Workspace
 	GetUserAssignedWorkspaces(pubkey string) []WorkspaceUsers
+	GetUserRecentActivity(workspaceUuids []string, limit int) []UserActivity
 	AddBudgetHistory(budget BudgetHistory) BudgetHistory
 	CreateWorkspaceBudget(budget NewBountyBudget) NewBountyBudget
 	UpdateWorkspaceBudget(budget NewBountyBudget) NewBountyBudget
 	GetPaymentHistoryByCreated(created *time.Time, workspace_uuid string) NewPaymentHistory
 	GetWorkspaceBudget(workspace_uuid string) NewBountyBudget
 	GetWorkspaceStatusBudget(workspace_uuid string) StatusBudget
+	GetWorkspaceBudgetByFeature(workspace_uuid string) []FeatureBudget
+	GetWorkspaceBountyAging(workspace_uuid string) []BountyAgeBucket
 	GetWorkspaceBudgetHistory(workspace_uuid string) []BudgetHistoryData
 	ProcessUpdateBudget(invoice NewInvoiceList) error
 	AddAndUpdateBudget(invoice NewInvoiceList) NewPaymentHistory
@@ -111,9 +135,11 @@ type Database interface {
 	AddPaymentHistory(payment NewPaymentHistory) NewPaymentHistory
 	ProcessBountyPayment(payment NewPaymentHistory, bounty NewBounty) error
 	GetPaymentHistory(workspace_uuid string, r *http.Request) []NewPaymentHistory
+	GetWorkspacePendingPayments(workspace_uuid string) []PendingPayment
 	GetInvoice(payment_request string) NewInvoiceList
 	GetWorkspaceInvoices(workspace_uuid string) []NewInvoiceList
 	GetWorkspaceInvoicesCount(workspace_uuid string) int64
+	GetAllWorkspaceInvoices(workspace_uuid string) []NewInvoiceList
 	UpdateInvoice(payment_request string) NewInvoiceList
 	AddInvoice(invoice NewInvoiceList) NewInvoiceList
 	DeleteInvoice(payment_request string) NewInvoiceList
@@ -125,6 +151,7 @@ type Database interface {
 	ChangeWorkspaceDeleteStatus(workspace_uuid string, status bool) Workspace
 	UpdateWorkspaceForDeletion(uuid string) error
 	ProcessDeleteWorkspace(workspace_uuid string) error
+	GetWorkspaceDeletionPreview(workspace_uuid string) WorkspaceDeletionPreview
 	DeleteAllUsersFromWorkspace(uuid string) error
 	GetFilterStatusCount() FilterStattuCount
 	UserHasManageBountyRoles(pubKeyFromAuth string, uuid string) bool
@@ -139,6 +166,8 @@ type Database interface {
 	TotalAssignedBounties(r PaymentDateRange, workspace string) int64
 	NewHuntersPaid(r PaymentDateRange, workspace string) int64
 	TotalHuntersPaid(r PaymentDateRange, workspace string) int64
+	GetPersonBountyStats(pubkey string, workspace string) PersonBountyStats
+	GetPersonActivityCounts(pubkey string, r PaymentDateRange) PersonActivityCounts
 	GetPersonByPubkey(pubkey string) Person
 	GetBountiesByDateRange(r PaymentDateRange, re *http.Request) []NewBounty
 	GetBountiesByDateRangeCount(r PaymentDateRange, re *http.Request) int64
@@ -151,21 +180,53 @@ type Database interface {
 	GetWorkspaceRepoByWorkspaceUuidAndRepoUuid(workspace_uuid string, uuid string) (WorkspaceRepositories, error)
 	DeleteWorkspaceRepository(workspace_uuid string, uuid string) bool
 	CreateOrEditFeature(m WorkspaceFeatures) (WorkspaceFeatures, error)
+	SetFeaturePinned(uuid string, pinned bool) (WorkspaceFeatures, error)
+	UpdateFeatureStatus(uuid string, status FeatureStatus) (WorkspaceFeatures, error)
 	GetFeaturesByWorkspaceUuid(uuid string, r *http.Request) []WorkspaceFeatures
+	GetFeaturesCountByWorkspaceUuid(uuid string, r *http.Request) int64
+	GetFeaturesLastUpdated(uuid string, r *http.Request) time.Time
 	GetWorkspaceFeaturesCount(uuid string) int64
+	GetWorkspacesFeaturesCount(uuids []string) map[string]int64
 	GetFeatureByUuid(uuid string) WorkspaceFeatures
 	CreateOrEditFeaturePhase(phase FeaturePhase) (FeaturePhase, error)
 	GetPhasesByFeatureUuid(featureUuid string) []FeaturePhase
+	GetWorkspacePhases(workspaceUuid string, r *http.Request) []WorkspacePhase
+	GetWorkspacePhasesCount(workspaceUuid string) int64
 	GetFeaturePhaseByUuid(featureUuid, phaseUuid string) (FeaturePhase, error)
 	DeleteFeaturePhase(featureUuid, phaseUuid string) error
 	CreateOrEditFeatureStory(story FeatureStory) (FeatureStory, error)
+	GetWorkspaceStoryCounts(workspaceUuid string) map[string]int64
 	GetFeatureStoriesByFeatureUuid(featureUuid string) ([]FeatureStory, error)
 	GetFeatureStoryByUuid(featureUuid, storyUuid string) (FeatureStory, error)
 	DeleteFeatureStoryByUuid(featureUuid, storyUuid string) error
+	DeleteFeatureStories(featureUuid string, storyUuids []string) (FeatureStoriesDeletion, error)
+	MoveFeatureStory(featureUuid, storyUuid, targetFeatureUuid string) (FeatureStory, error)
+	MoveFeatureToWorkspace(featureUuid, targetWorkspaceUuid string) (WorkspaceFeatures, error)
 	DeleteFeatureByUuid(uuid string) error
+	AssignPhaseBounties(phaseUuid string, bountyIds []uint, assignee string) ([]PhaseBountyAssignment, error)
 	GetBountiesByFeatureAndPhaseUuid(featureUuid string, phaseUuid string, r *http.Request) ([]NewBounty, error)
 	GetBountiesCountByFeatureAndPhaseUuid(featureUuid string, phaseUuid string, r *http.Request) int64
+	GetBountiesByFeatureUuid(featureUuid string, r *http.Request) ([]NewBounty, error)
+	GetBountiesCountByFeatureUuid(featureUuid string, r *http.Request) int64
+	GetOldestOpenBountyPerFeature(workspaceUuid string) ([]FeatureOldestBounty, error)
 	GetPhaseByUuid(phaseUuid string) (FeaturePhase, error)
+	UpdateFeaturePhaseStatus(featureUuid string, phaseUuid string, status PhaseStatus) (FeaturePhase, error)
 	GetBountiesByPhaseUuid(phaseUuid string) []Bounty
 	GetFeaturePhasesBountiesCount(bountyType string, phaseUuid string) int64
+	AddFeatureDependency(dependency FeatureDependency) (FeatureDependency, error)
+	DeleteFeatureDependency(featureUuid, dependsOnUuid string) error
+	GetUpstreamFeatures(featureUuid string) []WorkspaceFeatures
+	GetDownstreamFeatures(featureUuid string) []WorkspaceFeatures
+	WatchFeature(watcher FeatureWatcher) (FeatureWatcher, error)
+	UnwatchFeature(featureUuid, pubkey string) error
+	GetFeatureWatchers(featureUuid string) []FeatureWatcher
+	GetFeatureBurndown(featureUuid string, start time.Time, end time.Time) []FeatureBurndown
+	GetFeatureEstimatedHours(featureUuid string) FeatureEstimatedHours
+	GetFeatureVelocity(featureUuid string, start time.Time, end time.Time) FeatureVelocity
+	ArchiveStaleFeatures(workspaceUuid string, cutoff time.Time) (int64, error)
+	ArchiveAllWorkspaceFeatures(workspaceUuid string) (int64, error)
+	GetFeatureActivity(featureUuid string, r *http.Request) []FeatureActivity
+	CreateFeatureSnapshot(snapshot FeatureSnapshot) (FeatureSnapshot, error)
+	GetFeatureSnapshots(featureUuid string) []FeatureSnapshot
+	GetFeatureSnapshot(featureUuid string, snapshotUuid string) (FeatureSnapshot, error)
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/stakwork/sphinx-tribes/utils"
+	"gorm.io/gorm"
 )
 
 var SecondsToDateConversion = 60 * 60 * 24
@@ -130,6 +131,52 @@ func (db database) NewHuntersPaid(r PaymentDateRange, workspace string) int64 {
 	return count
 }
 
+// GetPersonBountyStats aggregates the bounties assigned to a hunter,
+// optionally scoped to one workspace
+func (db database) GetPersonBountyStats(pubkey string, workspace string) PersonBountyStats {
+	return assigneeBountyStats(func() *gorm.DB {
+		query := db.db.Model(&NewBounty{}).Where("assignee = ?", pubkey)
+		if workspace != "" {
+			query = query.Where("workspace_uuid = ?", workspace)
+		}
+		return query
+	})
+}
+
+// assigneeBountyStats runs the PersonBountyStats counts on the bounties
+// returned by assigneeQuery, which is already scoped to the hunter
+func assigneeBountyStats(assigneeQuery func() *gorm.DB) PersonBountyStats {
+	stats := PersonBountyStats{}
+
+	assigneeQuery().Where("paid = ?", false).Count(&stats.BountiesAssigned)
+	assigneeQuery().Where("completed = ?", true).Count(&stats.BountiesCompleted)
+	assigneeQuery().Where("paid = ?", true).Count(&stats.BountiesPaid)
+	assigneeQuery().Where("paid = ?", true).Select("COALESCE(SUM(price), 0)").Row().Scan(&stats.SatsEarned)
+
+	return stats
+}
+
+// GetPersonActivityCounts counts the bounties a person posted and adds
+// their hunter stats. The date range applies to the bounty created date
+// and is skipped when either end is empty.
+func (db database) GetPersonActivityCounts(pubkey string, r PaymentDateRange) PersonActivityCounts {
+	counts := PersonActivityCounts{}
+
+	inRange := func(query *gorm.DB) *gorm.DB {
+		if r.StartDate != "" && r.EndDate != "" {
+			query = query.Where("created >= ?", r.StartDate).Where("created <= ?", r.EndDate)
+		}
+		return query
+	}
+
+	inRange(db.db.Model(&NewBounty{}).Where("owner_id = ?", pubkey)).Count(&counts.BountiesPosted)
+	counts.PersonBountyStats = assigneeBountyStats(func() *gorm.DB {
+		return inRange(db.db.Model(&NewBounty{}).Where("assignee = ?", pubkey))
+	})
+
+	return counts
+}
+
 func (db database) TotalBountiesPosted(r PaymentDateRange, workspace string) int64 {
 	var count int64
 	query := db.db.Model(&Bounty{}).Where("created >= ?", r.StartDate).Where("created <= ?", r.EndDate)
@@ -132,6 +132,39 @@ func (s StoreData) GetChallengeCache(key string) (string, error) {
 	return c, nil
 }
 
+func featuresCountCacheKey(workspaceUuid string) string {
+	return "FEATURESCOUNT-" + workspaceUuid
+}
+
+func (s StoreData) SetFeaturesCountCache(workspaceUuid string, count int64) error {
+	if s.Cache == nil {
+		return errors.New("Cache not initialized")
+	}
+	// The count should expire every 5 minutes
+	s.Cache.Set(featuresCountCacheKey(workspaceUuid), count, 5*time.Minute)
+	return nil
+}
+
+func (s StoreData) GetFeaturesCountCache(workspaceUuid string) (int64, error) {
+	if s.Cache == nil {
+		return 0, errors.New("Cache not initialized")
+	}
+	value, found := s.Cache.Get(featuresCountCacheKey(workspaceUuid))
+	c, ok := value.(int64)
+	if !found || !ok {
+		return 0, errors.New("Features Count Cache not found")
+	}
+	return c, nil
+}
+
+func (s StoreData) DeleteFeaturesCountCache(workspaceUuid string) error {
+	if s.Cache == nil {
+		return nil
+	}
+	s.Cache.Delete(featuresCountCacheKey(workspaceUuid))
+	return nil
+}
+
 func Ask(w http.ResponseWriter, r *http.Request) {
 	var m sync.Mutex
 	m.Lock()
@@ -67,3 +67,27 @@ func TestSetLnCache(t *testing.T) {
 		t.Error("Could not set cache item")
 	}
 }
+
+func TestFeaturesCountCache(t *testing.T) {
+	var workspaceUuid = "TestWorkspace"
+
+	InitCache()
+
+	Store.SetFeaturesCountCache(workspaceUuid, 3)
+	count, err := Store.GetFeaturesCountCache(workspaceUuid)
+
+	if err != nil {
+		t.Error("Cache error thrown")
+	}
+
+	if count != 3 {
+		t.Error("Could not set features count cache item")
+	}
+
+	Store.DeleteFeaturesCountCache(workspaceUuid)
+	_, err = Store.GetFeaturesCountCache(workspaceUuid)
+
+	if err == nil {
+		t.Error("Could not delete features count cache item")
+	}
+}
@@ -390,6 +390,7 @@ type Bounty struct {
 	CodingLanguages         pq.StringArray `gorm:"type:text[];not null default:'[]'" json:"coding_languages"`
 	PhaseUuid               *string        `json:"phase_uuid"`
 	PhasePriority           *int           `json:"phase_priority"`
+	PendingApproval         bool           `gorm:"default:false" json:"pending_approval"`
 }
 
 // Todo: Change back to Bounty
@@ -427,6 +428,7 @@ type NewBounty struct {
 	CodingLanguages         pq.StringArray `gorm:"type:text[];not null default:'[]'" json:"coding_languages"`
 	PhaseUuid               string         `json:"phase_uuid"`
 	PhasePriority           int            `json:"phase_priority"`
+	PendingApproval         bool           `gorm:"default:false" json:"pending_approval"`
 }
 
 type BountyOwners struct {
@@ -508,30 +510,64 @@ type Organization struct {
 }
 
 type Workspace struct {
-	ID           uint       `json:"id"`
-	Uuid         string     `json:"uuid"`
-	Name         string     `gorm:"unique;not null" json:"name"`
-	OwnerPubKey  string     `json:"owner_pubkey"`
-	Img          string     `json:"img"`
-	Created      *time.Time `json:"created"`
-	Updated      *time.Time `json:"updated"`
-	Show         bool       `json:"show"`
-	Deleted      bool       `gorm:"default:false" json:"deleted"`
-	BountyCount  int64      `json:"bounty_count,omitempty"`
-	Budget       uint       `json:"budget,omitempty"`
-	Website      string     `json:"website" validate:"omitempty,uri"`
-	Github       string     `json:"github" validate:"omitempty,uri"`
-	Description  string     `json:"description" validate:"omitempty,lte=120"`
-	Mission      string     `json:"mission"`
-	Tactics      string     `json:"tactics"`
-	SchematicUrl string     `json:"schematic_url"`
-	SchematicImg string     `json:"schematic_img"`
+	ID                 uint       `json:"id"`
+	Uuid               string     `json:"uuid"`
+	Name               string     `gorm:"unique;not null" json:"name"`
+	OwnerPubKey        string     `json:"owner_pubkey"`
+	Img                string     `json:"img"`
+	Created            *time.Time `json:"created"`
+	Updated            *time.Time `json:"updated"`
+	Show               bool       `json:"show"`
+	Deleted            bool       `gorm:"default:false" json:"deleted"`
+	BountyCount        int64      `json:"bounty_count,omitempty"`
+	FeatureCount       int64      `gorm:"-" json:"feature_count,omitempty"`
+	Budget             uint       `json:"budget,omitempty"`
+	Website            string     `json:"website" validate:"omitempty,uri"`
+	Github             string     `json:"github" validate:"omitempty,uri"`
+	Description        string     `json:"description" validate:"omitempty,lte=120"`
+	Mission            string     `json:"mission"`
+	Tactics            string     `json:"tactics"`
+	SchematicUrl       string     `json:"schematic_url"`
+	SchematicImg       string     `json:"schematic_img"`
+	DefaultBountyPrice int        `gorm:"default:0" json:"default_bounty_price" validate:"gte=0"`
+	Private            bool       `gorm:"default:false" json:"private"`
+	DisplayCurrency    string     `json:"display_currency" validate:"omitempty,oneof=sats btc usd"`
+	// RequiresBountyApproval holds new bounties back until a bounty manager
+	// approves them
+	RequiresBountyApproval bool `gorm:"default:false" json:"requires_bounty_approval"`
+}
+
+// DefaultDisplayCurrency is used for workspaces that never picked one
+const DefaultDisplayCurrency = "sats"
+
+// GetDisplayCurrency returns how the workspace wants amounts shown, falling
+// back to sats for workspaces created before the setting existed
+func (w Workspace) GetDisplayCurrency() string {
+	if w.DisplayCurrency == "" {
+		return DefaultDisplayCurrency
+	}
+	return w.DisplayCurrency
+}
+
+// WorkspaceDeletionPreview counts the records a workspace delete would touch
+type WorkspaceDeletionPreview struct {
+	Users     int64 `json:"users"`
+	UserRoles int64 `json:"user_roles"`
+	Features  int64 `json:"features"`
+	Bounties  int64 `json:"bounties"`
+	Invoices  int64 `json:"invoices"`
+}
+
+type WorkspaceProductBrief struct {
+	Mission *string `json:"mission,omitempty" validate:"omitempty,lte=10000"`
+	Tactics *string `json:"tactics,omitempty" validate:"omitempty,lte=10000"`
 }
 
 type WorkspaceShort struct {
-	Uuid string `json:"uuid"`
-	Name string `gorm:"unique;not null" json:"name"`
-	Img  string `json:"img"`
+	Uuid            string `json:"uuid"`
+	Name            string `gorm:"unique;not null" json:"name"`
+	Img             string `json:"img"`
+	DisplayCurrency string `json:"display_currency,omitempty"`
 }
 
 type OrganizationUsers struct {
@@ -549,6 +585,23 @@ type WorkspaceUsers struct {
 	WorkspaceUuid string     `json:"workspace_uuid,omitempty"`
 	Created       *time.Time `json:"created"`
 	Updated       *time.Time `json:"updated"`
+	Roles         []string   `gorm:"-" json:"roles,omitempty"`
+}
+
+type WorkspaceUserRemoval struct {
+	OwnerPubKey string `json:"owner_pubkey"`
+	Removed     bool   `json:"removed"`
+	Error       string `json:"error,omitempty"`
+}
+
+// AssignableMember is the person summary of a workspace member that bounties
+// can be assigned to
+type AssignableMember struct {
+	OwnerPubKey string `json:"owner_pubkey"`
+	OwnerAlias  string `json:"owner_alias"`
+	UniqueName  string `json:"unique_name"`
+	Img         string `json:"img"`
+	IsOwner     bool   `json:"is_owner"`
 }
 
 type WorkspaceUsersData struct {
@@ -558,6 +611,12 @@ type WorkspaceUsersData struct {
 	Person
 }
 
+type WorkspaceTeamMember struct {
+	WorkspaceUsersData
+	Roles      []WorkspaceUserRoles `gorm:"-" json:"roles"`
+	LastActive *time.Time           `json:"last_active"`
+}
+
 type WorkspaceRepositories struct {
 	ID            uint       `json:"id"`
 	Uuid          string     `gorm:"not null" json:"uuid"`
@@ -570,34 +629,115 @@ type WorkspaceRepositories struct {
 	UpdatedBy     string     `json:"updated_by"`
 }
 
+type FeatureStatus string
+
+const (
+	ActiveFeature   FeatureStatus = "active"
+	ArchivedFeature FeatureStatus = "archived"
+)
+
+// IsBuiltIn reports whether s is one of the statuses every workspace has
+func (s FeatureStatus) IsBuiltIn() bool {
+	return s == ActiveFeature || s == ArchivedFeature
+}
+
 type WorkspaceFeatures struct {
-	ID                     uint       `json:"id"`
-	Uuid                   string     `gorm:"not null" json:"uuid"`
-	WorkspaceUuid          string     `gorm:"not null" json:"workspace_uuid"`
-	Name                   string     `gorm:"not null" json:"name"`
-	Brief                  string     `json:"brief"`
-	Requirements           string     `json:"requirements"`
-	Architecture           string     `json:"architecture"`
-	Url                    string     `json:"url"`
-	Priority               int        `json:"priority"`
-	Created                *time.Time `json:"created"`
-	Updated                *time.Time `json:"updated"`
-	CreatedBy              string     `json:"created_by"`
-	UpdatedBy              string     `json:"updated_by"`
-	BountiesCountCompleted int        `gorm:"-" json:"bounties_count_completed"`
-	BountiesCountAssigned  int        `gorm:"-" json:"bounties_count_assigned"`
-	BountiesCountOpen      int        `gorm:"-" json:"bounties_count_open"`
+	ID                     uint           `json:"id"`
+	Uuid                   string         `gorm:"not null" json:"uuid"`
+	WorkspaceUuid          string         `gorm:"not null" json:"workspace_uuid"`
+	Name                   string         `gorm:"not null" json:"name"`
+	Brief                  string         `json:"brief"`
+	Requirements           string         `json:"requirements"`
+	Architecture           string         `json:"architecture"`
+	Url                    string         `json:"url"`
+	Priority               int            `json:"priority"`
+	Tags                   pq.StringArray `gorm:"type:text[];default:'{}'" json:"tags" validate:"omitempty,lte=10,dive,required,lte=30"`
+	FeatStatus             FeatureStatus  `gorm:"type:varchar(20);default:'active'" json:"feat_status"`
+	OwnerPubKey            string         `json:"owner_pubkey"`
+	Pinned                 bool           `gorm:"default:false" json:"pinned"`
+	TargetDate             *time.Time     `json:"target_date"`
+	Created                *time.Time     `json:"created"`
+	Updated                *time.Time     `json:"updated"`
+	CreatedBy              string         `json:"created_by"`
+	UpdatedBy              string         `json:"updated_by"`
+	BountiesCountCompleted int            `gorm:"-" json:"bounties_count_completed"`
+	BountiesCountAssigned  int            `gorm:"-" json:"bounties_count_assigned"`
+	BountiesCountOpen      int            `gorm:"-" json:"bounties_count_open"`
+	Warnings               []string       `gorm:"-" json:"warnings,omitempty"`
+}
+
+type PhaseStatus string
+
+const (
+	PlannedPhase   PhaseStatus = "planned"
+	ActivePhase    PhaseStatus = "active"
+	CompletedPhase PhaseStatus = "completed"
+)
+
+// IsValid reports whether s is one of the known phase statuses
+func (s PhaseStatus) IsValid() bool {
+	switch s {
+	case PlannedPhase, ActivePhase, CompletedPhase:
+		return true
+	}
+	return false
 }
 
 type FeaturePhase struct {
-	Uuid        string     `json:"uuid" gorm:"primary_key"`
-	FeatureUuid string     `json:"feature_uuid"`
-	Name        string     `json:"name"`
-	Priority    int        `json:"priority"`
-	Created     *time.Time `json:"created"`
-	Updated     *time.Time `json:"updated"`
-	CreatedBy   string     `json:"created_by"`
-	UpdatedBy   string     `json:"updated_by"`
+	Uuid         string      `json:"uuid" gorm:"primary_key"`
+	FeatureUuid  string      `json:"feature_uuid"`
+	Name         string      `json:"name"`
+	Priority     int         `json:"priority"`
+	Created      *time.Time  `json:"created"`
+	Updated      *time.Time  `json:"updated"`
+	CreatedBy    string      `json:"created_by"`
+	UpdatedBy    string      `json:"updated_by"`
+	PhaseStatus  PhaseStatus `gorm:"type:varchar(20);default:'active'" json:"phase_status" validate:"omitempty,oneof=planned active completed"`
+	AutoComplete bool        `gorm:"default:false" json:"auto_complete"`
+}
+
+// FeatureTreePhase is a phase in a FeatureTree with its bounty counts,
+// Bounties is only filled when they were asked for
+type FeatureTreePhase struct {
+	FeaturePhase
+	BountiesCountOpen      int64    `json:"bounties_count_open"`
+	BountiesCountAssigned  int64    `json:"bounties_count_assigned"`
+	BountiesCountCompleted int64    `json:"bounties_count_completed"`
+	Bounties               []Bounty `json:"bounties,omitempty"`
+}
+
+// FeatureTree nests a feature's phases and stories under it so the feature
+// page loads in one request. Stories is only filled for users who can view them
+type FeatureTree struct {
+	Feature WorkspaceFeatures  `json:"feature"`
+	Phases  []FeatureTreePhase `json:"phases"`
+	Stories []FeatureStory     `json:"stories,omitempty"`
+}
+
+// PhaseDetail bundles a phase with its bounties and bounty counts so the
+// phase view loads in one request
+type PhaseDetail struct {
+	Phase                  FeaturePhase     `json:"phase"`
+	Bounties               []BountyResponse `json:"bounties"`
+	BountiesCount          int64            `json:"bounties_count"`
+	BountiesCountOpen      int64            `json:"bounties_count_open"`
+	BountiesCountAssigned  int64            `json:"bounties_count_assigned"`
+	BountiesCountCompleted int64            `json:"bounties_count_completed"`
+}
+
+// WorkspacePhase is a phase listed across a workspace, tagged with the name
+// of the feature it belongs to
+type WorkspacePhase struct {
+	FeaturePhase
+	FeatureName string `json:"feature_name"`
+}
+
+// PhaseBountyAssignment is the outcome of assigning one bounty in a bulk
+// phase assignment, Error says why it was skipped
+type PhaseBountyAssignment struct {
+	BountyID uint   `json:"bounty_id"`
+	Assigned bool   `json:"assigned"`
+	Error    string `json:"error,omitempty"`
 }
 
 type BountyRoles struct {
@@ -611,6 +751,41 @@ type UserRoles struct {
 	Created     *time.Time `json:"created"`
 }
 
+type EffectivePermissions struct {
+	IsOwner     bool     `json:"is_owner"`
+	Permissions []string `json:"permissions"`
+}
+
+type WorkspaceInvite struct {
+	ID            uint           `json:"id"`
+	Token         string         `gorm:"unique;not null" json:"token"`
+	WorkspaceUuid string         `gorm:"not null" json:"workspace_uuid"`
+	Roles         pq.StringArray `gorm:"type:text[];default:'{}'" json:"roles"`
+	MaxUses       int            `gorm:"default:1" json:"max_uses" validate:"gte=0,lte=1000"`
+	Uses          int            `gorm:"default:0" json:"uses"`
+	ExpiresAt     *time.Time     `json:"expires_at"`
+	CreatedBy     string         `json:"created_by"`
+	Created       *time.Time     `json:"created"`
+}
+
+type WorkspaceTag struct {
+	ID            uint       `json:"id"`
+	WorkspaceUuid string     `gorm:"not null;uniqueIndex:idx_workspace_tag_name" json:"workspace_uuid"`
+	Name          string     `gorm:"not null;uniqueIndex:idx_workspace_tag_name" json:"name" validate:"required,lte=30"`
+	CreatedBy     string     `json:"created_by"`
+	Created       *time.Time `json:"created"`
+}
+
+// WorkspaceFeatureStatus is a custom feature status a workspace allows on
+// top of the built-in active and archived
+type WorkspaceFeatureStatus struct {
+	ID            uint       `json:"id"`
+	WorkspaceUuid string     `gorm:"not null;uniqueIndex:idx_workspace_feature_status_name" json:"workspace_uuid"`
+	Name          string     `gorm:"not null;uniqueIndex:idx_workspace_feature_status_name" json:"name" validate:"required,lte=20"`
+	CreatedBy     string     `json:"created_by"`
+	Created       *time.Time `json:"created"`
+}
+
 // change back to UserRoles after migration
 type WorkspaceUserRoles struct {
 	Role          string     `json:"role"`
@@ -652,6 +827,28 @@ type StatusBudget struct {
 	CompletedBudget     uint   `json:"completed_budget"`
 	CompletedCount      int64  `json:"completed_count"`
 	CompletedDifference int    `json:"completed_difference"`
+	DisplayCurrency     string `json:"display_currency"`
+}
+
+// BountyAgeBucket counts the open bounties whose age in days falls in the
+// bucket and sums their price
+type BountyAgeBucket struct {
+	Bucket    string `json:"bucket"`
+	Count     int64  `json:"count"`
+	TotalSats uint   `json:"total_sats"`
+}
+
+// FeatureBudget is the bounty budget of one feature, split by bounty status
+// the same way as StatusBudget
+type FeatureBudget struct {
+	FeatureUuid    string `json:"feature_uuid"`
+	FeatureName    string `json:"feature_name"`
+	OpenBudget     uint   `json:"open_budget"`
+	OpenCount      int64  `json:"open_count"`
+	AssignedBudget uint   `json:"assigned_budget"`
+	AssignedCount  int64  `json:"assigned_count"`
+	PaidBudget     uint   `json:"paid_budget"`
+	PaidCount      int64  `json:"paid_count"`
 }
 
 type BudgetInvoiceRequest struct {
@@ -692,15 +889,161 @@ type BudgetHistory struct {
 }
 
 type FeatureStory struct {
-	ID          uint       `json:"id"`
-	Uuid        string     `json:"uuid"`
-	FeatureUuid string     `json:"feature_uuid"`
-	Description string     `json:"description"`
-	Priority    int        `json:"priority"`
-	Created     *time.Time `json:"created"`
-	Updated     *time.Time `json:"updated"`
-	CreatedBy   string     `json:"created_by"`
-	UpdatedBy   string     `json:"updated_by"`
+	ID                 uint           `json:"id"`
+	Uuid               string         `json:"uuid"`
+	FeatureUuid        string         `json:"feature_uuid"`
+	Description        string         `json:"description"`
+	AcceptanceCriteria pq.StringArray `gorm:"type:text[];default:'{}'" json:"acceptance_criteria" validate:"omitempty,lte=20,dive,required,lte=500"`
+	Order              *int           `json:"order" validate:"omitempty,gte=0"`
+	Priority           int            `json:"priority"`
+	Created            *time.Time     `json:"created"`
+	Updated            *time.Time     `json:"updated"`
+	CreatedBy          string         `json:"created_by"`
+	UpdatedBy          string         `json:"updated_by"`
+}
+
+type FeatureStoriesDeletion struct {
+	Removed  int64    `json:"removed"`
+	NotFound []string `json:"not_found"`
+}
+
+type FeatureDependency struct {
+	ID            uint       `json:"id"`
+	FeatureUuid   string     `gorm:"not null" json:"feature_uuid"`
+	DependsOnUuid string     `gorm:"not null" json:"depends_on_uuid"`
+	Created       *time.Time `json:"created"`
+	CreatedBy     string     `json:"created_by"`
+}
+
+type FeatureActivity struct {
+	Type   string    `json:"type"`
+	ItemId string    `json:"item_id"`
+	Title  string    `json:"title"`
+	Actor  string    `json:"actor,omitempty"`
+	Date   time.Time `json:"date"`
+}
+
+type UserActivity struct {
+	Type          string    `json:"type"`
+	ItemId        string    `json:"item_id"`
+	Title         string    `json:"title"`
+	WorkspaceUuid string    `json:"workspace_uuid"`
+	Date          time.Time `json:"date"`
+}
+
+type BountyPhaseContext struct {
+	Phase   FeaturePhase      `json:"phase"`
+	Feature WorkspaceFeatures `json:"feature"`
+}
+
+// PaginatedResponse wraps a page of list results with the total number of
+// matching rows and the offset and limit used for the page
+type PaginatedResponse struct {
+	Items  interface{} `json:"items"`
+	Total  int64       `json:"total"`
+	Offset int         `json:"offset"`
+	Limit  int         `json:"limit"`
+}
+
+// BountyStatusResponse is a PaginatedResponse of bounties in one status,
+// with the summed price of every bounty in that status
+type BountyStatusResponse struct {
+	PaginatedResponse
+	Status    string `json:"status"`
+	TotalSats uint   `json:"total_sats"`
+}
+
+type FeatureOldestBounty struct {
+	Feature WorkspaceFeatures `json:"feature"`
+	Bounty  *NewBounty        `json:"bounty"`
+}
+
+type FeatureBurndown struct {
+	Date      string `json:"date"`
+	Open      int64  `json:"open"`
+	Completed int64  `json:"completed"`
+}
+
+type FeatureVelocityWeek struct {
+	WeekStart string `json:"week_start"`
+	Completed int64  `json:"completed"`
+}
+
+// FeatureVelocity is the weekly completion rate of a feature's bounties. The
+// projected finish is empty when nothing was completed in the range or no
+// bounties are left open.
+type FeatureVelocity struct {
+	Weeks           []FeatureVelocityWeek `json:"weeks"`
+	AverageWeekly   float64               `json:"average_weekly"`
+	OpenBounties    int64                 `json:"open_bounties"`
+	ProjectedFinish string                `json:"projected_finish,omitempty"`
+}
+
+// PhaseEstimatedHours sums the hour estimates of one phase's bounties.
+// Bounties without a usable estimate are counted but add no hours.
+type PhaseEstimatedHours struct {
+	PhaseUuid           string `json:"phase_uuid"`
+	PhaseName           string `json:"phase_name"`
+	Hours               int64  `json:"hours"`
+	EstimatedBounties   int64  `json:"estimated_bounties"`
+	UnestimatedBounties int64  `json:"unestimated_bounties"`
+}
+
+// FeatureEstimatedHours is the estimated work left in a feature's bounties,
+// in total and per phase
+type FeatureEstimatedHours struct {
+	FeatureUuid         string                `json:"feature_uuid"`
+	TotalHours          int64                 `json:"total_hours"`
+	EstimatedBounties   int64                 `json:"estimated_bounties"`
+	UnestimatedBounties int64                 `json:"unestimated_bounties"`
+	Phases              []PhaseEstimatedHours `json:"phases"`
+}
+
+// FeatureSnapshotData is the full state of a feature captured by a snapshot;
+// the feature carries its bounty counts at the time
+type FeatureSnapshotData struct {
+	Feature WorkspaceFeatures `json:"feature"`
+	Phases  []FeaturePhase    `json:"phases"`
+	Stories []FeatureStory    `json:"stories"`
+}
+
+// Value Marshal
+func (d FeatureSnapshotData) Value() (driver.Value, error) {
+	return json.Marshal(d)
+}
+
+// Scan Unmarshal
+func (d *FeatureSnapshotData) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, d)
+}
+
+type FeatureSnapshot struct {
+	ID          uint                `json:"id"`
+	Uuid        string              `gorm:"unique;not null" json:"uuid"`
+	FeatureUuid string              `gorm:"not null;index" json:"feature_uuid"`
+	Data        FeatureSnapshotData `gorm:"type:jsonb;not null" json:"data"`
+	CreatedBy   string              `json:"created_by"`
+	Created     *time.Time          `json:"created"`
+}
+
+// FeatureWatcher subscribes a user to a feature's updates. The websocket
+// token reaches the watcher's socket, so it is never sent out.
+type FeatureWatcher struct {
+	ID             uint       `json:"id"`
+	FeatureUuid    string     `gorm:"not null" json:"feature_uuid"`
+	OwnerPubKey    string     `gorm:"not null" json:"owner_pubkey"`
+	WebsocketToken string     `json:"-"`
+	Created        *time.Time `json:"created"`
+	Updated        *time.Time `json:"updated"`
+}
+
+type FeatureDependencies struct {
+	Upstream   []WorkspaceFeatures `json:"upstream"`
+	Downstream []WorkspaceFeatures `json:"downstream"`
 }
 
 type BudgetHistoryData struct {
@@ -743,6 +1086,17 @@ type PaymentHistoryData struct {
 	ReceiverImg  string `json:"receiver_img"`
 }
 
+type PendingPayment struct {
+	NewPaymentHistory
+	BountyTitle string `json:"bounty_title"`
+}
+
+type PendingPaymentsSummary struct {
+	Count       int              `json:"count"`
+	TotalAmount uint             `json:"total_amount"`
+	Payments    []PendingPayment `json:"payments"`
+}
+
 type PaymentData struct {
 	ID             uint        `json:"id"`
 	OrgUuid        string      `json:"org_uuid"`
@@ -792,6 +1146,19 @@ type NewInvoiceList struct {
 	Updated        *time.Time  `json:"updated"`
 }
 
+type InvoiceState string
+
+const (
+	InvoicePending InvoiceState = "pending"
+	InvoiceSettled InvoiceState = "settled"
+	InvoiceExpired InvoiceState = "expired"
+)
+
+type WorkspaceInvoice struct {
+	NewInvoiceList
+	State InvoiceState `json:"state"`
+}
+
 type UserInvoiceData struct {
 	ID             uint   `json:"id"`
 	Amount         uint   `json:"amount"`
@@ -878,6 +1245,21 @@ type BountyMetrics struct {
 	NewHuntersPaid         int64 `json:"new_hunters_paid"`
 }
 
+type PersonBountyStats struct {
+	BountiesAssigned  int64 `json:"bounties_assigned"`
+	BountiesCompleted int64 `json:"bounties_completed"`
+	BountiesPaid      int64 `json:"bounties_paid"`
+	SatsEarned        uint  `json:"sats_earned"`
+}
+
+// PersonActivityCounts counts the bounties a person posted as owner next
+// to their hunter stats, so assigned means assigned and not yet paid in
+// both places.
+type PersonActivityCounts struct {
+	BountiesPosted int64 `json:"bounties_posted"`
+	PersonBountyStats
+}
+
 type MetricsBountyCsv struct {
 	DatePosted   *time.Time `json:"date_posted"`
 	Organization string     `json:"organization"`
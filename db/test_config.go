@@ -49,6 +49,12 @@ func InitTestDB() {
 	db.AutoMigrate(&WorkspaceFeatures{})
 	db.AutoMigrate(&FeaturePhase{})
 	db.AutoMigrate(&FeatureStory{})
+	db.AutoMigrate(&FeatureDependency{})
+	db.AutoMigrate(&FeatureWatcher{})
+	db.AutoMigrate(&FeatureSnapshot{})
+	db.AutoMigrate(&WorkspaceInvite{})
+	db.AutoMigrate(&WorkspaceTag{})
+	db.AutoMigrate(&WorkspaceFeatureStatus{})
 	db.AutoMigrate(&NewBounty{})
 	db.AutoMigrate(&BudgetHistory{})
 	db.AutoMigrate(&NewPaymentHistory{})
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/stakwork/sphinx-tribes/utils"
+	"gorm.io/gorm"
 )
 
 func (db database) GetWorkspaces(r *http.Request) []Workspace {
@@ -53,11 +55,38 @@ func (db database) CreateOrEditWorkspace(m Workspace) (Workspace, error) {
 
 	if db.db.Model(&m).Where("uuid = ?", m.Uuid).Updates(&m).RowsAffected == 0 {
 		db.db.Create(&m)
+	} else {
+		// Updates skips zero values, so a workspace could never be made public
+		// again or stop requiring bounty approval
+		db.db.Model(&Workspace{}).Where("uuid = ?", m.Uuid).Updates(map[string]interface{}{
+			"private":                  m.Private,
+			"requires_bounty_approval": m.RequiresBountyApproval,
+		})
 	}
 
 	return m, nil
 }
 
+// UpdateWorkspaceProductBrief only writes the brief fields that are set, so
+// either one can be cleared without touching the other
+func (db database) UpdateWorkspaceProductBrief(uuid string, brief WorkspaceProductBrief) (Workspace, error) {
+	updates := map[string]interface{}{
+		"updated": time.Now(),
+	}
+	if brief.Mission != nil {
+		updates["mission"] = *brief.Mission
+	}
+	if brief.Tactics != nil {
+		updates["tactics"] = *brief.Tactics
+	}
+
+	if err := db.db.Model(&Workspace{}).Where("uuid = ?", uuid).Updates(updates).Error; err != nil {
+		return Workspace{}, err
+	}
+
+	return db.GetWorkspaceByUuid(uuid), nil
+}
+
 func (db database) CreateOrEditWorkspaceRepository(m WorkspaceRepositories) (WorkspaceRepositories, error) {
 	m.Name = strings.TrimSpace(m.Name)
 	m.Url = strings.TrimSpace(m.Url)
@@ -113,6 +142,53 @@ func (db database) GetWorkspaceUsersCount(uuid string) int64 {
 	return count
 }
 
+// GetWorkspaceTeam returns the workspace members with their roles and the
+// time of their latest bounty activity (created, assigned, completed or paid)
+// in the workspace, most recently active first.
+func (db database) GetWorkspaceTeam(uuid string, r *http.Request) ([]WorkspaceTeamMember, error) {
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
+
+	limitQuery := ""
+	if limit > 1 {
+		limitQuery = fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	}
+
+	ms := []WorkspaceTeamMember{}
+
+	query := `SELECT org.workspace_uuid, org.created as user_created, person.*,
+		(SELECT MAX(GREATEST(to_timestamp(bounty.created), bounty.updated, bounty.assigned_date, bounty.completion_date, bounty.paid_date))
+			FROM public.bounty AS bounty
+			WHERE bounty.workspace_uuid = org.workspace_uuid
+			AND (bounty.owner_id = org.owner_pub_key OR bounty.assignee = org.owner_pub_key)) AS last_active
+		FROM public.workspace_users AS org
+		LEFT OUTER JOIN public.people AS person ON org.owner_pub_key = person.owner_pub_key
+		WHERE org.workspace_uuid = ?
+		ORDER BY last_active DESC NULLS LAST, org.created DESC ` + limitQuery
+
+	if err := db.db.Raw(query, uuid).Scan(&ms).Error; err != nil {
+		return ms, err
+	}
+
+	roles := []WorkspaceUserRoles{}
+	if err := db.db.Where("workspace_uuid = ?", uuid).Find(&roles).Error; err != nil {
+		return ms, err
+	}
+
+	rolesByUser := make(map[string][]WorkspaceUserRoles)
+	for _, role := range roles {
+		rolesByUser[role.OwnerPubKey] = append(rolesByUser[role.OwnerPubKey], role)
+	}
+
+	for i := range ms {
+		ms[i].Roles = rolesByUser[ms[i].OwnerPubKey]
+		if ms[i].Roles == nil {
+			ms[i].Roles = []WorkspaceUserRoles{}
+		}
+	}
+
+	return ms, nil
+}
+
 func (db database) GetWorkspaceBountyCount(uuid string) int64 {
 	var count int64
 	db.db.Model(&Bounty{}).Where("workspace_uuid  = ?", uuid).Count(&count)
@@ -131,12 +207,59 @@ func (db database) CreateWorkspaceUser(orgUser WorkspaceUsers) WorkspaceUsers {
 	return orgUser
 }
 
+// CreateWorkspaceUserWithRoles creates the user and its initial roles in one
+// transaction, so a user is never left in the workspace without them
+func (db database) CreateWorkspaceUserWithRoles(orgUser WorkspaceUsers) (WorkspaceUsers, error) {
+	var user WorkspaceUsers
+	err := db.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		user, err = createWorkspaceUserWithRoles(tx, orgUser)
+		return err
+	})
+	return user, err
+}
+
+func createWorkspaceUserWithRoles(tx *gorm.DB, orgUser WorkspaceUsers) (WorkspaceUsers, error) {
+	if err := tx.Create(&orgUser).Error; err != nil {
+		return WorkspaceUsers{}, err
+	}
+
+	if len(orgUser.Roles) == 0 {
+		return orgUser, nil
+	}
+
+	roles := []WorkspaceUserRoles{}
+	for _, role := range orgUser.Roles {
+		roles = append(roles, WorkspaceUserRoles{
+			Role:          role,
+			OwnerPubKey:   orgUser.OwnerPubKey,
+			WorkspaceUuid: orgUser.WorkspaceUuid,
+			Created:       orgUser.Created,
+		})
+	}
+	if err := tx.Create(&roles).Error; err != nil {
+		return WorkspaceUsers{}, err
+	}
+	return orgUser, nil
+}
+
 func (db database) DeleteWorkspaceUser(orgUser WorkspaceUsersData, workspace_uuid string) WorkspaceUsersData {
 	db.db.Where("owner_pub_key = ?", orgUser.OwnerPubKey).Where("workspace_uuid = ?", workspace_uuid).Delete(&WorkspaceUsers{})
 	db.db.Where("owner_pub_key = ?", orgUser.OwnerPubKey).Where("workspace_uuid = ?", workspace_uuid).Delete(&UserRoles{})
 	return orgUser
 }
 
+// RemoveWorkspaceUsers removes the users and their roles together, so a
+// failed removal doesn't leave orphaned roles behind
+func (db database) RemoveWorkspaceUsers(workspace_uuid string, pubkeys []string) error {
+	return db.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("workspace_uuid = ?", workspace_uuid).Where("owner_pub_key IN ?", pubkeys).Delete(&WorkspaceUsers{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("workspace_uuid = ?", workspace_uuid).Where("owner_pub_key IN ?", pubkeys).Delete(&WorkspaceUserRoles{}).Error
+	})
+}
+
 func (db database) GetBountyRoles() []BountyRoles {
 	ms := []BountyRoles{}
 	db.db.Find(&ms)
@@ -151,6 +274,138 @@ func (db database) CreateUserRoles(roles []WorkspaceUserRoles, uuid string, pubk
 	return roles
 }
 
+func (db database) CreateWorkspaceInvite(invite WorkspaceInvite) (WorkspaceInvite, error) {
+	now := time.Now()
+	invite.Created = &now
+
+	if err := db.db.Create(&invite).Error; err != nil {
+		return WorkspaceInvite{}, err
+	}
+	return invite, nil
+}
+
+func (db database) GetWorkspaceInviteByToken(token string) WorkspaceInvite {
+	invite := WorkspaceInvite{}
+	db.db.Where("token = ?", token).Find(&invite)
+	return invite
+}
+
+// RedeemWorkspaceInvite adds pubkey to the invite's workspace with the preset
+// roles. The use is claimed with a conditional update so concurrent redeems
+// can't go past MaxUses.
+func (db database) RedeemWorkspaceInvite(invite WorkspaceInvite, pubkey string) error {
+	return db.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&WorkspaceInvite{}).Where("id = ? AND uses < max_uses", invite.ID).Update("uses", gorm.Expr("uses + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("invite has no uses left")
+		}
+
+		now := time.Now()
+		_, err := createWorkspaceUserWithRoles(tx, WorkspaceUsers{
+			OwnerPubKey:   pubkey,
+			WorkspaceUuid: invite.WorkspaceUuid,
+			Created:       &now,
+			Updated:       &now,
+			Roles:         invite.Roles,
+		})
+		return err
+	})
+}
+
+func (db database) CreateWorkspaceTag(tag WorkspaceTag) (WorkspaceTag, error) {
+	now := time.Now()
+	tag.Created = &now
+
+	if err := db.db.Create(&tag).Error; err != nil {
+		return WorkspaceTag{}, err
+	}
+	return tag, nil
+}
+
+func (db database) GetWorkspaceTags(workspace_uuid string) []WorkspaceTag {
+	ms := []WorkspaceTag{}
+	db.db.Where("workspace_uuid = ?", workspace_uuid).Order("name ASC").Find(&ms)
+	return ms
+}
+
+func (db database) DeleteWorkspaceTag(workspace_uuid string, name string) error {
+	result := db.db.Where("workspace_uuid = ? AND name = ?", workspace_uuid, name).Delete(&WorkspaceTag{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (db database) CreateWorkspaceFeatureStatus(status WorkspaceFeatureStatus) (WorkspaceFeatureStatus, error) {
+	now := time.Now()
+	status.Created = &now
+
+	if err := db.db.Create(&status).Error; err != nil {
+		return WorkspaceFeatureStatus{}, err
+	}
+	return status, nil
+}
+
+func (db database) GetWorkspaceFeatureStatuses(workspace_uuid string) []WorkspaceFeatureStatus {
+	ms := []WorkspaceFeatureStatus{}
+	db.db.Where("workspace_uuid = ?", workspace_uuid).Order("name ASC").Find(&ms)
+	return ms
+}
+
+func (db database) DeleteWorkspaceFeatureStatus(workspace_uuid string, name string) error {
+	result := db.db.Where("workspace_uuid = ? AND name = ?", workspace_uuid, name).Delete(&WorkspaceFeatureStatus{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetUserRecentActivity returns the most recently touched bounties and
+// features of the given workspaces, newest first
+func (db database) GetUserRecentActivity(workspaceUuids []string, limit int) []UserActivity {
+	activity := []UserActivity{}
+	if len(workspaceUuids) == 0 {
+		return activity
+	}
+
+	bounties := []UserActivity{}
+	db.db.Raw(`SELECT 'bounty' AS type, CAST(id AS TEXT) AS item_id, title, workspace_uuid,
+		COALESCE(updated, to_timestamp(created)) AS date
+		FROM bounty WHERE workspace_uuid IN ?
+		ORDER BY date DESC LIMIT ?`, workspaceUuids, limit).Scan(&bounties)
+
+	features := []UserActivity{}
+	db.db.Raw(`SELECT 'feature' AS type, uuid AS item_id, name AS title, workspace_uuid,
+		COALESCE(updated, created) AS date
+		FROM workspace_features WHERE workspace_uuid IN ?
+		ORDER BY date DESC LIMIT ?`, workspaceUuids, limit).Scan(&features)
+
+	activity = append(activity, bounties...)
+	activity = append(activity, features...)
+	for i := range activity {
+		activity[i].Date = activity[i].Date.UTC()
+	}
+
+	sort.SliceStable(activity, func(i, j int) bool {
+		return activity[i].Date.After(activity[j].Date)
+	})
+
+	if len(activity) > limit {
+		activity = activity[:limit]
+	}
+
+	return activity
+}
+
 func (db database) GetUserRoles(uuid string, pubkey string) []WorkspaceUserRoles {
 	ms := []WorkspaceUserRoles{}
 	db.db.Where("workspace_uuid = ?", uuid).Where("owner_pub_key = ?", pubkey).Find(&ms)
@@ -239,11 +494,62 @@ func (db database) GetWorkspaceStatusBudget(workspace_uuid string) StatusBudget
 		CompletedBudget:     completedBudget,
 		CompletedCount:      completedCount,
 		CompletedDifference: completedDifference,
+		DisplayCurrency:     db.GetWorkspaceByUuid(workspace_uuid).GetDisplayCurrency(),
 	}
 
 	return statusBudget
 }
 
+// GetWorkspaceBudgetByFeature sums the price of the bounties on each
+// feature's phases by status. Features without bounties are included with
+// zero totals, bounties outside any phase are left out.
+func (db database) GetWorkspaceBudgetByFeature(workspace_uuid string) []FeatureBudget {
+	budgets := []FeatureBudget{}
+
+	db.db.Raw(`SELECT f.uuid AS feature_uuid, f.name AS feature_name,
+		COALESCE(SUM(b.price) FILTER (WHERE b.assignee = '' AND b.paid != true), 0) AS open_budget,
+		COUNT(b.id) FILTER (WHERE b.assignee = '' AND b.paid != true) AS open_count,
+		COALESCE(SUM(b.price) FILTER (WHERE b.assignee != '' AND b.paid != true), 0) AS assigned_budget,
+		COUNT(b.id) FILTER (WHERE b.assignee != '' AND b.paid != true) AS assigned_count,
+		COALESCE(SUM(b.price) FILTER (WHERE b.paid = true), 0) AS paid_budget,
+		COUNT(b.id) FILTER (WHERE b.paid = true) AS paid_count
+		FROM public.workspace_features f
+		LEFT JOIN public.feature_phases p ON p.feature_uuid = f.uuid
+		LEFT JOIN public.bounty b ON b.phase_uuid = p.uuid
+		WHERE f.workspace_uuid = ?
+		GROUP BY f.uuid, f.name, f.priority
+		ORDER BY f.priority ASC, f.name ASC`, workspace_uuid).Scan(&budgets)
+
+	return budgets
+}
+
+// GetWorkspaceBountyAging buckets the open bounties of the workspace by
+// the whole days since they were created, always returning the 0-7d, 8-30d
+// and 31+d buckets in that order
+func (db database) GetWorkspaceBountyAging(workspace_uuid string) []BountyAgeBucket {
+	now := time.Now()
+	weekCutoff := now.AddDate(0, 0, -8).Unix()
+	monthCutoff := now.AddDate(0, 0, -31).Unix()
+
+	rows := []BountyAgeBucket{}
+	db.db.Raw(`SELECT CASE WHEN created > ? THEN '0-7d' WHEN created > ? THEN '8-30d' ELSE '31+d' END AS bucket,
+		COUNT(*) AS count, COALESCE(SUM(price), 0) AS total_sats
+		FROM public.bounty
+		WHERE workspace_uuid = ? AND `+bountyStatusConditions["open"]+`
+		GROUP BY bucket`, weekCutoff, monthCutoff, workspace_uuid).Scan(&rows)
+
+	buckets := []BountyAgeBucket{{Bucket: "0-7d"}, {Bucket: "8-30d"}, {Bucket: "31+d"}}
+	for i := range buckets {
+		for _, row := range rows {
+			if row.Bucket == buckets[i].Bucket {
+				buckets[i] = row
+			}
+		}
+	}
+
+	return buckets
+}
+
 func (db database) GetWorkspaceBudgetHistory(workspace_uuid string) []BudgetHistoryData {
 	budgetHistory := []BudgetHistoryData{}
 
@@ -273,11 +579,16 @@ func (db database) ProcessUpdateBudget(invoice NewInvoiceList) error {
 	// Get payment history and update budget
 	paymentHistory := db.GetPaymentHistoryByCreated(created, workspace_uuid)
 	if paymentHistory.WorkspaceUuid != "" && paymentHistory.Amount != 0 {
-		paymentHistory.Status = true
-
-		// Update payment history
-		if err = tx.Where("created = ?", created).Where("workspace_uuid = ? ", workspace_uuid).Updates(paymentHistory).Error; err != nil {
+		// Only flip the payment if it is still pending, so processing the
+		// same invoice twice (e.g. two concurrent polls) credits the budget once
+		result := tx.Model(&NewPaymentHistory{}).Where("created = ?", created).Where("workspace_uuid = ? ", workspace_uuid).Where("status = ?", false).Update("status", true)
+		if err = result.Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		if result.RowsAffected == 0 {
 			tx.Rollback()
+			return nil
 		}
 
 		// get Workspace budget and add payment to total budget
@@ -316,35 +627,13 @@ func (db database) ProcessUpdateBudget(invoice NewInvoiceList) error {
 }
 
 func (db database) AddAndUpdateBudget(invoice NewInvoiceList) NewPaymentHistory {
-	created := invoice.Created
-	workspace_uuid := invoice.WorkspaceUuid
-
-	paymentHistory := db.GetPaymentHistoryByCreated(created, workspace_uuid)
-
-	if paymentHistory.WorkspaceUuid != "" && paymentHistory.Amount != 0 {
-		paymentHistory.Status = true
-		db.db.Where("created = ?", created).Where("workspace_uuid = ? ", workspace_uuid).Updates(paymentHistory)
-
-		// get Workspace budget and add payment to total budget
-		WorkspaceBudget := db.GetWorkspaceBudget(workspace_uuid)
-
-		if WorkspaceBudget.WorkspaceUuid == "" {
-			now := time.Now()
-			workBudget := NewBountyBudget{
-				WorkspaceUuid: workspace_uuid,
-				TotalBudget:   paymentHistory.Amount,
-				Created:       &now,
-				Updated:       &now,
-			}
-			db.CreateWorkspaceBudget(workBudget)
-		} else {
-			totalBudget := WorkspaceBudget.TotalBudget
-			WorkspaceBudget.TotalBudget = totalBudget + paymentHistory.Amount
-			db.UpdateWorkspaceBudget(WorkspaceBudget)
-		}
+	// Credit through the guarded transaction so a budget invoice that is
+	// also picked up by the budget pollers is only added once
+	if err := db.ProcessUpdateBudget(invoice); err != nil {
+		fmt.Printf("[workspaces] could not update budget for invoice %s: %v\n", invoice.PaymentRequest, err)
 	}
 
-	return paymentHistory
+	return db.GetPaymentHistoryByCreated(invoice.Created, invoice.WorkspaceUuid)
 }
 
 func (db database) WithdrawBudget(sender_pubkey string, workspace_uuid string, amount uint) {
@@ -459,12 +748,38 @@ func (db database) GetPaymentHistory(workspace_uuid string, r *http.Request) []N
 
 	limitQuery = fmt.Sprintf("LIMIT %d  OFFSET %d", limit, offset)
 
-	query := `SELECT * FROM payment_histories WHERE workspace_uuid = '` + workspace_uuid + `' AND status = true ORDER BY created DESC`
+	keys := r.URL.Query()
+	paymentType := keys.Get("payment_type")
+	direction := keys.Get("direction")
+
+	filterQuery := ""
+	filterArgs := []interface{}{workspace_uuid}
+	if paymentType != "" {
+		filterQuery += " AND payment_type = ?"
+		filterArgs = append(filterArgs, paymentType)
+	}
+	// deposits are the only payments coming into a workspace
+	if direction == "received" {
+		filterQuery += " AND payment_type = ?"
+		filterArgs = append(filterArgs, Deposit)
+	} else if direction == "sent" {
+		filterQuery += " AND payment_type IN ?"
+		filterArgs = append(filterArgs, []PaymentType{Withdraw, Payment})
+	}
 
-	db.db.Raw(query + " " + limitQuery).Find(&payment)
+	query := `SELECT * FROM payment_histories WHERE workspace_uuid = ? AND status = true` + filterQuery + ` ORDER BY created DESC`
+
+	db.db.Raw(query+" "+limitQuery, filterArgs...).Find(&payment)
 	return payment
 }
 
+func (db database) GetWorkspacePendingPayments(workspace_uuid string) []PendingPayment {
+	payments := []PendingPayment{}
+
+	db.db.Raw(`SELECT payment.*, COALESCE(bounty.title, '') AS bounty_title FROM public.payment_histories AS payment LEFT OUTER JOIN public.bounty AS bounty ON payment.bounty_id = bounty.id WHERE payment.workspace_uuid = ? AND payment.status = false ORDER BY payment.created DESC`, workspace_uuid).Find(&payments)
+	return payments
+}
+
 func (db database) GetWorkspaceInvoices(workspace_uuid string) []NewInvoiceList {
 	ms := []NewInvoiceList{}
 	db.db.Where("workspace_uuid = ?", workspace_uuid).Where("status", false).Find(&ms)
@@ -479,6 +794,13 @@ func (db database) GetWorkspaceInvoicesCount(workspace_uuid string) int64 {
 	return count
 }
 
+// GetAllWorkspaceInvoices returns paid and unpaid invoices, newest first
+func (db database) GetAllWorkspaceInvoices(workspace_uuid string) []NewInvoiceList {
+	ms := []NewInvoiceList{}
+	db.db.Where("workspace_uuid = ?", workspace_uuid).Order("created DESC").Find(&ms)
+	return ms
+}
+
 func (db database) ChangeWorkspaceDeleteStatus(workspace_uuid string, status bool) Workspace {
 	ms := Workspace{}
 	db.db.Model(&ms).Where("uuid", workspace_uuid).Updates(map[string]interface{}{
@@ -503,6 +825,10 @@ func (db database) UpdateWorkspaceForDeletion(uuid string) error {
 	return nil
 }
 
+// ProcessDeleteWorkspace soft deletes a workspace. The deleted flag keeps it
+// out of listings and can be cleared with ChangeWorkspaceDeleteStatus, show is
+// left as it was so a restore keeps it. The cleared website, github and
+// description, workspace users and their roles are removed for good.
 func (db database) ProcessDeleteWorkspace(workspace_uuid string) error {
 	tx := db.db.Begin()
 	var err error
@@ -521,7 +847,6 @@ func (db database) ProcessDeleteWorkspace(workspace_uuid string) error {
 		"website":     "",
 		"github":      "",
 		"description": "",
-		"show":        false,
 	}
 
 	// Update workspace
@@ -549,6 +874,21 @@ func (db database) ProcessDeleteWorkspace(workspace_uuid string) error {
 	return tx.Commit().Error
 }
 
+// GetWorkspaceDeletionPreview returns the number of records tied to a
+// workspace without changing anything. Users and roles are removed by
+// ProcessDeleteWorkspace, the rest stay behind the soft deleted workspace.
+func (db database) GetWorkspaceDeletionPreview(workspace_uuid string) WorkspaceDeletionPreview {
+	preview := WorkspaceDeletionPreview{}
+
+	db.db.Model(&WorkspaceUsers{}).Where("workspace_uuid = ?", workspace_uuid).Count(&preview.Users)
+	db.db.Model(&WorkspaceUserRoles{}).Where("workspace_uuid = ?", workspace_uuid).Count(&preview.UserRoles)
+	db.db.Model(&WorkspaceFeatures{}).Where("workspace_uuid = ?", workspace_uuid).Count(&preview.Features)
+	db.db.Model(&NewBounty{}).Where("workspace_uuid = ?", workspace_uuid).Count(&preview.Bounties)
+	db.db.Model(&NewInvoiceList{}).Where("workspace_uuid = ?", workspace_uuid).Count(&preview.Invoices)
+
+	return preview
+}
+
 func (db database) DeleteAllUsersFromWorkspace(workspace_uuid string) error {
 	if workspace_uuid == "" {
 		return errors.New("no workspoace uuid provided")
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessUpdateBudget(t *testing.T) {
+	InitTestDB()
+
+	workspaceUuid := xid.New().String()
+	now := time.Now()
+
+	TestDB.AddPaymentHistory(NewPaymentHistory{
+		Amount:        1000,
+		PaymentType:   Deposit,
+		WorkspaceUuid: workspaceUuid,
+		Created:       &now,
+		Updated:       &now,
+		Status:        false,
+	})
+	invoice := TestDB.AddInvoice(NewInvoiceList{
+		PaymentRequest: xid.New().String(),
+		Type:           Budget,
+		WorkspaceUuid:  workspaceUuid,
+		Created:        &now,
+		Updated:        &now,
+		Status:         false,
+	})
+
+	assert.NoError(t, TestDB.ProcessUpdateBudget(invoice))
+	assert.NoError(t, TestDB.ProcessUpdateBudget(invoice))
+
+	assert.Equal(t, uint(1000), TestDB.GetWorkspaceBudget(workspaceUuid).TotalBudget)
+	assert.True(t, TestDB.GetPaymentHistoryByCreated(&now, workspaceUuid).Status)
+	assert.True(t, TestDB.GetInvoice(invoice.PaymentRequest).Status)
+}
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -65,6 +66,56 @@ func (h *bountyHandler) GetBountyById(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *bountyHandler) GetBountyPhaseContext(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		fmt.Println("[bounty] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	id, err := utils.ConvertStringToUint(chi.URLParam(r, "bountyId"))
+	if err != nil {
+		fmt.Println("[bounty] could not parse id")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	bounty := h.db.GetBounty(id)
+	if bounty.ID != id {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Bounty not found")
+		return
+	}
+
+	if bounty.PhaseUuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Bounty is not linked to a phase")
+		return
+	}
+
+	phase, err := h.db.GetPhaseByUuid(bounty.PhaseUuid)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Bounty is not linked to a phase")
+		return
+	}
+
+	feature := h.db.GetFeatureByUuid(phase.FeatureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Bounty is not linked to a feature")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(db.BountyPhaseContext{
+		Phase:   phase,
+		Feature: feature,
+	})
+}
+
 func (h *bountyHandler) GetNextBountyByCreated(w http.ResponseWriter, r *http.Request) {
 	bounties, err := h.db.GetNextBountyByCreated(r)
 	if err != nil {
@@ -228,6 +279,16 @@ func (h *bountyHandler) CreateOrEditBounty(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	// bounties are tagged by their coding languages, so those are what the
+	// strict vocabulary applies to
+	if config.StrictWorkspaceTags && bounty.WorkspaceUuid != "" && len(bounty.CodingLanguages) > 0 {
+		if unknown := unknownWorkspaceTags(h.db, bounty.WorkspaceUuid, bounty.CodingLanguages); len(unknown) > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(fmt.Sprintf("Error: tags are not in the workspace vocabulary: %s", strings.Join(unknown, ", ")))
+			return
+		}
+	}
+
 	if bounty.Assignee != "" {
 		now := time.Now()
 		bounty.AssignedDate = &now
@@ -273,6 +334,12 @@ func (h *bountyHandler) CreateOrEditBounty(w http.ResponseWriter, r *http.Reques
 				return
 			}
 		}
+
+		// only ApproveBounty can publish a bounty held for approval
+		bounty.PendingApproval = dbBounty.PendingApproval
+		if bounty.PendingApproval {
+			bounty.Show = false
+		}
 	}
 
 	if bounty.PhaseUuid != "" {
@@ -289,6 +356,24 @@ func (h *bountyHandler) CreateOrEditBounty(w http.ResponseWriter, r *http.Reques
 		}
 	}
 
+	if bounty.ID == 0 {
+		bounty.PendingApproval = false
+	}
+
+	if bounty.ID == 0 && bounty.WorkspaceUuid != "" {
+		workspace := h.db.GetWorkspaceByUuid(bounty.WorkspaceUuid)
+
+		// new workspace bounties without a price get the workspace default
+		if bounty.Price == 0 && workspace.DefaultBountyPrice > 0 {
+			bounty.Price = uint(workspace.DefaultBountyPrice)
+		}
+
+		if workspace.RequiresBountyApproval {
+			bounty.Show = false
+			bounty.PendingApproval = true
+		}
+	}
+
 	b, err := h.db.CreateOrEditBounty(bounty)
 	if err != nil {
 		fmt.Println("[bounty]", err)
@@ -300,6 +385,125 @@ func (h *bountyHandler) CreateOrEditBounty(w http.ResponseWriter, r *http.Reques
 	json.NewEncoder(w).Encode(b)
 }
 
+// ApproveBounty publishes a bounty held back by the workspace approval
+// workflow, only bounty managers of the workspace may approve
+func (h *bountyHandler) ApproveBounty(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	idParam := chi.URLParam(r, "id")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[bounty] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	id, err := utils.ConvertStringToUint(idParam)
+	if err != nil {
+		fmt.Println("[bounty] could not parse id")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	bounty := h.db.GetBounty(id)
+	if bounty.ID != id {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Bounty not found")
+		return
+	}
+
+	if bounty.WorkspaceUuid == "" || !h.userHasManageBountyRoles(pubKeyFromAuth, bounty.WorkspaceUuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("You don't have the right permission to approve bounties")
+		return
+	}
+
+	if !bounty.PendingApproval {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Bounty is not pending approval")
+		return
+	}
+
+	b, err := h.db.ApproveBounty(id)
+	if err != nil {
+		fmt.Println("[bounty]", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(b)
+}
+
+func (h *bountyHandler) AssignBountyToSelf(w http.ResponseWriter, r *http.Request) {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	idParam := chi.URLParam(r, "id")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[bounty] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	id, err := utils.ConvertStringToUint(idParam)
+	if err != nil {
+		fmt.Println("[bounty] could not parse id")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	bounty := h.db.GetBounty(id)
+	if bounty.ID != id {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Bounty not found")
+		return
+	}
+
+	if bounty.Assignee != "" {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode("Bounty is already assigned")
+		return
+	}
+
+	if bounty.Paid {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Bounty has already been paid")
+		return
+	}
+
+	// bounties still waiting for approval, or hidden, are not open to take
+	if bounty.PendingApproval || !bounty.Show {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Bounty is not open for assignment")
+		return
+	}
+
+	if bounty.WorkspaceUuid == "" || !isWorkspaceMember(h.db, pubKeyFromAuth, bounty.WorkspaceUuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Only workspace members can assign themselves to this bounty")
+		return
+	}
+
+	now := time.Now()
+	bounty.Assignee = pubKeyFromAuth
+	bounty.AssignedDate = &now
+	bounty.Updated = &now
+
+	b, err := h.db.UpdateBounty(bounty)
+	if err != nil {
+		fmt.Println("[bounty]", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(b)
+}
+
 func (h *bountyHandler) DeleteBounty(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
@@ -469,14 +673,16 @@ func (h *bountyHandler) GenerateBountyResponse(bounties []db.NewBounty) []db.Bou
 				TwitterConfirmed: owner.TwitterConfirmed,
 			},
 			Organization: db.WorkspaceShort{
-				Name: workspace.Name,
-				Uuid: workspace.Uuid,
-				Img:  workspace.Img,
+				Name:            workspace.Name,
+				Uuid:            workspace.Uuid,
+				Img:             workspace.Img,
+				DisplayCurrency: workspace.GetDisplayCurrency(),
 			},
 			Workspace: db.WorkspaceShort{
-				Name: workspace.Name,
-				Uuid: workspace.Uuid,
-				Img:  workspace.Img,
+				Name:            workspace.Name,
+				Uuid:            workspace.Uuid,
+				Img:             workspace.Img,
+				DisplayCurrency: workspace.GetDisplayCurrency(),
 			},
 		}
 		bountyResponse = append(bountyResponse, b)
@@ -638,6 +844,8 @@ func (h *bountyHandler) MakeBountyPayment(w http.ResponseWriter, r *http.Request
 		if err == nil {
 			socket.Conn.WriteJSON(msg)
 		}
+
+		h.completePhaseIfDone(bounty.PhaseUuid, request.Websocket_token)
 	} else {
 		msg["msg"] = "keysend_error"
 		msg["invoice"] = ""
@@ -651,6 +859,52 @@ func (h *bountyHandler) MakeBountyPayment(w http.ResponseWriter, r *http.Request
 	h.m.Unlock()
 }
 
+// completePhaseIfDone marks an auto complete phase as completed once none of
+// its bounties are open or assigned, and tells the payer and the feature's
+// watchers over the websocket
+func (h *bountyHandler) completePhaseIfDone(phaseUuid string, websocketToken string) {
+	if phaseUuid == "" {
+		return
+	}
+
+	phase, err := h.db.GetPhaseByUuid(phaseUuid)
+	if err != nil || !phase.AutoComplete || phase.PhaseStatus == db.CompletedPhase {
+		return
+	}
+
+	if h.db.GetFeaturePhasesBountiesCount("open", phaseUuid) > 0 || h.db.GetFeaturePhasesBountiesCount("assigned", phaseUuid) > 0 {
+		return
+	}
+
+	phase.PhaseStatus = db.CompletedPhase
+	if _, err := h.db.CreateOrEditFeaturePhase(phase); err != nil {
+		fmt.Println("[bounty] could not complete phase", err)
+		return
+	}
+
+	msg := make(map[string]interface{})
+	msg["msg"] = "phase_completed"
+	msg["phase_uuid"] = phase.Uuid
+	msg["feature_uuid"] = phase.FeatureUuid
+
+	tokens := []string{websocketToken}
+	for _, watcher := range h.db.GetFeatureWatchers(phase.FeatureUuid) {
+		if watcher.WebsocketToken != websocketToken {
+			tokens = append(tokens, watcher.WebsocketToken)
+		}
+	}
+
+	for _, token := range tokens {
+		if token == "" {
+			continue
+		}
+		socket, err := h.getSocketConnections(token)
+		if err == nil {
+			socket.Conn.WriteJSON(msg)
+		}
+	}
+}
+
 func (h *bountyHandler) BountyBudgetWithdraw(w http.ResponseWriter, r *http.Request) {
 	h.m.Lock()
 
@@ -935,7 +1189,9 @@ func (h *bountyHandler) PollInvoice(w http.ResponseWriter, r *http.Request) {
 		// Make any change only if the invoice has not been settled
 		if !dbInvoice.Status {
 			if invoice.Type == "BUDGET" {
-				h.db.AddAndUpdateBudget(invoice)
+				if err := h.db.ProcessUpdateBudget(invoice); err != nil {
+					log.Printf("[bounty] could not update budget for invoice %s: %v", paymentRequest, err)
+				}
 			} else if invoice.Type == "KEYSEND" {
 				url := fmt.Sprintf("%s/payment", config.RelayUrl)
 
@@ -976,6 +1232,7 @@ func (h *bountyHandler) PollInvoice(w http.ResponseWriter, r *http.Request) {
 					}
 
 					h.db.UpdateBounty(bounty)
+					h.completePhaseIfDone(bounty.PhaseUuid, "")
 				} else {
 					// Unmarshal result
 					keysendError := db.KeysendError{}
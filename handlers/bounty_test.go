@@ -20,6 +20,8 @@ import (
 	"github.com/stakwork/sphinx-tribes/utils"
 
 	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
 	"github.com/stakwork/sphinx-tribes/auth"
 	"github.com/stakwork/sphinx-tribes/config"
 	"github.com/stakwork/sphinx-tribes/db"
@@ -337,6 +339,40 @@ func TestCreateOrEditBounty(t *testing.T) {
 		handler.ServeHTTP(rr, req)
 		assert.Equal(t, http.StatusOK, rr.Code)
 	})
+
+	t.Run("should apply the workspace default price to a new bounty without a price", func(t *testing.T) {
+		workspace := db.Workspace{
+			Uuid:               uuid.New().String(),
+			Name:               uuid.New().String(),
+			OwnerPubKey:        "test-key",
+			Description:        "Default price workspace",
+			DefaultBountyPrice: 5000,
+		}
+		db.TestDB.CreateOrEditWorkspace(workspace)
+
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(bHandler.CreateOrEditBounty)
+
+		body, _ := json.Marshal(db.NewBounty{
+			Type:          "coding",
+			Title:         "default price bounty",
+			Description:   "default price bounty description",
+			WorkspaceUuid: workspace.Uuid,
+			OwnerID:       "test-key",
+		})
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var returnedBounty db.NewBounty
+		err = json.Unmarshal(rr.Body.Bytes(), &returnedBounty)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5000), returnedBounty.Price)
+	})
 }
 
 func TestPayLightningInvoice(t *testing.T) {
@@ -451,6 +487,100 @@ func TestPayLightningInvoice(t *testing.T) {
 
 }
 
+func TestAssignBountyToSelf(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+
+	mockHttpClient := mocks.NewHttpClient(t)
+	bHandler := NewBountyHandler(mockHttpClient, db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: bountyOwner.OwnerPubKey,
+		Description: "Assign workspace description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	now := time.Now()
+	db.TestDB.CreateWorkspaceUser(db.WorkspaceUsers{
+		OwnerPubKey:   bountyAssignee.OwnerPubKey,
+		WorkspaceUuid: workspace.Uuid,
+		Created:       &now,
+	})
+
+	createBounty := func(assignee string, pendingApproval bool) db.NewBounty {
+		bounty := db.NewBounty{
+			Type:            "coding",
+			Title:           "Assign to self bounty",
+			Description:     "Assign to self bounty description",
+			WorkspaceUuid:   workspace.Uuid,
+			Assignee:        assignee,
+			OwnerID:         bountyOwner.OwnerPubKey,
+			Show:            true,
+			PendingApproval: pendingApproval,
+			Created:         time.Now().UnixNano(),
+		}
+		db.TestDB.CreateOrEditBounty(bounty)
+
+		bountyInDb, err := db.TestDB.GetBountyByCreated(uint(bounty.Created))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bountyInDb
+	}
+
+	assign := func(id uint, pubkey string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", strconv.Itoa(int(id)))
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/assign/"+strconv.Itoa(int(id)), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(bHandler.AssignBountyToSelf).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should assign an open bounty to a workspace member", func(t *testing.T) {
+		bounty := createBounty("", false)
+
+		rr := assign(bounty.ID, bountyAssignee.OwnerPubKey)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, bountyAssignee.OwnerPubKey, db.TestDB.GetBounty(bounty.ID).Assignee)
+	})
+
+	t.Run("should return 409 if the bounty is already assigned", func(t *testing.T) {
+		bounty := createBounty(bountyOwner.OwnerPubKey, false)
+
+		rr := assign(bounty.ID, bountyAssignee.OwnerPubKey)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, bountyOwner.OwnerPubKey, db.TestDB.GetBounty(bounty.ID).Assignee)
+	})
+
+	t.Run("should return 401 if the caller is not a workspace member", func(t *testing.T) {
+		bounty := createBounty("", false)
+
+		rr := assign(bounty.ID, "non_member_pubkey")
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Empty(t, db.TestDB.GetBounty(bounty.ID).Assignee)
+	})
+
+	t.Run("should return 400 if the bounty is pending approval", func(t *testing.T) {
+		bounty := createBounty("", true)
+
+		rr := assign(bounty.ID, bountyAssignee.OwnerPubKey)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Empty(t, db.TestDB.GetBounty(bounty.ID).Assignee)
+	})
+}
+
 func TestDeleteBounty(t *testing.T) {
 	teardownSuite := SetupSuite(t)
 	defer teardownSuite(t)
@@ -1048,6 +1178,87 @@ func TestGetBountyById(t *testing.T) {
 	})
 }
 
+func TestGetBountyPhaseContext(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+
+	mockHttpClient := mocks.NewHttpClient(t)
+	bHandler := NewBountyHandler(mockHttpClient, db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: bountyOwner.OwnerPubKey,
+		Description: "Phase context workspace",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Phase context feature",
+	})
+	phase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "Phase context phase",
+	})
+
+	createBounty := func(phaseUuid string) db.NewBounty {
+		bounty := db.NewBounty{
+			Type:          "coding",
+			Title:         "Phase context bounty",
+			Description:   "Phase context bounty description",
+			WorkspaceUuid: workspace.Uuid,
+			PhaseUuid:     phaseUuid,
+			OwnerID:       bountyOwner.OwnerPubKey,
+			Created:       time.Now().UnixNano(),
+		}
+		db.TestDB.CreateOrEditBounty(bounty)
+
+		bountyInDb, err := db.TestDB.GetBountyByCreated(uint(bounty.Created))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bountyInDb
+	}
+
+	getPhaseContext := func(id uint) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("bountyId", strconv.Itoa(int(id)))
+		ctx := context.WithValue(context.Background(), auth.ContextKey, bountyOwner.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/phase_context/"+strconv.Itoa(int(id)), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(bHandler.GetBountyPhaseContext).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return the phase and feature of a linked bounty", func(t *testing.T) {
+		bounty := createBounty(phase.Uuid)
+
+		rr := getPhaseContext(bounty.ID)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var phaseContext db.BountyPhaseContext
+		err := json.Unmarshal(rr.Body.Bytes(), &phaseContext)
+		assert.NoError(t, err)
+		assert.Equal(t, phase.Uuid, phaseContext.Phase.Uuid)
+		assert.Equal(t, feature.Uuid, phaseContext.Feature.Uuid)
+	})
+
+	t.Run("should return 404 for a bounty without a phase", func(t *testing.T) {
+		bounty := createBounty("")
+
+		rr := getPhaseContext(bounty.ID)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
 func TestGetBountyIndexById(t *testing.T) {
 	teardownSuite := SetupSuite(t)
 	defer teardownSuite(t)
@@ -1429,6 +1640,66 @@ func TestMakeBountyPayment(t *testing.T) {
 		mockDb2.AssertExpectations(t)
 		mockHttpClient2.AssertExpectations(t)
 	})
+	t.Run("should auto complete the phase when its last bounty is paid", func(t *testing.T) {
+		mockDb := dbMocks.NewDatabase(t)
+		mockHttpClient := mocks.NewHttpClient(t)
+		bHandler := NewBountyHandler(mockHttpClient, mockDb)
+		bHandler.userHasAccess = mockUserHasAccessTrue
+
+		sockets := []string{}
+		bHandler.getSocketConnections = func(host string) (db.Client, error) {
+			sockets = append(sockets, host)
+			return mockGetSocketConnections(host)
+		}
+
+		phaseBounty := bounty
+		phaseBounty.PhaseUuid = "phase-1"
+		phase := db.FeaturePhase{Uuid: "phase-1", FeatureUuid: "feature-1", AutoComplete: true, PhaseStatus: db.ActivePhase}
+
+		mockDb.On("GetBounty", bountyID).Return(phaseBounty, nil)
+		mockDb.On("GetWorkspaceBudget", phaseBounty.WorkspaceUuid).Return(db.NewBountyBudget{TotalBudget: 2000}, nil)
+		mockDb.On("GetPersonByPubkey", phaseBounty.Assignee).Return(db.Person{OwnerPubKey: "assignee-1", OwnerRouteHint: "OwnerRouteHint"}, nil)
+		mockDb.On("ProcessBountyPayment", mock.AnythingOfType("db.NewPaymentHistory"), mock.AnythingOfType("db.NewBounty")).Return(nil)
+		mockDb.On("GetPhaseByUuid", "phase-1").Return(phase, nil)
+		mockDb.On("GetFeaturePhasesBountiesCount", "open", "phase-1").Return(int64(0))
+		mockDb.On("GetFeaturePhasesBountiesCount", "assigned", "phase-1").Return(int64(0))
+		mockDb.On("CreateOrEditFeaturePhase", mock.MatchedBy(func(p db.FeaturePhase) bool {
+			return p.Uuid == "phase-1" && p.PhaseStatus == db.CompletedPhase
+		})).Return(phase, nil).Once()
+		mockDb.On("GetFeatureWatchers", "feature-1").Return([]db.FeatureWatcher{{OwnerPubKey: "watcher", WebsocketToken: "watcher-token"}})
+
+		mockHttpClient.On("Do", mock.Anything).Return(&http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(bytes.NewReader([]byte(`{"success": true, "response": { "sumAmount": "1"}}`))),
+		}, nil).Once()
+
+		ro := chi.NewRouter()
+		ro.Post("/gobounties/pay/{id}", bHandler.MakeBountyPayment)
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(authorizedCtx, http.MethodPost, "/gobounties/pay/1", bytes.NewBufferString(`{"websocket_token": "payer-token"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ro.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, []string{"payer-token", "payer-token", "watcher-token"}, sockets)
+	})
+
+	t.Run("should leave the phase active while bounties remain assigned", func(t *testing.T) {
+		mockDb := dbMocks.NewDatabase(t)
+		bHandler := NewBountyHandler(mocks.NewHttpClient(t), mockDb)
+
+		mockDb.On("GetPhaseByUuid", "phase-1").Return(db.FeaturePhase{Uuid: "phase-1", AutoComplete: true}, nil)
+		mockDb.On("GetFeaturePhasesBountiesCount", "open", "phase-1").Return(int64(0))
+		mockDb.On("GetFeaturePhasesBountiesCount", "assigned", "phase-1").Return(int64(1))
+
+		bHandler.completePhaseIfDone("phase-1", "")
+
+		mockDb.AssertNotCalled(t, "CreateOrEditFeaturePhase", mock.Anything)
+	})
 }
 
 func TestBountyBudgetWithdraw(t *testing.T) {
@@ -1824,15 +2095,25 @@ func TestPollInvoice(t *testing.T) {
 	t.Run("If the invoice is settled and the invoice.Type is equal to BUDGET the invoice amount should be added to the workspace budget and the payment status of the related invoice should be sent to true on the payment history table", func(t *testing.T) {
 		db.TestDB.DeleteInvoice(paymentRequest)
 
+		workspaceUuid := xid.New().String()
 		invoice := db.NewInvoiceList{
 			PaymentRequest: paymentRequest,
 			Status:         false,
+			Type:           "BUDGET",
 			OwnerPubkey:    "owner_pubkey",
-			WorkspaceUuid:  "workspace_uuid",
+			WorkspaceUuid:  workspaceUuid,
 			Created:        &now,
 		}
 
 		db.TestDB.AddInvoice(invoice)
+		db.TestDB.AddPaymentHistory(db.NewPaymentHistory{
+			Amount:        bountyAmount,
+			PaymentType:   db.Deposit,
+			WorkspaceUuid: workspaceUuid,
+			Created:       &now,
+			Updated:       &now,
+			Status:        false,
+		})
 
 		ctx := context.Background()
 		mockHttpClient := &mocks.HttpClient{}
@@ -1862,5 +2143,210 @@ func TestPollInvoice(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, rr.Code)
 		mockHttpClient.AssertExpectations(t)
+
+		// a budget poller picking up the same invoice must not credit it again
+		assert.NoError(t, db.TestDB.ProcessUpdateBudget(invoice))
+
+		assert.Equal(t, bountyAmount, db.TestDB.GetWorkspaceBudget(workspaceUuid).TotalBudget)
+		assert.True(t, db.TestDB.GetPaymentHistoryByCreated(&now, workspaceUuid).Status)
+		assert.True(t, db.TestDB.GetInvoice(paymentRequest).Status)
+	})
+}
+
+func TestBountyApproval(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	mockHttpClient := mocks.NewHttpClient(t)
+	bHandler := NewBountyHandler(mockHttpClient, mockDb)
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "manager-key")
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key", RequiresBountyApproval: true}
+
+	approve := func(id string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/approve/"+id, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(bHandler.ApproveBounty).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should hold a new bounty for approval when the workspace requires it", func(t *testing.T) {
+		bounty := db.NewBounty{
+			Type:          "coding",
+			Title:         "held bounty",
+			Description:   "held bounty description",
+			OwnerID:       "owner-key",
+			WorkspaceUuid: workspace.Uuid,
+			Price:         1000,
+			Show:          true,
+		}
+		mockDb.On("UpdateBountyNullColumn", mock.AnythingOfType("db.NewBounty"), "assignee").Return(db.NewBounty{}).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("CreateOrEditBounty", mock.MatchedBy(func(b db.NewBounty) bool {
+			return !b.Show && b.PendingApproval
+		})).Return(db.NewBounty{ID: 1, Title: bounty.Title, PendingApproval: true}, nil).Once()
+
+		body, _ := json.Marshal(bounty)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(bHandler.CreateOrEditBounty).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		created := db.NewBounty{}
+		err = json.Unmarshal(rr.Body.Bytes(), &created)
+		assert.NoError(t, err)
+		assert.True(t, created.PendingApproval)
+		assert.False(t, created.Show)
+	})
+
+	t.Run("should return 401 when the user cannot manage bounties", func(t *testing.T) {
+		bHandler.userHasManageBountyRoles = func(pubKeyFromAuth string, uuid string) bool {
+			return false
+		}
+		mockDb.On("GetBounty", uint(1)).Return(db.NewBounty{ID: 1, WorkspaceUuid: workspace.Uuid, PendingApproval: true}).Once()
+
+		rr := approve("1")
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return 400 for a bounty that is not pending", func(t *testing.T) {
+		bHandler.userHasManageBountyRoles = func(pubKeyFromAuth string, uuid string) bool {
+			return true
+		}
+		mockDb.On("GetBounty", uint(2)).Return(db.NewBounty{ID: 2, WorkspaceUuid: workspace.Uuid, Show: true}).Once()
+
+		rr := approve("2")
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should publish an approved bounty", func(t *testing.T) {
+		bHandler.userHasManageBountyRoles = func(pubKeyFromAuth string, uuid string) bool {
+			return pubKeyFromAuth == "manager-key" && uuid == workspace.Uuid
+		}
+		mockDb.On("GetBounty", uint(1)).Return(db.NewBounty{ID: 1, WorkspaceUuid: workspace.Uuid, PendingApproval: true}).Once()
+		mockDb.On("ApproveBounty", uint(1)).Return(db.NewBounty{ID: 1, WorkspaceUuid: workspace.Uuid, Show: true}, nil).Once()
+
+		rr := approve("1")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		approved := db.NewBounty{}
+		err := json.Unmarshal(rr.Body.Bytes(), &approved)
+		assert.NoError(t, err)
+		assert.True(t, approved.Show)
+		assert.False(t, approved.PendingApproval)
+	})
+
+	editBounty := func(bounty db.NewBounty) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(bounty)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(bHandler.CreateOrEditBounty).ServeHTTP(rr, req)
+		return rr
+	}
+
+	pendingEdit := db.NewBounty{
+		ID:            3,
+		Type:          "coding",
+		Title:         "held bounty",
+		Description:   "held bounty description",
+		OwnerID:       "owner-key",
+		WorkspaceUuid: workspace.Uuid,
+		Show:          true,
+	}
+
+	t.Run("should not touch a pending bounty edited without permission", func(t *testing.T) {
+		bHandler.userHasManageBountyRoles = func(pubKeyFromAuth string, uuid string) bool {
+			return false
+		}
+		mockDb.On("UpdateBountyNullColumn", mock.AnythingOfType("db.NewBounty"), "assignee").Return(db.NewBounty{}).Once()
+		mockDb.On("GetBounty", uint(3)).Return(db.NewBounty{ID: 3, OwnerID: "owner-key", WorkspaceUuid: workspace.Uuid, PendingApproval: true}).Once()
+
+		rr := editBounty(pendingEdit)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockDb.AssertNotCalled(t, "UpdateBountyBoolColumn", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should keep a pending bounty hidden when it is edited", func(t *testing.T) {
+		bHandler.userHasManageBountyRoles = func(pubKeyFromAuth string, uuid string) bool {
+			return true
+		}
+		mockDb.On("UpdateBountyNullColumn", mock.AnythingOfType("db.NewBounty"), "assignee").Return(db.NewBounty{}).Once()
+		mockDb.On("GetBounty", uint(3)).Return(db.NewBounty{ID: 3, OwnerID: "owner-key", WorkspaceUuid: workspace.Uuid, PendingApproval: true}).Once()
+		mockDb.On("CreateOrEditBounty", mock.MatchedBy(func(b db.NewBounty) bool {
+			return !b.Show && b.PendingApproval
+		})).Return(db.NewBounty{ID: 3, Title: pendingEdit.Title, PendingApproval: true}, nil).Once()
+
+		rr := editBounty(pendingEdit)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		mockDb.AssertNotCalled(t, "UpdateBountyBoolColumn", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCreateOrEditBountyStrictTags(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	mockHttpClient := mocks.NewHttpClient(t)
+	bHandler := NewBountyHandler(mockHttpClient, mockDb)
+
+	config.StrictWorkspaceTags = true
+	defer func() { config.StrictWorkspaceTags = false }()
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "owner-key")
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key"}
+
+	createBounty := func(languages []string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(db.NewBounty{
+			Type:            "coding",
+			Title:           "tagged bounty",
+			Description:     "tagged bounty description",
+			OwnerID:         "owner-key",
+			WorkspaceUuid:   workspace.Uuid,
+			Price:           1000,
+			CodingLanguages: languages,
+		})
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(bHandler.CreateOrEditBounty).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 400 for a tag outside the workspace vocabulary", func(t *testing.T) {
+		mockDb.On("GetWorkspaceTags", workspace.Uuid).Return([]db.WorkspaceTag{{WorkspaceUuid: workspace.Uuid, Name: "golang"}}).Once()
+
+		rr := createBounty([]string{"Golang", "Cobol"})
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Cobol")
+		mockDb.AssertNotCalled(t, "CreateOrEditBounty", mock.Anything)
+	})
+
+	t.Run("should create a bounty tagged from the workspace vocabulary", func(t *testing.T) {
+		mockDb.On("GetWorkspaceTags", workspace.Uuid).Return([]db.WorkspaceTag{{WorkspaceUuid: workspace.Uuid, Name: "golang"}}).Once()
+		mockDb.On("UpdateBountyNullColumn", mock.AnythingOfType("db.NewBounty"), "assignee").Return(db.NewBounty{}).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("CreateOrEditBounty", mock.AnythingOfType("db.NewBounty")).Return(db.NewBounty{ID: 1, Title: "tagged bounty"}, nil).Once()
+
+		rr := createBounty([]string{"Golang"})
+
+		assert.Equal(t, http.StatusOK, rr.Code)
 	})
 }
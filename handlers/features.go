@@ -1,27 +1,45 @@
 package handlers
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/rs/xid"
 	"github.com/stakwork/sphinx-tribes/auth"
+	"github.com/stakwork/sphinx-tribes/config"
 	"github.com/stakwork/sphinx-tribes/db"
+	"github.com/stakwork/sphinx-tribes/utils"
+	"gorm.io/gorm"
 )
 
+const maxBurndownDays = 366
+
+const minFeatureNameLength = 3
+
 type featureHandler struct {
 	db                    db.Database
 	generateBountyHandler func(bounties []db.NewBounty) []db.BountyResponse
+	getSocketConnections  func(host string) (db.Client, error)
+	userHasAccess         func(pubKeyFromAuth string, uuid string, role string) bool
 }
 
 func NewFeatureHandler(database db.Database) *featureHandler {
 	bHandler := NewBountyHandler(http.DefaultClient, database)
+	dbConf := db.NewDatabaseConfig(&gorm.DB{})
 	return &featureHandler{
 		db:                    database,
 		generateBountyHandler: bHandler.GenerateBountyResponse,
+		getSocketConnections:  db.Store.GetSocketConnections,
+		userHasAccess:         dbConf.UserHasAccess,
 	}
 }
 
@@ -29,7 +47,7 @@ func (oh *featureHandler) CreateOrEditFeatures(w http.ResponseWriter, r *http.Re
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	if pubKeyFromAuth == "" {
-		fmt.Println("no pubkey from auth")
+		utils.Log.Info(ctx, "no pubkey from auth")
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
@@ -40,13 +58,46 @@ func (oh *featureHandler) CreateOrEditFeatures(w http.ResponseWriter, r *http.Re
 	err := json.Unmarshal(body, &features)
 
 	if err != nil {
-		fmt.Println(err)
+		utils.Log.Error(ctx, "could not decode feature: %v", err)
 		w.WriteHeader(http.StatusNotAcceptable)
 		return
 	}
 
 	features.CreatedBy = pubKeyFromAuth
 
+	for i, tag := range features.Tags {
+		features.Tags[i] = strings.TrimSpace(tag)
+	}
+
+	if config.StrictWorkspaceTags && len(features.Tags) > 0 {
+		if unknown := unknownWorkspaceTags(oh.db, features.WorkspaceUuid, features.Tags); len(unknown) > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(fmt.Sprintf("Error: tags are not in the workspace vocabulary: %s", strings.Join(unknown, ", ")))
+			return
+		}
+	}
+
+	// a past target date is only accepted when it is already saved on the
+	// feature, so overdue features can still be edited
+	if features.TargetDate != nil && !features.TargetDate.After(time.Now()) {
+		existing := db.WorkspaceFeatures{}
+		if features.Uuid != "" {
+			existing = oh.db.GetFeatureByUuid(features.Uuid)
+		}
+		if existing.TargetDate == nil || !existing.TargetDate.Equal(*features.TargetDate) {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode("Error: target date must be in the future")
+			return
+		}
+	}
+
+	features.Url = strings.TrimSpace(features.Url)
+	if features.Url != "" && !isValidFeatureUrl(features.Url) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: feature url must be an absolute http or https url")
+		return
+	}
+
 	if features.Uuid == "" {
 		features.Uuid = xid.New().String()
 	} else {
@@ -70,21 +121,57 @@ func (oh *featureHandler) CreateOrEditFeatures(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	features.OwnerPubKey = strings.TrimSpace(features.OwnerPubKey)
+	if features.OwnerPubKey != "" && !isWorkspaceMember(oh.db, features.OwnerPubKey, features.WorkspaceUuid) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: feature owner must be a member of the workspace")
+		return
+	}
+
 	p, err := oh.db.CreateOrEditFeature(features)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 
+	oh.notifyFeatureWatchers(p, pubKeyFromAuth)
+
+	p.Warnings = featureWarnings(p)
+
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(p)
 }
 
+// featureWarnings lists what looks incomplete on a saved feature, these are
+// surfaced to the client but never fail the save
+func featureWarnings(feature db.WorkspaceFeatures) []string {
+	warnings := []string{}
+	if len(feature.Name) < minFeatureNameLength {
+		warnings = append(warnings, fmt.Sprintf("name is shorter than %d characters", minFeatureNameLength))
+	}
+	if feature.Url == "" {
+		warnings = append(warnings, "url is empty")
+	}
+	if feature.Brief == "" {
+		warnings = append(warnings, "brief is empty")
+	}
+	return warnings
+}
+
+// isValidFeatureUrl reports whether rawUrl is an absolute http(s) url with a host
+func isValidFeatureUrl(rawUrl string) bool {
+	u, err := url.ParseRequestURI(rawUrl)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
 func (oh *featureHandler) DeleteFeature(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	if pubKeyFromAuth == "" {
-		fmt.Println("no pubkey from auth")
+		utils.Log.Info(ctx, "no pubkey from auth")
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
@@ -101,33 +188,244 @@ func (oh *featureHandler) DeleteFeature(w http.ResponseWriter, r *http.Request)
 	fmt.Fprint(w, "Feature deleted successfully")
 }
 
+// UpdateFeatureStatus moves a feature to a built-in status or one of the
+// workspace's custom statuses
+func (oh *featureHandler) UpdateFeatureStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+
+	request := struct {
+		FeatStatus db.FeatureStatus `json:"feat_status"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error decoding request body: %v", err)
+		return
+	}
+	request.FeatStatus = db.FeatureStatus(strings.ToLower(strings.TrimSpace(string(request.FeatStatus))))
+
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	if !isAllowedFeatureStatus(oh.db, feature.WorkspaceUuid, request.FeatStatus) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: status is not allowed in this workspace")
+		return
+	}
+
+	updated, err := oh.db.UpdateFeatureStatus(featureUuid, request.FeatStatus)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	oh.notifyFeatureWatchers(updated, pubKeyFromAuth)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(updated)
+}
+
+// PinFeature keeps a feature at the top of the workspace board
+func (oh *featureHandler) PinFeature(w http.ResponseWriter, r *http.Request) {
+	oh.setFeaturePinned(w, r, true)
+}
+
+func (oh *featureHandler) UnpinFeature(w http.ResponseWriter, r *http.Request) {
+	oh.setFeaturePinned(w, r, false)
+}
+
+func (oh *featureHandler) setFeaturePinned(w http.ResponseWriter, r *http.Request, pinned bool) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	updated, err := oh.db.SetFeaturePinned(featureUuid, pinned)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(updated)
+}
+
 // Old Method for getting features for workspace uuid
 func (oh *featureHandler) GetFeaturesByWorkspaceUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	if pubKeyFromAuth == "" {
-		fmt.Println("no pubkey from auth")
+		utils.Log.Info(ctx, "no pubkey from auth")
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
 
+	sortBy := r.URL.Query().Get("sortBy")
+	direction := r.URL.Query().Get("direction")
+	if !db.IsValidFeatureSort(sortBy, direction) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: sortBy must be one of priority, name, created, updated and direction asc or desc")
+		return
+	}
+
+	if dueBefore := r.URL.Query().Get("due_before"); dueBefore != "" {
+		if _, err := db.ParseFeatureDate(dueBefore); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode("Error: due_before must be a date like 2006-01-02")
+			return
+		}
+	}
+
 	uuid := chi.URLParam(r, "workspace_uuid")
+	total := oh.db.GetFeaturesCountByWorkspaceUuid(uuid, r)
+	lastUpdated := oh.db.GetFeaturesLastUpdated(uuid, r)
+
+	// the board is polled, so skip the list when nothing changed since the
+	// client's copy
+	etag := featuresETag(uuid, r, lastUpdated, total)
+	w.Header().Set("ETag", etag)
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	workspaceFeatures := oh.db.GetFeaturesByWorkspaceUuid(uuid, r)
 
+	writePaginatedResponse(w, r, workspaceFeatures, total)
+}
+
+// featuresETag identifies a page of the feature list by its query, the
+// latest feature update and the number of matching features
+func featuresETag(workspaceUuid string, r *http.Request, lastUpdated time.Time, total int64) string {
+	hash := sha1.Sum([]byte(fmt.Sprintf("%s|%s|%d|%d", workspaceUuid, r.URL.RawQuery, lastUpdated.UnixNano(), total)))
+	return `"` + hex.EncodeToString(hash[:]) + `"`
+}
+
+// etagMatches reports whether an If-None-Match header lists etag
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// GetWorkspacePhases lists the phases of all features in a workspace
+func (oh *featureHandler) GetWorkspacePhases(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	uuid := chi.URLParam(r, "workspace_uuid")
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, uuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to this workspace")
+		return
+	}
+
+	phases := oh.db.GetWorkspacePhases(uuid, r)
+	total := oh.db.GetWorkspacePhasesCount(uuid)
+
+	writePaginatedResponse(w, r, phases, total)
+}
+
+// GetWorkspaceStoryCounts maps each feature of the workspace to its number
+// of stories, for the story badges on the board
+func (oh *featureHandler) GetWorkspaceStoryCounts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	uuid := chi.URLParam(r, "workspace_uuid")
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, uuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to this workspace")
+		return
+	}
+
+	counts := oh.db.GetWorkspaceStoryCounts(uuid)
+
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(workspaceFeatures)
+	json.NewEncoder(w).Encode(counts)
+}
+
+// writePaginatedResponse encodes items in a db.PaginatedResponse, or as the
+// old bare array when the legacy=true param is set
+func writePaginatedResponse(w http.ResponseWriter, r *http.Request, items interface{}, total int64) {
+	w.WriteHeader(http.StatusOK)
+
+	if r.URL.Query().Get("legacy") == "true" {
+		json.NewEncoder(w).Encode(items)
+		return
+	}
+
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
+	json.NewEncoder(w).Encode(db.PaginatedResponse{
+		Items:  items,
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+	})
 }
 
 func (oh *featureHandler) GetWorkspaceFeaturesCount(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	if pubKeyFromAuth == "" {
-		fmt.Println("no pubkey from auth")
+		utils.Log.Info(ctx, "no pubkey from auth")
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
 
 	uuid := chi.URLParam(r, "uuid")
+	if r.URL.Query().Get("fresh") == "true" {
+		db.Store.DeleteFeaturesCountCache(uuid)
+	}
 	workspaceFeatures := oh.db.GetWorkspaceFeaturesCount(uuid)
 
 	w.WriteHeader(http.StatusOK)
@@ -138,7 +436,7 @@ func (oh *featureHandler) GetFeatureByUuid(w http.ResponseWriter, r *http.Reques
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	if pubKeyFromAuth == "" {
-		fmt.Println("no pubkey from auth")
+		utils.Log.Info(ctx, "no pubkey from auth")
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
@@ -150,11 +448,71 @@ func (oh *featureHandler) GetFeatureByUuid(w http.ResponseWriter, r *http.Reques
 	json.NewEncoder(w).Encode(workspaceFeature)
 }
 
+// GetFeatureTree returns the feature with its phases, their bounty counts and
+// its stories, include_bounties=true also lists each phase's bounties. Stories
+// are left out for members who can't view the feature's stories.
+func (oh *featureHandler) GetFeatureTree(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	includeBounties := r.URL.Query().Get("include_bounties") == "true"
+
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, feature.WorkspaceUuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to this workspace")
+		return
+	}
+
+	tree := db.FeatureTree{
+		Feature: feature,
+		Phases:  []db.FeatureTreePhase{},
+	}
+
+	if _, ok := oh.canViewFeature(pubKeyFromAuth, featureUuid); ok {
+		stories, err := oh.db.GetFeatureStoriesByFeatureUuid(featureUuid)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+		tree.Stories = stories
+	}
+
+	for _, phase := range oh.db.GetPhasesByFeatureUuid(featureUuid) {
+		treePhase := db.FeatureTreePhase{
+			FeaturePhase:           phase,
+			BountiesCountOpen:      oh.db.GetFeaturePhasesBountiesCount("open", phase.Uuid),
+			BountiesCountAssigned:  oh.db.GetFeaturePhasesBountiesCount("assigned", phase.Uuid),
+			BountiesCountCompleted: oh.db.GetFeaturePhasesBountiesCount("completed", phase.Uuid),
+		}
+		if includeBounties {
+			treePhase.Bounties = oh.db.GetBountiesByPhaseUuid(phase.Uuid)
+		}
+		tree.Phases = append(tree.Phases, treePhase)
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(tree)
+}
+
 func (oh *featureHandler) CreateOrEditFeaturePhase(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	if pubKeyFromAuth == "" {
-		fmt.Println("no pubkey from auth")
+		utils.Log.Info(ctx, "no pubkey from auth")
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
@@ -180,6 +538,15 @@ func (oh *featureHandler) CreateOrEditFeaturePhase(w http.ResponseWriter, r *htt
 
 	newPhase.UpdatedBy = pubKeyFromAuth
 
+	// Validate struct data
+	err = db.Validate.Struct(newPhase)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("Error: did not pass validation test : %s", err)
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
 	// Check if feature exists
 	feature := oh.db.GetFeatureByUuid(newPhase.FeatureUuid)
 	if feature.Uuid != newPhase.FeatureUuid {
@@ -201,31 +568,70 @@ func (oh *featureHandler) CreateOrEditFeaturePhase(w http.ResponseWriter, r *htt
 
 func (oh *featureHandler) GetFeaturePhases(w http.ResponseWriter, r *http.Request) {
 	featureUuid := chi.URLParam(r, "feature_uuid")
+	status := db.PhaseStatus(r.URL.Query().Get("status"))
+	if status != "" && !status.IsValid() {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: status must be one of planned, active, completed")
+		return
+	}
+
 	phases := oh.db.GetPhasesByFeatureUuid(featureUuid)
 
+	if status != "" {
+		filtered := []db.FeaturePhase{}
+		for _, phase := range phases {
+			if phase.PhaseStatus == status {
+				filtered = append(filtered, phase)
+			}
+		}
+		phases = filtered
+	}
+
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(phases)
 }
 
-func (oh *featureHandler) GetFeaturePhaseByUUID(w http.ResponseWriter, r *http.Request) {
+func (oh *featureHandler) UpdatePhaseStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
 	featureUuid := chi.URLParam(r, "feature_uuid")
 	phaseUuid := chi.URLParam(r, "phase_uuid")
 
-	phase, err := oh.db.GetFeaturePhaseByUuid(featureUuid, phaseUuid)
-	if err != nil {
-		w.WriteHeader(http.StatusNotFound)
+	request := struct {
+		PhaseStatus db.PhaseStatus `json:"phase_status"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error decoding request body: %v", err)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(phase)
-}
+	if !request.PhaseStatus.IsValid() {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: status must be one of planned, active, completed")
+		return
+	}
 
-func (oh *featureHandler) DeleteFeaturePhase(w http.ResponseWriter, r *http.Request) {
-	featureUuid := chi.URLParam(r, "feature_uuid")
-	phaseUuid := chi.URLParam(r, "phase_uuid")
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
 
-	err := oh.db.DeleteFeaturePhase(featureUuid, phaseUuid)
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	phase, err := oh.db.UpdateFeaturePhaseStatus(featureUuid, phaseUuid, request.PhaseStatus)
 	if err != nil {
 		w.WriteHeader(http.StatusNotFound)
 		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
@@ -233,53 +639,186 @@ func (oh *featureHandler) DeleteFeaturePhase(w http.ResponseWriter, r *http.Requ
 	}
 
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{"message": "Phase deleted successfully"})
+	json.NewEncoder(w).Encode(phase)
 }
 
-func (oh *featureHandler) CreateOrEditStory(w http.ResponseWriter, r *http.Request) {
+// GetPhaseDetail returns the phase with its bounties and their counts, the
+// bounty list takes the same filters and paging as the phase bounty list
+func (oh *featureHandler) GetPhaseDetail(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	if pubKeyFromAuth == "" {
-		fmt.Println("no pubkey from auth")
+		utils.Log.Info(ctx, "no pubkey from auth")
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
 
-	newStory := db.FeatureStory{}
-	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&newStory)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		fmt.Fprintf(w, "Error decoding request body: %v", err)
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	phaseUuid := chi.URLParam(r, "phase_uuid")
+
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
 		return
 	}
 
-	if newStory.Uuid == "" {
-		newStory.Uuid = xid.New().String()
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, feature.WorkspaceUuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to this workspace")
+		return
 	}
 
-	existingStory, _ := oh.db.GetFeatureStoryByUuid(newStory.FeatureUuid, newStory.Uuid)
-
-	if existingStory.CreatedBy == "" {
-		newStory.CreatedBy = pubKeyFromAuth
+	phase, ok := oh.phaseOfFeature(w, featureUuid, phaseUuid)
+	if !ok {
+		return
 	}
 
-	newStory.UpdatedBy = pubKeyFromAuth
+	// an empty phase is reported as an error by the bounty query
+	bounties, _ := oh.db.GetBountiesByFeatureAndPhaseUuid(featureUuid, phaseUuid, r)
 
-	story, err := oh.db.CreateOrEditFeatureStory(newStory)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		fmt.Fprintf(w, "Error creating feature story: %v", err)
-		return
+	detail := db.PhaseDetail{
+		Phase:                  phase,
+		Bounties:               oh.generateBountyHandler(bounties),
+		BountiesCount:          oh.db.GetBountiesCountByFeatureAndPhaseUuid(featureUuid, phaseUuid, r),
+		BountiesCountOpen:      oh.db.GetFeaturePhasesBountiesCount("open", phaseUuid),
+		BountiesCountAssigned:  oh.db.GetFeaturePhasesBountiesCount("assigned", phaseUuid),
+		BountiesCountCompleted: oh.db.GetFeaturePhasesBountiesCount("completed", phaseUuid),
 	}
 
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(story)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(detail)
 }
 
-func (oh *featureHandler) GetStoriesByFeatureUuid(w http.ResponseWriter, r *http.Request) {
+func (oh *featureHandler) GetFeaturePhaseByUUID(w http.ResponseWriter, r *http.Request) {
 	featureUuid := chi.URLParam(r, "feature_uuid")
-	stories, err := oh.db.GetFeatureStoriesByFeatureUuid(featureUuid)
+	phaseUuid := chi.URLParam(r, "phase_uuid")
+
+	phase, err := oh.db.GetFeaturePhaseByUuid(featureUuid, phaseUuid)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(phase)
+}
+
+// phaseOfFeature loads the phase in the path and responds 404 when it does
+// not exist or belongs to another feature than the one in the path
+func (oh *featureHandler) phaseOfFeature(w http.ResponseWriter, featureUuid string, phaseUuid string) (db.FeaturePhase, bool) {
+	phase, err := oh.db.GetFeaturePhaseByUuid(featureUuid, phaseUuid)
+	if err != nil || phase.FeatureUuid != featureUuid {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Phase does not exists")
+		return db.FeaturePhase{}, false
+	}
+	return phase, true
+}
+
+func (oh *featureHandler) DeleteFeaturePhase(w http.ResponseWriter, r *http.Request) {
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	phaseUuid := chi.URLParam(r, "phase_uuid")
+
+	if _, ok := oh.phaseOfFeature(w, featureUuid, phaseUuid); !ok {
+		return
+	}
+
+	err := oh.db.DeleteFeaturePhase(featureUuid, phaseUuid)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"message": "Phase deleted successfully"})
+}
+
+func (oh *featureHandler) CreateOrEditStory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	newStory := db.FeatureStory{}
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&newStory)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error decoding request body: %v", err)
+		return
+	}
+
+	if newStory.Uuid == "" {
+		newStory.Uuid = xid.New().String()
+	}
+
+	for i, criterion := range newStory.AcceptanceCriteria {
+		newStory.AcceptanceCriteria[i] = strings.TrimSpace(criterion)
+	}
+
+	err = db.Validate.Struct(newStory)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("Error: did not pass validation test : %s", err)
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	existingStory, _ := oh.db.GetFeatureStoryByUuid(newStory.FeatureUuid, newStory.Uuid)
+
+	if existingStory.CreatedBy == "" {
+		newStory.CreatedBy = pubKeyFromAuth
+	}
+
+	newStory.UpdatedBy = pubKeyFromAuth
+
+	story, err := oh.db.CreateOrEditFeatureStory(newStory)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "Error creating feature story: %v", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(story)
+}
+
+// canViewFeature checks that the feature exists and that pubkey owns its
+// workspace or holds the ViewReport role, returning the status to respond
+// with when it does not
+func (oh *featureHandler) canViewFeature(pubKeyFromAuth string, featureUuid string) (int, bool) {
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		return http.StatusNotFound, false
+	}
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.ViewReport) {
+		return http.StatusForbidden, false
+	}
+	return http.StatusOK, true
+}
+
+func (oh *featureHandler) GetStoriesByFeatureUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	if status, ok := oh.canViewFeature(pubKeyFromAuth, featureUuid); !ok {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode("Don't have access to view this feature")
+		return
+	}
+
+	stories, err := oh.db.GetFeatureStoriesByFeatureUuid(featureUuid)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -290,9 +829,23 @@ func (oh *featureHandler) GetStoriesByFeatureUuid(w http.ResponseWriter, r *http
 }
 
 func (oh *featureHandler) GetStoryByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
 	featureUuid := chi.URLParam(r, "feature_uuid")
 	storyUuid := chi.URLParam(r, "story_uuid")
 
+	if status, ok := oh.canViewFeature(pubKeyFromAuth, featureUuid); !ok {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode("Don't have access to view this feature")
+		return
+	}
+
 	story, err := oh.db.GetFeatureStoryByUuid(featureUuid, storyUuid)
 	if err != nil {
 		w.WriteHeader(http.StatusNotFound)
@@ -302,6 +855,174 @@ func (oh *featureHandler) GetStoryByUuid(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(story)
 }
+func (oh *featureHandler) DeleteFeatureStories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+
+	request := struct {
+		StoryUuids []string `json:"story_uuids"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error decoding request body: %v", err)
+		return
+	}
+
+	if len(request.StoryUuids) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: story_uuids must not be empty")
+		return
+	}
+
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	deletion, err := oh.db.DeleteFeatureStories(featureUuid, request.StoryUuids)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(deletion)
+}
+
+func (oh *featureHandler) MoveStoryToFeature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	storyUuid := chi.URLParam(r, "story_uuid")
+
+	request := struct {
+		TargetFeatureUuid string `json:"target_feature_uuid"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error decoding request body: %v", err)
+		return
+	}
+
+	if request.TargetFeatureUuid == "" || request.TargetFeatureUuid == featureUuid {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: target_feature_uuid must be a different feature")
+		return
+	}
+
+	source := oh.db.GetFeatureByUuid(featureUuid)
+	target := oh.db.GetFeatureByUuid(request.TargetFeatureUuid)
+	if source.Uuid == "" || target.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if source.WorkspaceUuid != target.WorkspaceUuid {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: stories can only move between features of the same workspace")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, source.WorkspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	story, err := oh.db.MoveFeatureStory(featureUuid, storyUuid, target.Uuid)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(story)
+}
+
+// MoveFeatureToWorkspace moves a feature created under the wrong workspace.
+// The caller needs EditOrg on both the current and the target workspace.
+func (oh *featureHandler) MoveFeatureToWorkspace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+
+	request := struct {
+		WorkspaceUuid string `json:"workspace_uuid"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error decoding request body: %v", err)
+		return
+	}
+
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if request.WorkspaceUuid == "" || request.WorkspaceUuid == feature.WorkspaceUuid {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: workspace_uuid must be a different workspace")
+		return
+	}
+
+	target := oh.db.GetWorkspaceByUuid(request.WorkspaceUuid)
+	if target.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Workspace does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.EditOrg) ||
+		!oh.userHasAccess(pubKeyFromAuth, target.Uuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	moved, err := oh.db.MoveFeatureToWorkspace(featureUuid, target.Uuid)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(moved)
+}
+
 func (oh *featureHandler) DeleteStory(w http.ResponseWriter, r *http.Request) {
 	featureUuid := chi.URLParam(r, "feature_uuid")
 	storyUuid := chi.URLParam(r, "story_uuid")
@@ -321,6 +1042,13 @@ func (oh *featureHandler) GetBountiesByFeatureAndPhaseUuid(w http.ResponseWriter
 	featureUuid := chi.URLParam(r, "feature_uuid")
 	phaseUuid := chi.URLParam(r, "phase_uuid")
 
+	if _, ok := oh.phaseOfFeature(w, featureUuid, phaseUuid); !ok {
+		return
+	}
+
+	bountiesCount := oh.db.GetBountiesCountByFeatureAndPhaseUuid(featureUuid, phaseUuid, r)
+	w.Header().Set("X-Total-Count", strconv.FormatInt(bountiesCount, 10))
+
 	bounties, err := oh.db.GetBountiesByFeatureAndPhaseUuid(featureUuid, phaseUuid, r)
 	if err != nil {
 		w.WriteHeader(http.StatusNotFound)
@@ -329,16 +1057,729 @@ func (oh *featureHandler) GetBountiesByFeatureAndPhaseUuid(w http.ResponseWriter
 
 	var bountyResponse []db.BountyResponse = oh.generateBountyHandler(bounties)
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(bountyResponse)
+	writePaginatedResponse(w, r, bountyResponse, bountiesCount)
 }
 
 func (oh *featureHandler) GetBountiesCountByFeatureAndPhaseUuid(w http.ResponseWriter, r *http.Request) {
 	featureUuid := chi.URLParam(r, "feature_uuid")
 	phaseUuid := chi.URLParam(r, "phase_uuid")
 
+	if _, ok := oh.phaseOfFeature(w, featureUuid, phaseUuid); !ok {
+		return
+	}
+
 	bountiesCount := oh.db.GetBountiesCountByFeatureAndPhaseUuid(featureUuid, phaseUuid, r)
 
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(bountiesCount)
 }
+
+func (oh *featureHandler) AssignPhaseBounties(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	phaseUuid := chi.URLParam(r, "phase_uuid")
+
+	request := struct {
+		BountyIds []uint `json:"bounty_ids"`
+		Assignee  string `json:"assignee"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error decoding request body: %v", err)
+		return
+	}
+
+	if len(request.BountyIds) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: bounty_ids must not be empty")
+		return
+	}
+
+	if request.Assignee == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: assignee is required")
+		return
+	}
+
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	if _, ok := oh.phaseOfFeature(w, featureUuid, phaseUuid); !ok {
+		return
+	}
+
+	if oh.db.GetPersonByPubkey(request.Assignee).OwnerPubKey == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: assignee does not exist")
+		return
+	}
+
+	if !isWorkspaceMember(oh.db, request.Assignee, feature.WorkspaceUuid) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: assignee must be a member of the workspace")
+		return
+	}
+
+	results, err := oh.db.AssignPhaseBounties(phaseUuid, request.BountyIds, request.Assignee)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(results)
+}
+
+func (oh *featureHandler) GetBountiesByFeatureUuid(w http.ResponseWriter, r *http.Request) {
+	featureUuid := chi.URLParam(r, "feature_uuid")
+
+	if feature := oh.db.GetFeatureByUuid(featureUuid); feature.Uuid != featureUuid {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	bountiesCount := oh.db.GetBountiesCountByFeatureUuid(featureUuid, r)
+	w.Header().Set("X-Total-Count", strconv.FormatInt(bountiesCount, 10))
+
+	bounties, err := oh.db.GetBountiesByFeatureUuid(featureUuid, r)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	var bountyResponse []db.BountyResponse = oh.generateBountyHandler(bounties)
+
+	writePaginatedResponse(w, r, bountyResponse, bountiesCount)
+}
+
+func (oh *featureHandler) AddFeatureDependency(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	dependency := db.FeatureDependency{}
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&dependency)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error decoding request body: %v", err)
+		return
+	}
+
+	feature := oh.db.GetFeatureByUuid(dependency.FeatureUuid)
+	dependsOn := oh.db.GetFeatureByUuid(dependency.DependsOnUuid)
+	if feature.Uuid == "" || dependsOn.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	if feature.WorkspaceUuid != dependsOn.WorkspaceUuid {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Features must belong to the same workspace")
+		return
+	}
+
+	if oh.dependsOn(dependsOn.Uuid, feature.Uuid) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Feature dependency would create a cycle")
+		return
+	}
+
+	dependency.CreatedBy = pubKeyFromAuth
+
+	dep, err := oh.db.AddFeatureDependency(dependency)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "Error creating feature dependency: %v", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dep)
+}
+
+// dependsOn reports whether featureUuid depends on targetUuid,
+// directly or through other features
+func (oh *featureHandler) dependsOn(featureUuid, targetUuid string) bool {
+	visited := map[string]bool{}
+	queue := []string{featureUuid}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == targetUuid {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		for _, upstream := range oh.db.GetUpstreamFeatures(current) {
+			queue = append(queue, upstream.Uuid)
+		}
+	}
+	return false
+}
+
+func (oh *featureHandler) RemoveFeatureDependency(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	dependsOnUuid := chi.URLParam(r, "depends_on_uuid")
+
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	err := oh.db.DeleteFeatureDependency(featureUuid, dependsOnUuid)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"message": "Feature dependency deleted successfully"})
+}
+
+func (oh *featureHandler) GetFeatureDependencies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, feature.WorkspaceUuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to this workspace")
+		return
+	}
+
+	dependencies := db.FeatureDependencies{
+		Upstream:   oh.db.GetUpstreamFeatures(featureUuid),
+		Downstream: oh.db.GetDownstreamFeatures(featureUuid),
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(dependencies)
+}
+
+func (oh *featureHandler) WatchFeature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, feature.WorkspaceUuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to this workspace")
+		return
+	}
+
+	request := struct {
+		WebsocketToken string `json:"websocket_token"`
+	}{}
+	body, _ := io.ReadAll(r.Body)
+	r.Body.Close()
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Error decoding request body: %v", err)
+			return
+		}
+	}
+
+	watcher := db.FeatureWatcher{
+		FeatureUuid:    feature.Uuid,
+		OwnerPubKey:    pubKeyFromAuth,
+		WebsocketToken: request.WebsocketToken,
+	}
+
+	fw, err := oh.db.WatchFeature(watcher)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "Error watching feature: %v", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(fw)
+}
+
+func (oh *featureHandler) UnwatchFeature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	err := oh.db.UnwatchFeature(featureUuid, pubKeyFromAuth)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "Feature unwatched successfully")
+}
+
+func (oh *featureHandler) GetFeatureWatchers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, feature.WorkspaceUuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to this workspace")
+		return
+	}
+
+	watchers := oh.db.GetFeatureWatchers(featureUuid)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(watchers)
+}
+
+// notifyFeatureWatchers sends a websocket message to everyone watching the
+// feature, except the user who made the change
+func (oh *featureHandler) notifyFeatureWatchers(feature db.WorkspaceFeatures, pubKeyFromAuth string) {
+	msg := make(map[string]interface{})
+	msg["msg"] = "feature_updated"
+	msg["feature_uuid"] = feature.Uuid
+	msg["name"] = feature.Name
+
+	for _, watcher := range oh.db.GetFeatureWatchers(feature.Uuid) {
+		if watcher.OwnerPubKey == pubKeyFromAuth || watcher.WebsocketToken == "" {
+			continue
+		}
+
+		socket, err := oh.getSocketConnections(watcher.WebsocketToken)
+		if err == nil {
+			socket.Conn.WriteJSON(msg)
+		}
+	}
+}
+
+func (oh *featureHandler) GetFeatureBurndown(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	if status, ok := oh.canViewFeature(pubKeyFromAuth, featureUuid); !ok {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode("Don't have access to view this feature")
+		return
+	}
+
+	start, end, ok := readFeatureDateRange(w, r)
+	if !ok {
+		return
+	}
+
+	burndown := oh.db.GetFeatureBurndown(featureUuid, start, end)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(burndown)
+}
+
+// GetFeatureVelocity returns the bounties completed per week in the date range
+// and a finish date projected from that pace
+func (oh *featureHandler) GetFeatureVelocity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	if status, ok := oh.canViewFeature(pubKeyFromAuth, featureUuid); !ok {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode("Don't have access to view this feature")
+		return
+	}
+
+	start, end, ok := readFeatureDateRange(w, r)
+	if !ok {
+		return
+	}
+
+	velocity := oh.db.GetFeatureVelocity(featureUuid, start, end)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(velocity)
+}
+
+// GetFeatureEstimatedHours sums the hour estimates of the feature's bounties,
+// in total and per phase
+func (oh *featureHandler) GetFeatureEstimatedHours(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	if status, ok := oh.canViewFeature(pubKeyFromAuth, featureUuid); !ok {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode("Don't have access to view this feature")
+		return
+	}
+
+	estimate := oh.db.GetFeatureEstimatedHours(featureUuid)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(estimate)
+}
+
+// readFeatureDateRange reads the unix timestamp range used by the burndown and
+// velocity endpoints, writing the error response when it is not valid
+func readFeatureDateRange(w http.ResponseWriter, r *http.Request) (time.Time, time.Time, bool) {
+	request := db.PaymentDateRange{}
+	body, _ := io.ReadAll(r.Body)
+	r.Body.Close()
+
+	err := json.Unmarshal(body, &request)
+	if err != nil {
+		w.WriteHeader(http.StatusNotAcceptable)
+		json.NewEncoder(w).Encode("Request body not accepted")
+		return time.Time{}, time.Time{}, false
+	}
+
+	startDate, startErr := strconv.ParseInt(request.StartDate, 10, 64)
+	endDate, endErr := strconv.ParseInt(request.EndDate, 10, 64)
+	if startErr != nil || endErr != nil || endDate < startDate {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Invalid date range")
+		return time.Time{}, time.Time{}, false
+	}
+
+	start := time.Unix(startDate, 0).UTC()
+	end := time.Unix(endDate, 0).UTC()
+	if end.Sub(start) > maxBurndownDays*24*time.Hour {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(fmt.Sprintf("Date range cannot be longer than %d days", maxBurndownDays))
+		return time.Time{}, time.Time{}, false
+	}
+
+	return start, end, true
+}
+
+func (oh *featureHandler) GetOldestOpenBountyPerFeature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	workspaceUuid := chi.URLParam(r, "workspace_uuid")
+	if !oh.userHasAccess(pubKeyFromAuth, workspaceUuid, db.ViewReport) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to view this workspace")
+		return
+	}
+
+	oldest, err := oh.db.GetOldestOpenBountyPerFeature(workspaceUuid)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(oldest)
+}
+
+func (oh *featureHandler) ArchiveStaleFeatures(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	workspaceUuid := chi.URLParam(r, "workspace_uuid")
+	if !oh.userHasAccess(pubKeyFromAuth, workspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	olderThanDays, err := strconv.Atoi(r.URL.Query().Get("older_than_days"))
+	if err != nil || olderThanDays < 1 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("older_than_days must be a positive number of days")
+		return
+	}
+
+	cutoff := time.Now().UTC().AddDate(0, 0, -olderThanDays)
+	archived, err := oh.db.ArchiveStaleFeatures(workspaceUuid, cutoff)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]int64{"archived": archived})
+}
+
+// ArchiveAllWorkspaceFeatures archives every feature of a workspace, for
+// winding a project down
+func (oh *featureHandler) ArchiveAllWorkspaceFeatures(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	workspaceUuid := chi.URLParam(r, "workspace_uuid")
+	if !oh.userHasAccess(pubKeyFromAuth, workspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	archived, err := oh.db.ArchiveAllWorkspaceFeatures(workspaceUuid)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]int64{"archived": archived})
+}
+
+func (oh *featureHandler) GetFeatureActivity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	if status, ok := oh.canViewFeature(pubKeyFromAuth, featureUuid); !ok {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode("Don't have access to view this feature")
+		return
+	}
+
+	if since := r.URL.Query().Get("since"); since != "" {
+		if _, err := strconv.ParseInt(since, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode("since must be a unix timestamp")
+			return
+		}
+	}
+
+	activity := oh.db.GetFeatureActivity(featureUuid, r)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(activity)
+}
+
+func (oh *featureHandler) SnapshotFeature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	feature := oh.db.GetFeatureByUuid(featureUuid)
+	if feature.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Feature does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, feature.WorkspaceUuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	phases := oh.db.GetPhasesByFeatureUuid(featureUuid)
+	for _, phase := range phases {
+		feature.BountiesCountCompleted += int(oh.db.GetFeaturePhasesBountiesCount("completed", phase.Uuid))
+		feature.BountiesCountAssigned += int(oh.db.GetFeaturePhasesBountiesCount("assigned", phase.Uuid))
+		feature.BountiesCountOpen += int(oh.db.GetFeaturePhasesBountiesCount("open", phase.Uuid))
+	}
+
+	stories, err := oh.db.GetFeatureStoriesByFeatureUuid(featureUuid)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	snapshot, err := oh.db.CreateFeatureSnapshot(db.FeatureSnapshot{
+		Uuid:        xid.New().String(),
+		FeatureUuid: featureUuid,
+		Data: db.FeatureSnapshotData{
+			Feature: feature,
+			Phases:  phases,
+			Stories: stories,
+		},
+		CreatedBy: pubKeyFromAuth,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(snapshot)
+}
+
+func (oh *featureHandler) GetFeatureSnapshots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	if status, ok := oh.canViewFeature(pubKeyFromAuth, featureUuid); !ok {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode("Don't have access to view this feature")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(oh.db.GetFeatureSnapshots(featureUuid))
+}
+
+func (oh *featureHandler) GetFeatureSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		utils.Log.Info(ctx, "no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	featureUuid := chi.URLParam(r, "feature_uuid")
+	snapshotUuid := chi.URLParam(r, "snapshot_uuid")
+	if status, ok := oh.canViewFeature(pubKeyFromAuth, featureUuid); !ok {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode("Don't have access to view this feature")
+		return
+	}
+
+	snapshot, err := oh.db.GetFeatureSnapshot(featureUuid, snapshotUuid)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Snapshot does not exists")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(snapshot)
+}
@@ -0,0 +1,2593 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/lib/pq"
+	"github.com/stakwork/sphinx-tribes/auth"
+	"github.com/stakwork/sphinx-tribes/config"
+	"github.com/stakwork/sphinx-tribes/db"
+	dbMocks "github.com/stakwork/sphinx-tribes/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAddFeatureDependency(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	featureA, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Feature A",
+	})
+	featureB, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Feature B",
+	})
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	addDependency := func(featureUuid, dependsOnUuid string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(fHandler.AddFeatureDependency)
+
+		body, _ := json.Marshal(db.FeatureDependency{
+			FeatureUuid:   featureUuid,
+			DependsOnUuid: dependsOnUuid,
+		})
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/dependency", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return error if public key not present", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(fHandler.AddFeatureDependency)
+
+		req, err := http.NewRequest(http.MethodPost, "/dependency", bytes.NewReader([]byte(`{}`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should add a dependency and return it in the dependency graph", func(t *testing.T) {
+		rr := addDependency(featureA.Uuid, featureB.Uuid)
+		assert.Equal(t, http.StatusCreated, rr.Code)
+
+		rr = httptest.NewRecorder()
+		handler := http.HandlerFunc(fHandler.GetFeatureDependencies)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", featureB.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/"+featureB.Uuid+"/dependencies", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var dependencies db.FeatureDependencies
+		err = json.Unmarshal(rr.Body.Bytes(), &dependencies)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Empty(t, dependencies.Upstream)
+		assert.Len(t, dependencies.Downstream, 1)
+		assert.Equal(t, featureA.Uuid, dependencies.Downstream[0].Uuid)
+	})
+
+	t.Run("should reject a dependency that creates a cycle", func(t *testing.T) {
+		rr := addDependency(featureB.Uuid, featureA.Uuid)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		assert.Empty(t, db.TestDB.GetUpstreamFeatures(featureB.Uuid))
+	})
+
+	t.Run("should reject a feature depending on itself", func(t *testing.T) {
+		rr := addDependency(featureA.Uuid, featureA.Uuid)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetBountiesByFeatureAndPhaseUuid(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+	fHandler.generateBountyHandler = func(bounties []db.NewBounty) []db.BountyResponse {
+		return []db.BountyResponse{}
+	}
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Feature",
+	})
+	phase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "Phase",
+	})
+
+	created := time.Now().Unix()
+	bounties := []db.NewBounty{
+		{Title: "open golang bounty", Assignee: "", CodingLanguages: pq.StringArray{"Golang"}},
+		{Title: "assigned golang bounty", Assignee: "hunter-key", CodingLanguages: pq.StringArray{"Golang"}},
+		{Title: "open javascript bounty", Assignee: "", CodingLanguages: pq.StringArray{"Javascript"}},
+	}
+	for i, bounty := range bounties {
+		bounty.Type = "coding"
+		bounty.Description = "bounty description"
+		bounty.OwnerID = workspace.OwnerPubKey
+		bounty.WorkspaceUuid = workspace.Uuid
+		bounty.PhaseUuid = phase.Uuid
+		bounty.Created = created + int64(i)
+		db.TestDB.CreateOrEditBounty(bounty)
+	}
+
+	t.Run("should set X-Total-Count to the filtered bounty count", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(fHandler.GetBountiesByFeatureAndPhaseUuid)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		rctx.URLParams.Add("phase_uuid", phase.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/"+feature.Uuid+"/phase/"+phase.Uuid+"/bounty?Open=true&languages=Golang", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler.ServeHTTP(rr, req)
+
+		expectedCount := db.TestDB.GetBountiesCountByFeatureAndPhaseUuid(feature.Uuid, phase.Uuid, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, int64(1), expectedCount)
+		assert.Equal(t, strconv.FormatInt(expectedCount, 10), rr.Header().Get("X-Total-Count"))
+
+		page := db.PaginatedResponse{}
+		err = json.Unmarshal(rr.Body.Bytes(), &page)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedCount, page.Total)
+	})
+
+	t.Run("should return a bare array when legacy is set", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		rctx.URLParams.Add("phase_uuid", phase.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/"+feature.Uuid+"/phase/"+phase.Uuid+"/bounty?legacy=true", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		http.HandlerFunc(fHandler.GetBountiesByFeatureAndPhaseUuid).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "[]\n", rr.Body.String())
+	})
+}
+
+func TestGetBountiesByFeatureUuid(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	var listedBounties []db.NewBounty
+	fHandler.generateBountyHandler = func(bounties []db.NewBounty) []db.BountyResponse {
+		listedBounties = bounties
+		return []db.BountyResponse{}
+	}
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Feature",
+	})
+	otherFeature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Other feature",
+	})
+
+	phaseUuids := []string{}
+	for _, featureUuid := range []string{feature.Uuid, feature.Uuid, otherFeature.Uuid} {
+		phase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+			Uuid:        uuid.New().String(),
+			FeatureUuid: featureUuid,
+			Name:        "Phase",
+		})
+		phaseUuids = append(phaseUuids, phase.Uuid)
+	}
+
+	created := time.Now().Unix()
+	for i, phaseUuid := range []string{phaseUuids[0], phaseUuids[0], phaseUuids[1], phaseUuids[2]} {
+		db.TestDB.CreateOrEditBounty(db.NewBounty{
+			Type:          "coding",
+			Title:         "feature bounty " + strconv.Itoa(i),
+			Description:   "bounty description",
+			OwnerID:       workspace.OwnerPubKey,
+			WorkspaceUuid: workspace.Uuid,
+			PhaseUuid:     phaseUuid,
+			Paid:          i == 2,
+			Created:       created + int64(i),
+		})
+	}
+
+	getBounties := func(query string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/"+feature.Uuid+"/bounty"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetBountiesByFeatureUuid).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return bounties from every phase of the feature", func(t *testing.T) {
+		rr := getBounties("")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "3", rr.Header().Get("X-Total-Count"))
+		assert.Len(t, listedBounties, 3)
+		for _, bounty := range listedBounties {
+			assert.Contains(t, phaseUuids[:2], bounty.PhaseUuid)
+		}
+
+		response := db.PaginatedResponse{}
+		err := json.Unmarshal(rr.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), response.Total)
+	})
+
+	t.Run("should apply the status filters across phases", func(t *testing.T) {
+		rr := getBounties("?Paid=true")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "1", rr.Header().Get("X-Total-Count"))
+		assert.Len(t, listedBounties, 1)
+		assert.Equal(t, phaseUuids[1], listedBounties[0].PhaseUuid)
+	})
+
+	t.Run("should return 404 for an unknown feature", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", "unknown-feature")
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/unknown-feature/bounty", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetBountiesByFeatureUuid).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestGetOldestOpenBountyPerFeature(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	openFeature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Feature with open work",
+		Priority:      1,
+	})
+	assignedFeature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Feature with assigned work",
+		Priority:      2,
+	})
+
+	openPhase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: openFeature.Uuid,
+		Name:        "Phase",
+	})
+	assignedPhase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: assignedFeature.Uuid,
+		Name:        "Phase",
+	})
+
+	created := time.Now().Unix()
+	bounties := []db.NewBounty{
+		{Title: "assigned but oldest", PhaseUuid: openPhase.Uuid, Assignee: "assignee-key", Created: created},
+		{Title: "oldest open", PhaseUuid: openPhase.Uuid, Created: created + 1},
+		{Title: "newer open", PhaseUuid: openPhase.Uuid, Created: created + 2},
+		{Title: "only assigned", PhaseUuid: assignedPhase.Uuid, Assignee: "assignee-key", Created: created},
+	}
+	for _, bounty := range bounties {
+		bounty.Type = "coding"
+		bounty.Description = "bounty description"
+		bounty.OwnerID = workspace.OwnerPubKey
+		bounty.WorkspaceUuid = workspace.Uuid
+		db.TestDB.CreateOrEditBounty(bounty)
+	}
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+	req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/workspace/"+workspace.Uuid+"/oldest_open_bounty", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(fHandler.GetOldestOpenBountyPerFeature).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	oldest := []db.FeatureOldestBounty{}
+	err = json.Unmarshal(rr.Body.Bytes(), &oldest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("should return the earliest unassigned bounty of a feature", func(t *testing.T) {
+		assert.Len(t, oldest, 2)
+		assert.Equal(t, openFeature.Uuid, oldest[0].Feature.Uuid)
+		if assert.NotNil(t, oldest[0].Bounty) {
+			assert.Equal(t, "oldest open", oldest[0].Bounty.Title)
+		}
+	})
+
+	t.Run("should return a null bounty for a feature without open work", func(t *testing.T) {
+		assert.Equal(t, assignedFeature.Uuid, oldest[1].Feature.Uuid)
+		assert.Nil(t, oldest[1].Bounty)
+	})
+}
+
+func TestGetFeaturesByWorkspaceUuid(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	creators := []string{"creator-one", "creator-one", "creator-two"}
+	for i, creator := range creators {
+		db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+			Uuid:          uuid.New().String(),
+			WorkspaceUuid: workspace.Uuid,
+			Name:          "Feature " + strconv.Itoa(i),
+			CreatedBy:     creator,
+		})
+	}
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	getFeatures := func(query string) []db.WorkspaceFeatures {
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(fHandler.GetFeaturesByWorkspaceUuid)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/forworkspace/"+workspace.Uuid+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		features := []db.WorkspaceFeatures{}
+		err = json.Unmarshal(rr.Body.Bytes(), &db.PaginatedResponse{Items: &features})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return features
+	}
+
+	t.Run("should only return features created by the given pubkey", func(t *testing.T) {
+		features := getFeatures("?created_by=creator-two")
+
+		assert.Len(t, features, 1)
+		assert.Equal(t, "creator-two", features[0].CreatedBy)
+	})
+
+	t.Run("should combine created_by with the search filter", func(t *testing.T) {
+		features := getFeatures("?created_by=creator-one&search=feature%201")
+
+		assert.Len(t, features, 1)
+		assert.Equal(t, "Feature 1", features[0].Name)
+	})
+
+	t.Run("should return an empty list when no feature matches", func(t *testing.T) {
+		features := getFeatures("?created_by=unknown-creator")
+
+		assert.Empty(t, features)
+	})
+
+	t.Run("should sort features by name descending", func(t *testing.T) {
+		features := getFeatures("?sortBy=name&direction=desc")
+
+		assert.Len(t, features, 3)
+		assert.Equal(t, "Feature 2", features[0].Name)
+		assert.Equal(t, "Feature 1", features[1].Name)
+		assert.Equal(t, "Feature 0", features[2].Name)
+	})
+
+	t.Run("should wrap features in a paginated response with the filtered total", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/forworkspace/"+workspace.Uuid+"?created_by=creator-one&limit=2&page=1", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		http.HandlerFunc(fHandler.GetFeaturesByWorkspaceUuid).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		features := []db.WorkspaceFeatures{}
+		page := db.PaginatedResponse{Items: &features}
+		err = json.Unmarshal(rr.Body.Bytes(), &page)
+		assert.NoError(t, err)
+		assert.Len(t, features, 2)
+		assert.Equal(t, int64(2), page.Total)
+		assert.Equal(t, 0, page.Offset)
+		assert.Equal(t, 2, page.Limit)
+	})
+
+	t.Run("should return a bare array when legacy is set", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/forworkspace/"+workspace.Uuid+"?legacy=true", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		http.HandlerFunc(fHandler.GetFeaturesByWorkspaceUuid).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		features := []db.WorkspaceFeatures{}
+		err = json.Unmarshal(rr.Body.Bytes(), &features)
+		assert.NoError(t, err)
+		assert.Len(t, features, 3)
+	})
+
+	t.Run("should reject a sort column outside the allow-list", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/forworkspace/"+workspace.Uuid+"?sortBy=owner_pubkey", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		http.HandlerFunc(fHandler.GetFeaturesByWorkspaceUuid).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestFeatureOwner(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	member := "feature-owner-key"
+	now := time.Now()
+	db.TestDB.CreateWorkspaceUser(db.WorkspaceUsers{
+		OwnerPubKey:   member,
+		WorkspaceUuid: workspace.Uuid,
+		Created:       &now,
+		Updated:       &now,
+	})
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	createFeature := func(name string, owner string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(db.WorkspaceFeatures{
+			WorkspaceUuid: workspace.Uuid,
+			Name:          name,
+			OwnerPubKey:   owner,
+		})
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.CreateOrEditFeatures).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should reject an owner outside the workspace", func(t *testing.T) {
+		rr := createFeature("Unowned", "outsider-key")
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should assign members and the workspace owner as feature owners", func(t *testing.T) {
+		rr := createFeature("Owned by member", member)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		feature := db.WorkspaceFeatures{}
+		err := json.Unmarshal(rr.Body.Bytes(), &feature)
+		assert.NoError(t, err)
+		assert.Equal(t, member, feature.OwnerPubKey)
+
+		assert.Equal(t, http.StatusOK, createFeature("Owned by admin", workspace.OwnerPubKey).Code)
+	})
+
+	t.Run("should filter features by owner", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/forworkspace/"+workspace.Uuid+"?owner="+member, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeaturesByWorkspaceUuid).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		features := []db.WorkspaceFeatures{}
+		page := db.PaginatedResponse{Items: &features}
+		err = json.Unmarshal(rr.Body.Bytes(), &page)
+		assert.NoError(t, err)
+		assert.Len(t, features, 1)
+		assert.Equal(t, "Owned by member", features[0].Name)
+		assert.Equal(t, int64(1), page.Total)
+	})
+}
+
+func TestFeatureTargetDate(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	saveFeature := func(feature db.WorkspaceFeatures) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(feature)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.CreateOrEditFeatures).ServeHTTP(rr, req)
+		return rr
+	}
+
+	listFeatures := func(query string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/forworkspace/"+workspace.Uuid+"?"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeaturesByWorkspaceUuid).ServeHTTP(rr, req)
+		return rr
+	}
+
+	soon := time.Now().AddDate(0, 0, 7).UTC().Truncate(time.Second)
+	later := time.Now().AddDate(0, 3, 0).UTC().Truncate(time.Second)
+	past := time.Now().AddDate(0, 0, -1).UTC()
+
+	t.Run("should reject a target date in the past", func(t *testing.T) {
+		rr := saveFeature(db.WorkspaceFeatures{
+			WorkspaceUuid: workspace.Uuid,
+			Name:          "Overdue already",
+			TargetDate:    &past,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should save and return a future target date", func(t *testing.T) {
+		rr := saveFeature(db.WorkspaceFeatures{
+			WorkspaceUuid: workspace.Uuid,
+			Name:          "Due soon",
+			TargetDate:    &soon,
+		})
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		feature := db.WorkspaceFeatures{}
+		err := json.Unmarshal(rr.Body.Bytes(), &feature)
+		assert.NoError(t, err)
+		assert.NotNil(t, feature.TargetDate)
+		assert.True(t, soon.Equal(*feature.TargetDate))
+
+		assert.Equal(t, http.StatusOK, saveFeature(db.WorkspaceFeatures{
+			WorkspaceUuid: workspace.Uuid,
+			Name:          "Due later",
+			TargetDate:    &later,
+		}).Code)
+		assert.Equal(t, http.StatusOK, saveFeature(db.WorkspaceFeatures{
+			WorkspaceUuid: workspace.Uuid,
+			Name:          "No date",
+		}).Code)
+	})
+
+	t.Run("should filter features due before a date", func(t *testing.T) {
+		rr := listFeatures("due_before=" + soon.AddDate(0, 0, 1).Format("2006-01-02"))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		features := []db.WorkspaceFeatures{}
+		err := json.Unmarshal(rr.Body.Bytes(), &db.PaginatedResponse{Items: &features})
+		assert.NoError(t, err)
+		assert.Len(t, features, 1)
+		assert.Equal(t, "Due soon", features[0].Name)
+	})
+
+	t.Run("should reject a malformed due_before", func(t *testing.T) {
+		rr := listFeatures("due_before=next-week")
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestFeatureTags(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	createFeature := func(name string, tags []string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(db.WorkspaceFeatures{
+			WorkspaceUuid: workspace.Uuid,
+			Name:          name,
+			Tags:          tags,
+		})
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.CreateOrEditFeatures).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should reject too many tags", func(t *testing.T) {
+		tags := make([]string, 11)
+		for i := range tags {
+			tags[i] = "tag" + strconv.Itoa(i)
+		}
+
+		rr := createFeature("Too many tags", tags)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should reject tags that are too long or empty", func(t *testing.T) {
+		rr := createFeature("Long tag", []string{"this-tag-is-much-longer-than-thirty-characters"})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = createFeature("Empty tag", []string{"  "})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should filter features matching any of the given tags", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, createFeature("Payments", []string{"backend", "payments"}).Code)
+		assert.Equal(t, http.StatusOK, createFeature("Landing page", []string{"frontend"}).Code)
+		assert.Equal(t, http.StatusOK, createFeature("Docs", []string{"docs"}).Code)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/forworkspace/"+workspace.Uuid+"?tags=payments,frontend", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeaturesByWorkspaceUuid).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		features := []db.WorkspaceFeatures{}
+		err = json.Unmarshal(rr.Body.Bytes(), &db.PaginatedResponse{Items: &features})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		names := []string{}
+		for _, feature := range features {
+			names = append(names, feature.Name)
+		}
+		assert.ElementsMatch(t, []string{"Payments", "Landing page"}, names)
+	})
+
+	t.Run("should only accept vocabulary tags when strict tags are enabled", func(t *testing.T) {
+		config.StrictWorkspaceTags = true
+		defer func() { config.StrictWorkspaceTags = false }()
+
+		_, err := db.TestDB.CreateWorkspaceTag(db.WorkspaceTag{WorkspaceUuid: workspace.Uuid, Name: "backend"})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, createFeature("Unknown tag", []string{"backend", "random"}).Code)
+		assert.Equal(t, http.StatusOK, createFeature("Known tag", []string{"Backend"}).Code)
+	})
+}
+
+func TestWatchFeature(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Watched feature",
+	})
+
+	watcherPubKey := "watcher-key"
+	websocketToken := uuid.New().String()
+	db.TestDB.CreateWorkspaceUser(db.WorkspaceUsers{
+		WorkspaceUuid: workspace.Uuid,
+		OwnerPubKey:   watcherPubKey,
+	})
+
+	received := make(chan map[string]interface{}, 1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.Close()
+
+		msg := map[string]interface{}{}
+		if err := ws.ReadJSON(&msg); err == nil {
+			received <- msg
+		}
+	}))
+	defer s.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(s.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+
+	fHandler.getSocketConnections = func(host string) (db.Client, error) {
+		if host != websocketToken {
+			return db.Client{}, errors.New("Socket Cache not found")
+		}
+		return db.Client{Host: host, Conn: ws}, nil
+	}
+
+	t.Run("should subscribe the user to the feature", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]string{"websocket_token": websocketToken})
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, watcherPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/"+feature.Uuid+"/watch", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.WatchFeature).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		watchers := db.TestDB.GetFeatureWatchers(feature.Uuid)
+		assert.Len(t, watchers, 1)
+		assert.Equal(t, watcherPubKey, watchers[0].OwnerPubKey)
+	})
+
+	t.Run("should notify watchers when the feature changes", func(t *testing.T) {
+		feature.Name = "Watched feature renamed"
+		body, _ := json.Marshal(feature)
+
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.CreateOrEditFeatures).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		select {
+		case msg := <-received:
+			assert.Equal(t, "feature_updated", msg["msg"])
+			assert.Equal(t, feature.Uuid, msg["feature_uuid"])
+		case <-time.After(5 * time.Second):
+			t.Fatal("watcher was not notified")
+		}
+	})
+}
+
+func TestGetFeatureBurndown(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Burndown feature",
+	})
+	phase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "Burndown phase",
+	})
+
+	dayOne := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+	dayTwo := dayOne.AddDate(0, 0, 1)
+
+	bounties := []db.NewBounty{
+		{Title: "completed on day two", Created: dayOne.Unix(), Completed: true, CompletionDate: &dayTwo},
+		{Title: "still open", Created: dayOne.Unix() + 1},
+	}
+	for _, bounty := range bounties {
+		bounty.Type = "coding"
+		bounty.Description = "burndown bounty"
+		bounty.OwnerID = workspace.OwnerPubKey
+		bounty.WorkspaceUuid = workspace.Uuid
+		bounty.PhaseUuid = phase.Uuid
+		db.TestDB.CreateOrEditBounty(bounty)
+	}
+
+	getBurndown := func(pubKey string, dateRange db.PaymentDateRange) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(dateRange)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/"+feature.Uuid+"/burndown", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeatureBurndown).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return daily open and completed counts", func(t *testing.T) {
+		rr := getBurndown(workspace.OwnerPubKey, db.PaymentDateRange{
+			StartDate: strconv.FormatInt(dayOne.Unix(), 10),
+			EndDate:   strconv.FormatInt(dayTwo.Unix(), 10),
+		})
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		burndown := []db.FeatureBurndown{}
+		err := json.Unmarshal(rr.Body.Bytes(), &burndown)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, []db.FeatureBurndown{
+			{Date: "2024-01-01", Open: 2, Completed: 0},
+			{Date: "2024-01-02", Open: 1, Completed: 1},
+		}, burndown)
+	})
+
+	t.Run("should reject an end date before the start date", func(t *testing.T) {
+		rr := getBurndown(workspace.OwnerPubKey, db.PaymentDateRange{
+			StartDate: strconv.FormatInt(dayTwo.Unix(), 10),
+			EndDate:   strconv.FormatInt(dayOne.Unix(), 10),
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 403 when the user cannot view the feature", func(t *testing.T) {
+		rr := getBurndown("outsider-key", db.PaymentDateRange{
+			StartDate: strconv.FormatInt(dayOne.Unix(), 10),
+			EndDate:   strconv.FormatInt(dayTwo.Unix(), 10),
+		})
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestGetFeatureVelocity(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Velocity feature",
+	})
+	phase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "Velocity phase",
+	})
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.January, 14, 0, 0, 0, 0, time.UTC)
+	doneOn := func(day int) *time.Time {
+		date := time.Date(2024, time.January, day, 12, 0, 0, 0, time.UTC)
+		return &date
+	}
+
+	bounties := []db.NewBounty{
+		{Title: "done in week one", Completed: true, CompletionDate: doneOn(2)},
+		{Title: "paid in week one", Paid: true, PaidDate: doneOn(3)},
+		{Title: "done in week two", Completed: true, CompletionDate: doneOn(9)},
+		{Title: "open one"},
+		{Title: "open two"},
+		{Title: "open three"},
+	}
+	for _, bounty := range bounties {
+		bounty.Type = "coding"
+		bounty.Description = "velocity bounty"
+		bounty.OwnerID = workspace.OwnerPubKey
+		bounty.WorkspaceUuid = workspace.Uuid
+		bounty.PhaseUuid = phase.Uuid
+		bounty.Created = start.Unix()
+		db.TestDB.CreateOrEditBounty(bounty)
+	}
+
+	getVelocity := func(pubKey string, dateRange db.PaymentDateRange) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(dateRange)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/"+feature.Uuid+"/velocity", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeatureVelocity).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return weekly completions and a projected finish", func(t *testing.T) {
+		rr := getVelocity(workspace.OwnerPubKey, db.PaymentDateRange{
+			StartDate: strconv.FormatInt(start.Unix(), 10),
+			EndDate:   strconv.FormatInt(end.Unix(), 10),
+		})
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		velocity := db.FeatureVelocity{}
+		err := json.Unmarshal(rr.Body.Bytes(), &velocity)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []db.FeatureVelocityWeek{
+			{WeekStart: "2024-01-01", Completed: 2},
+			{WeekStart: "2024-01-08", Completed: 1},
+		}, velocity.Weeks)
+		assert.Equal(t, 1.5, velocity.AverageWeekly)
+		assert.Equal(t, int64(3), velocity.OpenBounties)
+		assert.Equal(t, "2024-01-28", velocity.ProjectedFinish)
+	})
+
+	t.Run("should reject an invalid date range", func(t *testing.T) {
+		rr := getVelocity(workspace.OwnerPubKey, db.PaymentDateRange{
+			StartDate: strconv.FormatInt(end.Unix(), 10),
+			EndDate:   strconv.FormatInt(start.Unix(), 10),
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 403 when the user cannot view the feature", func(t *testing.T) {
+		rr := getVelocity("outsider-key", db.PaymentDateRange{
+			StartDate: strconv.FormatInt(start.Unix(), 10),
+			EndDate:   strconv.FormatInt(end.Unix(), 10),
+		})
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestGetWorkspaceFeaturesCount(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	db.InitCache()
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	getCount := func(query string) int64 {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/workspace/count/"+workspace.Uuid+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetWorkspaceFeaturesCount).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var count int64
+		err = json.Unmarshal(rr.Body.Bytes(), &count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return count
+	}
+
+	t.Run("should update the cached count after creating a feature", func(t *testing.T) {
+		assert.Equal(t, int64(0), getCount(""))
+
+		db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+			Uuid:          uuid.New().String(),
+			WorkspaceUuid: workspace.Uuid,
+			Name:          "Counted feature",
+		})
+
+		assert.Equal(t, int64(1), getCount(""))
+	})
+
+	t.Run("should bypass the cache when fresh is true", func(t *testing.T) {
+		db.Store.SetFeaturesCountCache(workspace.Uuid, 99)
+
+		assert.Equal(t, int64(99), getCount(""))
+		assert.Equal(t, int64(1), getCount("?fresh=true"))
+	})
+}
+
+func TestFeatureTimestampsAreUTC(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	local := time.Local
+	time.Local = time.FixedZone("UTC+5", 5*60*60)
+	defer func() { time.Local = local }()
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "UTC feature",
+	})
+	phase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "UTC phase",
+	})
+	story, _ := db.TestDB.CreateOrEditFeatureStory(db.FeatureStory{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Description: "UTC story",
+	})
+
+	assertUTC := func(t *testing.T, timestamps ...*time.Time) {
+		for _, timestamp := range timestamps {
+			if assert.NotNil(t, timestamp) {
+				_, offset := timestamp.Zone()
+				assert.Equal(t, 0, offset)
+			}
+		}
+	}
+
+	t.Run("should return UTC timestamps from the db layer", func(t *testing.T) {
+		assertUTC(t, feature.Created, feature.Updated, phase.Created, phase.Updated, story.Created, story.Updated)
+
+		storedFeature := db.TestDB.GetFeatureByUuid(feature.Uuid)
+		storedPhase, _ := db.TestDB.GetFeaturePhaseByUuid(feature.Uuid, phase.Uuid)
+		storedStory, _ := db.TestDB.GetFeatureStoryByUuid(feature.Uuid, story.Uuid)
+
+		assertUTC(t, storedFeature.Created, storedFeature.Updated, storedPhase.Created, storedPhase.Updated, storedStory.Created, storedStory.Updated)
+
+		req, err := http.NewRequest(http.MethodGet, "/workspace/"+workspace.Uuid+"/phases", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		workspacePhases := db.TestDB.GetWorkspacePhases(workspace.Uuid, req)
+		if assert.Len(t, workspacePhases, 1) {
+			assertUTC(t, workspacePhases[0].Created, workspacePhases[0].Updated)
+		}
+	})
+
+	t.Run("should return UTC timestamps in feature responses", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/forworkspace/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeaturesByWorkspaceUuid).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		features := []db.WorkspaceFeatures{}
+		err = json.Unmarshal(rr.Body.Bytes(), &db.PaginatedResponse{Items: &features})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Len(t, features, 1)
+		assertUTC(t, features[0].Created, features[0].Updated)
+	})
+}
+
+func TestArchiveStaleFeatures(t *testing.T) {
+	mockDb := &dbMocks.Database{}
+	fHandler := NewFeatureHandler(mockDb)
+
+	archive := func(query string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", "workspace-uuid")
+		ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/workspace/workspace-uuid/archive_stale"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.ArchiveStaleFeatures).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 without the EditOrg role", func(t *testing.T) {
+		fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+
+		rr := archive("?older_than_days=30")
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return 400 for an invalid number of days", func(t *testing.T) {
+		fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return true
+		}
+
+		rr := archive("?older_than_days=0")
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should archive features older than the cutoff", func(t *testing.T) {
+		fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return role == db.EditOrg
+		}
+		mockDb.On("ArchiveStaleFeatures", "workspace-uuid", mock.MatchedBy(func(cutoff time.Time) bool {
+			expected := time.Now().AddDate(0, 0, -30)
+			return cutoff.Sub(expected) < time.Minute && expected.Sub(cutoff) < time.Minute
+		})).Return(int64(2), nil).Once()
+
+		rr := archive("?older_than_days=30")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"archived": 2}`, rr.Body.String())
+		mockDb.AssertExpectations(t)
+	})
+}
+
+func TestGetFeatureActivity(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Activity feature",
+	})
+	phase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "Activity phase",
+	})
+	db.TestDB.CreateOrEditFeatureStory(db.FeatureStory{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Description: "Activity story",
+	})
+	db.TestDB.CreateOrEditBounty(db.NewBounty{
+		Type:          "coding",
+		Title:         "Activity bounty",
+		Description:   "Activity bounty description",
+		OwnerID:       workspace.OwnerPubKey,
+		WorkspaceUuid: workspace.Uuid,
+		PhaseUuid:     phase.Uuid,
+		Created:       time.Now().Add(time.Hour).Unix(),
+	})
+
+	getActivity := func(query string) []db.FeatureActivity {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/"+feature.Uuid+"/activity"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeatureActivity).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		activity := []db.FeatureActivity{}
+		err = json.Unmarshal(rr.Body.Bytes(), &activity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return activity
+	}
+
+	t.Run("should return events in reverse-chronological order", func(t *testing.T) {
+		activity := getActivity("")
+
+		types := []string{}
+		for i, event := range activity {
+			types = append(types, event.Type)
+			if i > 0 {
+				assert.False(t, event.Date.After(activity[i-1].Date))
+			}
+		}
+
+		assert.Equal(t, []string{"bounty_created", "story_created", "phase_created", "feature_created"}, types)
+	})
+
+	t.Run("should only return events after the since cursor", func(t *testing.T) {
+		since := strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)
+		activity := getActivity("?since=" + since)
+
+		assert.Len(t, activity, 1)
+		assert.Equal(t, "bounty_created", activity[0].Type)
+	})
+
+	t.Run("should return 403 when the user cannot view the feature", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, "outsider-key")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/"+feature.Uuid+"/activity", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeatureActivity).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestFeatureUrlValidation(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	createFeature := func(featureUrl string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(db.WorkspaceFeatures{
+			WorkspaceUuid: workspace.Uuid,
+			Name:          "Url feature",
+			Url:           featureUrl,
+		})
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.CreateOrEditFeatures).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should trim and accept a valid url", func(t *testing.T) {
+		rr := createFeature("  https://github.com/stakwork/sphinx-tribes  ")
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		feature := db.WorkspaceFeatures{}
+		err := json.Unmarshal(rr.Body.Bytes(), &feature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "https://github.com/stakwork/sphinx-tribes", feature.Url)
+	})
+
+	t.Run("should accept an empty url", func(t *testing.T) {
+		rr := createFeature("")
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should reject a malformed url", func(t *testing.T) {
+		for _, featureUrl := range []string{"not a url", "github.com/stakwork", "ftp://example.com", "https://"} {
+			rr := createFeature(featureUrl)
+			assert.Equal(t, http.StatusBadRequest, rr.Code, featureUrl)
+		}
+	})
+}
+
+func TestGetStoryByUuid(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+
+	feature := db.WorkspaceFeatures{Uuid: "feature-uuid", WorkspaceUuid: "workspace-uuid"}
+	story := db.FeatureStory{Uuid: "story-uuid", FeatureUuid: feature.Uuid, Description: "a story"}
+
+	getStory := func(handlerFunc http.HandlerFunc, path string, withStory bool) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		if withStory {
+			rctx.URLParams.Add("story_uuid", story.Uuid)
+		}
+		ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		handlerFunc.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 without a pubkey", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "/feature-uuid/story/story-uuid", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetStoryByUuid).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should deny a story to a non-member", func(t *testing.T) {
+		fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+
+		rr := getStory(fHandler.GetStoryByUuid, "/feature-uuid/story/story-uuid", true)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should deny the story list to a non-member", func(t *testing.T) {
+		fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+
+		rr := getStory(fHandler.GetStoriesByFeatureUuid, "/feature-uuid/story", false)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("should return 404 for an unknown feature", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(db.WorkspaceFeatures{}).Once()
+
+		rr := getStory(fHandler.GetStoryByUuid, "/feature-uuid/story/story-uuid", true)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should return the story to a member with ViewReport", func(t *testing.T) {
+		fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return uuid == feature.WorkspaceUuid && role == db.ViewReport
+		}
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetFeatureStoryByUuid", feature.Uuid, story.Uuid).Return(story, nil).Once()
+
+		rr := getStory(fHandler.GetStoryByUuid, "/feature-uuid/story/story-uuid", true)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		returned := db.FeatureStory{}
+		err := json.Unmarshal(rr.Body.Bytes(), &returned)
+		assert.NoError(t, err)
+		assert.Equal(t, story.Description, returned.Description)
+	})
+}
+
+func TestStoryAcceptanceCriteria(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature := db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Feature with stories",
+	}
+	db.TestDB.CreateOrEditFeature(feature)
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	createStory := func(story db.FeatureStory) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(story)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/story", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.CreateOrEditStory).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should reject an empty criterion", func(t *testing.T) {
+		rr := createStory(db.FeatureStory{
+			FeatureUuid:        feature.Uuid,
+			Description:        "story",
+			AcceptanceCriteria: []string{"valid criterion", "   "},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should reject a criterion that is too long", func(t *testing.T) {
+		rr := createStory(db.FeatureStory{
+			FeatureUuid:        feature.Uuid,
+			Description:        "story",
+			AcceptanceCriteria: []string{strings.Repeat("a", 501)},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should round-trip acceptance criteria", func(t *testing.T) {
+		rr := createStory(db.FeatureStory{
+			FeatureUuid:        feature.Uuid,
+			Description:        "As a user I can sign in",
+			AcceptanceCriteria: []string{" shows an error on a bad password ", "redirects to the dashboard"},
+		})
+		assert.Equal(t, http.StatusCreated, rr.Code)
+
+		created := db.FeatureStory{}
+		err := json.Unmarshal(rr.Body.Bytes(), &created)
+		assert.NoError(t, err)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		rctx.URLParams.Add("story_uuid", created.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/"+feature.Uuid+"/story/"+created.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		getRr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetStoryByUuid).ServeHTTP(getRr, req)
+		assert.Equal(t, http.StatusOK, getRr.Code)
+
+		story := db.FeatureStory{}
+		err = json.Unmarshal(getRr.Body.Bytes(), &story)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"shows an error on a bad password", "redirects to the dashboard"}, []string(story.AcceptanceCriteria))
+	})
+}
+
+func TestFeatureSnapshots(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Snapshot feature",
+	})
+	phase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Name:        "Snapshot phase",
+	})
+	db.TestDB.CreateOrEditFeatureStory(db.FeatureStory{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: feature.Uuid,
+		Description: "Snapshot story",
+	})
+	db.TestDB.CreateOrEditBounty(db.NewBounty{
+		Type:          "coding",
+		Title:         "snapshot bounty",
+		Description:   "snapshot bounty description",
+		OwnerID:       workspace.OwnerPubKey,
+		WorkspaceUuid: workspace.Uuid,
+		PhaseUuid:     phase.Uuid,
+	})
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	request := func(handlerFunc http.HandlerFunc, method string, snapshotUuid string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		if snapshotUuid != "" {
+			rctx.URLParams.Add("snapshot_uuid", snapshotUuid)
+		}
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), method, "/"+feature.Uuid+"/snapshot", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		handlerFunc.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := request(fHandler.SnapshotFeature, http.MethodPost, "")
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	created := db.FeatureSnapshot{}
+	err := json.Unmarshal(rr.Body.Bytes(), &created)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// later edits must not change the stored snapshot
+	feature.Name = "Renamed feature"
+	db.TestDB.CreateOrEditFeature(feature)
+
+	t.Run("should read back the captured feature state", func(t *testing.T) {
+		rr := request(fHandler.GetFeatureSnapshot, http.MethodGet, created.Uuid)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		snapshot := db.FeatureSnapshot{}
+		err := json.Unmarshal(rr.Body.Bytes(), &snapshot)
+		assert.NoError(t, err)
+		assert.Equal(t, "Snapshot feature", snapshot.Data.Feature.Name)
+		assert.Equal(t, 1, snapshot.Data.Feature.BountiesCountOpen)
+		assert.Len(t, snapshot.Data.Phases, 1)
+		assert.Len(t, snapshot.Data.Stories, 1)
+		assert.Equal(t, "Snapshot story", snapshot.Data.Stories[0].Description)
+	})
+
+	t.Run("should list the feature's snapshots", func(t *testing.T) {
+		rr := request(fHandler.GetFeatureSnapshots, http.MethodGet, "")
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		snapshots := []db.FeatureSnapshot{}
+		err := json.Unmarshal(rr.Body.Bytes(), &snapshots)
+		assert.NoError(t, err)
+		assert.Len(t, snapshots, 1)
+		assert.Equal(t, created.Uuid, snapshots[0].Uuid)
+	})
+
+	t.Run("should return 404 for an unknown snapshot", func(t *testing.T) {
+		rr := request(fHandler.GetFeatureSnapshot, http.MethodGet, "unknown-snapshot")
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestPhaseStatus(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+	fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return role == db.EditOrg
+	}
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+	feature := db.WorkspaceFeatures{Uuid: "feature-uuid", WorkspaceUuid: "workspace-uuid"}
+
+	updateStatus := func(body string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		rctx.URLParams.Add("phase_uuid", "phase-uuid")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPut, "/feature-uuid/phase/phase-uuid/status", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.UpdatePhaseStatus).ServeHTTP(rr, req)
+		return rr
+	}
+
+	getPhases := func(query string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/feature-uuid/phase"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeaturePhases).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should reject an unknown status when creating a phase", func(t *testing.T) {
+		mockDb.On("GetFeaturePhaseByUuid", feature.Uuid, "phase-uuid").Return(db.FeaturePhase{}, errors.New("no phase found")).Once()
+
+		body, _ := json.Marshal(db.FeaturePhase{Uuid: "phase-uuid", FeatureUuid: feature.Uuid, Name: "Phase", PhaseStatus: "paused"})
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/phase", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.CreateOrEditFeaturePhase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should reject an unknown status update", func(t *testing.T) {
+		rr := updateStatus(`{"phase_status": "paused"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should update the phase status", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("UpdateFeaturePhaseStatus", feature.Uuid, "phase-uuid", db.PlannedPhase).Return(db.FeaturePhase{Uuid: "phase-uuid", PhaseStatus: db.PlannedPhase}, nil).Once()
+
+		rr := updateStatus(`{"phase_status": "planned"}`)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		phase := db.FeaturePhase{}
+		err := json.Unmarshal(rr.Body.Bytes(), &phase)
+		assert.NoError(t, err)
+		assert.Equal(t, db.PlannedPhase, phase.PhaseStatus)
+	})
+
+	t.Run("should filter phases by status", func(t *testing.T) {
+		mockDb.On("GetPhasesByFeatureUuid", feature.Uuid).Return([]db.FeaturePhase{
+			{Uuid: "planned-phase", PhaseStatus: db.PlannedPhase},
+			{Uuid: "active-phase", PhaseStatus: db.ActivePhase},
+			{Uuid: "completed-phase", PhaseStatus: db.CompletedPhase},
+		}).Once()
+
+		rr := getPhases("?status=active")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		phases := []db.FeaturePhase{}
+		err := json.Unmarshal(rr.Body.Bytes(), &phases)
+		assert.NoError(t, err)
+		assert.Len(t, phases, 1)
+		assert.Equal(t, "active-phase", phases[0].Uuid)
+	})
+
+	t.Run("should reject an unknown status filter", func(t *testing.T) {
+		rr := getPhases("?status=paused")
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestCustomFeatureStatus(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+	fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return role == db.EditOrg
+	}
+	wHandler := NewWorkspaceHandler(mockDb)
+	wHandler.userHasAccess = fHandler.userHasAccess
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "test-key"}
+	feature := db.WorkspaceFeatures{Uuid: "feature-uuid", WorkspaceUuid: workspace.Uuid}
+	inReview := db.WorkspaceFeatureStatus{WorkspaceUuid: workspace.Uuid, Name: "in review", CreatedBy: "test-key"}
+
+	updateStatus := func(body string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPut, "/feature-uuid/status", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.UpdateFeatureStatus).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should add a custom status to the workspace", func(t *testing.T) {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceFeatureStatuses", workspace.Uuid).Return([]db.WorkspaceFeatureStatus{}).Once()
+		mockDb.On("CreateWorkspaceFeatureStatus", mock.MatchedBy(func(status db.WorkspaceFeatureStatus) bool {
+			return status.Name == inReview.Name && status.WorkspaceUuid == workspace.Uuid
+		})).Return(inReview, nil).Once()
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/feature_statuses/"+workspace.Uuid, strings.NewReader(`{"name": " In Review "}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(wHandler.CreateWorkspaceFeatureStatus).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should not add a built-in status again", func(t *testing.T) {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/feature_statuses/"+workspace.Uuid, strings.NewReader(`{"name": "Archived"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(wHandler.CreateWorkspaceFeatureStatus).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("should move a feature to the custom status", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetWorkspaceFeatureStatuses", workspace.Uuid).Return([]db.WorkspaceFeatureStatus{inReview}).Once()
+		mockDb.On("UpdateFeatureStatus", feature.Uuid, db.FeatureStatus("in review")).Return(db.WorkspaceFeatures{Uuid: feature.Uuid, FeatStatus: "in review"}, nil).Once()
+		mockDb.On("GetFeatureWatchers", feature.Uuid).Return([]db.FeatureWatcher{}).Once()
+
+		rr := updateStatus(`{"feat_status": "In Review"}`)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		updated := db.WorkspaceFeatures{}
+		err := json.Unmarshal(rr.Body.Bytes(), &updated)
+		assert.NoError(t, err)
+		assert.Equal(t, db.FeatureStatus("in review"), updated.FeatStatus)
+	})
+
+	t.Run("should always allow the built-in statuses", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("UpdateFeatureStatus", feature.Uuid, db.ArchivedFeature).Return(db.WorkspaceFeatures{Uuid: feature.Uuid, FeatStatus: db.ArchivedFeature}, nil).Once()
+		mockDb.On("GetFeatureWatchers", feature.Uuid).Return([]db.FeatureWatcher{}).Once()
+
+		rr := updateStatus(`{"feat_status": "archived"}`)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should reject a status the workspace does not define", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetWorkspaceFeatureStatuses", workspace.Uuid).Return([]db.WorkspaceFeatureStatus{inReview}).Once()
+
+		rr := updateStatus(`{"feat_status": "blocked"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestMoveStoryToFeature(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	fHandler := NewFeatureHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+	otherWorkspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+		Description: "Other Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(otherWorkspace)
+
+	source, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Source feature",
+	})
+	target, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspace.Uuid,
+		Name:          "Target feature",
+	})
+	foreign, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: otherWorkspace.Uuid,
+		Name:          "Foreign feature",
+	})
+	story, _ := db.TestDB.CreateOrEditFeatureStory(db.FeatureStory{
+		Uuid:        uuid.New().String(),
+		FeatureUuid: source.Uuid,
+		Description: "Story to move",
+	})
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	moveStory := func(targetUuid string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", source.Uuid)
+		rctx.URLParams.Add("story_uuid", story.Uuid)
+		body, _ := json.Marshal(map[string]string{"target_feature_uuid": targetUuid})
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/"+source.Uuid+"/story/"+story.Uuid+"/move", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.MoveStoryToFeature).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should not move a story to another workspace", func(t *testing.T) {
+		rr := moveStory(foreign.Uuid)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should move the story to the target feature", func(t *testing.T) {
+		rr := moveStory(target.Uuid)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		moved := db.FeatureStory{}
+		err := json.Unmarshal(rr.Body.Bytes(), &moved)
+		assert.NoError(t, err)
+		assert.Equal(t, target.Uuid, moved.FeatureUuid)
+
+		sourceStories, _ := db.TestDB.GetFeatureStoriesByFeatureUuid(source.Uuid)
+		assert.Empty(t, sourceStories)
+		targetStories, _ := db.TestDB.GetFeatureStoriesByFeatureUuid(target.Uuid)
+		assert.Len(t, targetStories, 1)
+	})
+}
+
+func TestGetFeaturesByWorkspaceUuidETag(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+	lastUpdated := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	getFeatures := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", "workspace-uuid")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/forworkspace/workspace-uuid?page=1&limit=10", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeaturesByWorkspaceUuid).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 304 when the features have not changed", func(t *testing.T) {
+		mockDb.On("GetFeaturesCountByWorkspaceUuid", "workspace-uuid", mock.Anything).Return(int64(1)).Twice()
+		mockDb.On("GetFeaturesLastUpdated", "workspace-uuid", mock.Anything).Return(lastUpdated).Twice()
+		mockDb.On("GetFeaturesByWorkspaceUuid", "workspace-uuid", mock.Anything).Return([]db.WorkspaceFeatures{{Uuid: "feature-uuid"}}).Once()
+
+		rr := getFeatures("")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		etag := rr.Header().Get("ETag")
+		assert.NotEmpty(t, etag)
+
+		rr = getFeatures(etag)
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+		assert.Equal(t, etag, rr.Header().Get("ETag"))
+		assert.Empty(t, rr.Body.String())
+	})
+
+	t.Run("should return the features when one was updated", func(t *testing.T) {
+		mockDb.On("GetFeaturesCountByWorkspaceUuid", "workspace-uuid", mock.Anything).Return(int64(1)).Twice()
+		mockDb.On("GetFeaturesLastUpdated", "workspace-uuid", mock.Anything).Return(lastUpdated).Once()
+		mockDb.On("GetFeaturesLastUpdated", "workspace-uuid", mock.Anything).Return(lastUpdated.Add(time.Minute)).Once()
+		mockDb.On("GetFeaturesByWorkspaceUuid", "workspace-uuid", mock.Anything).Return([]db.WorkspaceFeatures{{Uuid: "feature-uuid"}}).Twice()
+
+		etag := getFeatures("").Header().Get("ETag")
+
+		rr := getFeatures(etag)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NotEqual(t, etag, rr.Header().Get("ETag"))
+	})
+}
+
+func TestGetPhaseDetail(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+	fHandler.generateBountyHandler = func(bounties []db.NewBounty) []db.BountyResponse {
+		responses := []db.BountyResponse{}
+		for _, bounty := range bounties {
+			responses = append(responses, db.BountyResponse{Bounty: bounty})
+		}
+		return responses
+	}
+
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key"}
+	feature := db.WorkspaceFeatures{Uuid: "feature-uuid", WorkspaceUuid: workspace.Uuid}
+	phase := db.FeaturePhase{Uuid: "phase-uuid", FeatureUuid: feature.Uuid, Name: "Phase"}
+
+	getDetail := func(pubkey string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		rctx.URLParams.Add("phase_uuid", phase.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/"+feature.Uuid+"/phase/"+phase.Uuid+"/detail", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetPhaseDetail).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 for a non member", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceUser", "outsider", workspace.Uuid).Return(db.WorkspaceUsers{}).Once()
+
+		rr := getDetail("outsider")
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return the phase with its bounties and counts", func(t *testing.T) {
+		bounties := []db.NewBounty{
+			{ID: 1, Title: "open", PhaseUuid: phase.Uuid},
+			{ID: 2, Title: "assigned", Assignee: "hunter", PhaseUuid: phase.Uuid},
+		}
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetFeaturePhaseByUuid", feature.Uuid, phase.Uuid).Return(phase, nil).Once()
+		mockDb.On("GetBountiesByFeatureAndPhaseUuid", feature.Uuid, phase.Uuid, mock.Anything).Return(bounties, nil).Once()
+		mockDb.On("GetBountiesCountByFeatureAndPhaseUuid", feature.Uuid, phase.Uuid, mock.Anything).Return(int64(2)).Once()
+		mockDb.On("GetFeaturePhasesBountiesCount", "open", phase.Uuid).Return(int64(1)).Once()
+		mockDb.On("GetFeaturePhasesBountiesCount", "assigned", phase.Uuid).Return(int64(1)).Once()
+		mockDb.On("GetFeaturePhasesBountiesCount", "completed", phase.Uuid).Return(int64(0)).Once()
+
+		rr := getDetail(workspace.OwnerPubKey)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		detail := db.PhaseDetail{}
+		err := json.Unmarshal(rr.Body.Bytes(), &detail)
+		assert.NoError(t, err)
+		assert.Equal(t, phase.Uuid, detail.Phase.Uuid)
+		assert.Len(t, detail.Bounties, 2)
+		assert.Equal(t, "assigned", detail.Bounties[1].Bounty.Title)
+		assert.Equal(t, int64(2), detail.BountiesCount)
+		assert.Equal(t, int64(1), detail.BountiesCountOpen)
+		assert.Equal(t, int64(1), detail.BountiesCountAssigned)
+		assert.Equal(t, int64(0), detail.BountiesCountCompleted)
+	})
+}
+
+func TestCreateOrEditFeaturesWarnings(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "test-key"}
+
+	saveFeature := func(feature db.WorkspaceFeatures) db.WorkspaceFeatures {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("CreateOrEditFeature", mock.AnythingOfType("db.WorkspaceFeatures")).Return(feature, nil).Once()
+		mockDb.On("GetFeatureWatchers", feature.Uuid).Return([]db.FeatureWatcher{}).Once()
+
+		body, _ := json.Marshal(feature)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.CreateOrEditFeatures).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		saved := db.WorkspaceFeatures{}
+		err = json.Unmarshal(rr.Body.Bytes(), &saved)
+		assert.NoError(t, err)
+		return saved
+	}
+
+	t.Run("should warn about an empty url without failing", func(t *testing.T) {
+		saved := saveFeature(db.WorkspaceFeatures{
+			Uuid:          "feature-uuid",
+			WorkspaceUuid: workspace.Uuid,
+			Name:          "Payments",
+			Brief:         "Pay hunters",
+		})
+
+		assert.Equal(t, []string{"url is empty"}, saved.Warnings)
+	})
+
+	t.Run("should not warn about a complete feature", func(t *testing.T) {
+		saved := saveFeature(db.WorkspaceFeatures{
+			Uuid:          "feature-uuid",
+			WorkspaceUuid: workspace.Uuid,
+			Name:          "Payments",
+			Brief:         "Pay hunters",
+			Url:           "https://github.com/stakwork/sphinx-tribes",
+		})
+
+		assert.Empty(t, saved.Warnings)
+	})
+}
+
+func TestGetFeatureTree(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "test-key"}
+	feature := db.WorkspaceFeatures{Uuid: "feature-uuid", WorkspaceUuid: workspace.Uuid, Name: "Payments"}
+	phases := []db.FeaturePhase{
+		{Uuid: "phase-one", FeatureUuid: feature.Uuid, Name: "Design"},
+		{Uuid: "phase-two", FeatureUuid: feature.Uuid, Name: "Build"},
+	}
+	stories := []db.FeatureStory{{Uuid: "story-uuid", FeatureUuid: feature.Uuid, Description: "As a hunter I get paid"}}
+
+	// only the owner holds ViewReport, members without it can't see stories
+	fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return pubKeyFromAuth == workspace.OwnerPubKey
+	}
+
+	getTreeAs := func(pubkey string, query string) db.FeatureTree {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Twice()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		if pubkey == workspace.OwnerPubKey {
+			mockDb.On("GetFeatureStoriesByFeatureUuid", feature.Uuid).Return(stories, nil).Once()
+		} else {
+			mockDb.On("GetWorkspaceUser", pubkey, workspace.Uuid).Return(db.WorkspaceUsers{OwnerPubKey: pubkey, WorkspaceUuid: workspace.Uuid}).Once()
+		}
+		mockDb.On("GetPhasesByFeatureUuid", feature.Uuid).Return(phases).Once()
+		for i, phase := range phases {
+			mockDb.On("GetFeaturePhasesBountiesCount", "open", phase.Uuid).Return(int64(i + 1)).Once()
+			mockDb.On("GetFeaturePhasesBountiesCount", "assigned", phase.Uuid).Return(int64(0)).Once()
+			mockDb.On("GetFeaturePhasesBountiesCount", "completed", phase.Uuid).Return(int64(1)).Once()
+		}
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/"+feature.Uuid+"/tree?"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetFeatureTree).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		tree := db.FeatureTree{}
+		err = json.Unmarshal(rr.Body.Bytes(), &tree)
+		assert.NoError(t, err)
+		return tree
+	}
+	getTree := func(query string) db.FeatureTree {
+		return getTreeAs(workspace.OwnerPubKey, query)
+	}
+
+	t.Run("should nest phases with bounty counts and stories under the feature", func(t *testing.T) {
+		tree := getTree("")
+
+		assert.Equal(t, feature.Uuid, tree.Feature.Uuid)
+		assert.Len(t, tree.Phases, 2)
+		assert.Equal(t, "Design", tree.Phases[0].Name)
+		assert.Equal(t, int64(1), tree.Phases[0].BountiesCountOpen)
+		assert.Equal(t, int64(2), tree.Phases[1].BountiesCountOpen)
+		assert.Equal(t, int64(1), tree.Phases[1].BountiesCountCompleted)
+		assert.Empty(t, tree.Phases[0].Bounties)
+		assert.Equal(t, stories[0].Uuid, tree.Stories[0].Uuid)
+	})
+
+	t.Run("should list each phase's bounties with include_bounties", func(t *testing.T) {
+		mockDb.On("GetBountiesByPhaseUuid", "phase-one").Return([]db.Bounty{{ID: 1, Title: "design bounty"}}).Once()
+		mockDb.On("GetBountiesByPhaseUuid", "phase-two").Return([]db.Bounty{}).Once()
+
+		tree := getTree("include_bounties=true")
+
+		assert.Len(t, tree.Phases[0].Bounties, 1)
+		assert.Equal(t, "design bounty", tree.Phases[0].Bounties[0].Title)
+		assert.Empty(t, tree.Phases[1].Bounties)
+	})
+
+	t.Run("should leave out the stories for a plain member", func(t *testing.T) {
+		tree := getTreeAs("member-key", "")
+
+		assert.Len(t, tree.Phases, 2)
+		assert.Empty(t, tree.Stories)
+	})
+}
+
+func TestPhaseMustBelongToFeature(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+
+	request := func(handler http.HandlerFunc, method string, path string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", "feature-uuid")
+		rctx.URLParams.Add("phase_uuid", "other-feature-phase")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), method, "/feature-uuid/phase/other-feature-phase"+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	endpoints := []struct {
+		name    string
+		handler http.HandlerFunc
+		method  string
+		path    string
+	}{
+		{"DeleteFeaturePhase", fHandler.DeleteFeaturePhase, http.MethodDelete, ""},
+		{"GetBountiesByFeatureAndPhaseUuid", fHandler.GetBountiesByFeatureAndPhaseUuid, http.MethodGet, "/bounty"},
+		{"GetBountiesCountByFeatureAndPhaseUuid", fHandler.GetBountiesCountByFeatureAndPhaseUuid, http.MethodGet, "/bounty/count"},
+	}
+
+	for _, endpoint := range endpoints {
+		t.Run("should return 404 from "+endpoint.name+" for a phase of another feature", func(t *testing.T) {
+			mockDb.On("GetFeaturePhaseByUuid", "feature-uuid", "other-feature-phase").Return(db.FeaturePhase{}, errors.New("no phase found")).Once()
+
+			rr := request(endpoint.handler, endpoint.method, endpoint.path)
+
+			assert.Equal(t, http.StatusNotFound, rr.Code)
+			mockDb.AssertNotCalled(t, "DeleteFeaturePhase", mock.Anything, mock.Anything)
+			mockDb.AssertNotCalled(t, "GetBountiesCountByFeatureAndPhaseUuid", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestFeatureWatchersAccess(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key"}
+	feature := db.WorkspaceFeatures{Uuid: "feature-uuid", WorkspaceUuid: workspace.Uuid}
+
+	request := func(handler http.HandlerFunc, method string, pubkey string, body string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), method, "/"+feature.Uuid+"/watchers", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 when a non member lists the watchers", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceUser", "outsider", workspace.Uuid).Return(db.WorkspaceUsers{}).Once()
+
+		rr := request(fHandler.GetFeatureWatchers, http.MethodGet, "outsider", "")
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return 401 when a non member watches the feature", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceUser", "outsider", workspace.Uuid).Return(db.WorkspaceUsers{}).Once()
+
+		rr := request(fHandler.WatchFeature, http.MethodPost, "outsider", `{"websocket_token": "outsider-token"}`)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		mockDb.AssertNotCalled(t, "WatchFeature", mock.Anything)
+	})
+
+	t.Run("should list the watchers without their websocket tokens", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetFeatureWatchers", feature.Uuid).Return([]db.FeatureWatcher{
+			{FeatureUuid: feature.Uuid, OwnerPubKey: "watcher-key", WebsocketToken: "secret-token"},
+		}).Once()
+
+		rr := request(fHandler.GetFeatureWatchers, http.MethodGet, workspace.OwnerPubKey, "")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "watcher-key")
+		assert.NotContains(t, rr.Body.String(), "secret-token")
+		assert.NotContains(t, rr.Body.String(), "websocket_token")
+	})
+
+	t.Run("should store the token sent by a member without echoing it", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("WatchFeature", db.FeatureWatcher{
+			FeatureUuid:    feature.Uuid,
+			OwnerPubKey:    workspace.OwnerPubKey,
+			WebsocketToken: "owner-token",
+		}).Return(db.FeatureWatcher{FeatureUuid: feature.Uuid, OwnerPubKey: workspace.OwnerPubKey, WebsocketToken: "owner-token"}, nil).Once()
+
+		rr := request(fHandler.WatchFeature, http.MethodPost, workspace.OwnerPubKey, `{"websocket_token": "owner-token"}`)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NotContains(t, rr.Body.String(), "owner-token")
+	})
+}
+
+func TestFeatureDependencyAccess(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+	fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return false
+	}
+
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key"}
+	featureA := db.WorkspaceFeatures{Uuid: "feature-a", WorkspaceUuid: workspace.Uuid}
+	featureB := db.WorkspaceFeatures{Uuid: "feature-b", WorkspaceUuid: workspace.Uuid}
+
+	request := func(handler http.HandlerFunc, method string, body string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", featureA.Uuid)
+		rctx.URLParams.Add("depends_on_uuid", featureB.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, "outsider")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), method, "/dependency", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 when adding a dependency without edit access", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", featureA.Uuid).Return(featureA).Once()
+		mockDb.On("GetFeatureByUuid", featureB.Uuid).Return(featureB).Once()
+
+		rr := request(fHandler.AddFeatureDependency, http.MethodPost, `{"feature_uuid": "feature-a", "depends_on_uuid": "feature-b"}`)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		mockDb.AssertNotCalled(t, "AddFeatureDependency", mock.Anything)
+	})
+
+	t.Run("should return 401 when removing a dependency without edit access", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", featureA.Uuid).Return(featureA).Once()
+
+		rr := request(fHandler.RemoveFeatureDependency, http.MethodDelete, "")
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		mockDb.AssertNotCalled(t, "DeleteFeatureDependency", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return 401 when a non member reads the dependencies", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", featureA.Uuid).Return(featureA).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceUser", "outsider", workspace.Uuid).Return(db.WorkspaceUsers{}).Once()
+
+		rr := request(fHandler.GetFeatureDependencies, http.MethodGet, "")
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		mockDb.AssertNotCalled(t, "GetUpstreamFeatures", mock.Anything)
+	})
+}
+
+func TestUpdateFeatureStatusNotifiesWatchers(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+	fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+
+	feature := db.WorkspaceFeatures{Uuid: "feature-uuid", WorkspaceUuid: "workspace-uuid", Name: "Watched feature"}
+	archived := feature
+	archived.FeatStatus = db.ArchivedFeature
+
+	received := make(chan map[string]interface{}, 1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.Close()
+
+		msg := map[string]interface{}{}
+		if err := ws.ReadJSON(&msg); err == nil {
+			received <- msg
+		}
+	}))
+	defer s.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(s.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+
+	fHandler.getSocketConnections = func(host string) (db.Client, error) {
+		if host != "watcher-token" {
+			return db.Client{}, errors.New("Socket Cache not found")
+		}
+		return db.Client{Host: host, Conn: ws}, nil
+	}
+
+	mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+	mockDb.On("UpdateFeatureStatus", feature.Uuid, db.ArchivedFeature).Return(archived, nil).Once()
+	mockDb.On("GetFeatureWatchers", feature.Uuid).Return([]db.FeatureWatcher{
+		{FeatureUuid: feature.Uuid, OwnerPubKey: "watcher-key", WebsocketToken: "watcher-token"},
+	}).Once()
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("feature_uuid", feature.Uuid)
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "editor-key")
+	req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPut, "/"+feature.Uuid+"/status", strings.NewReader(`{"feat_status": "archived"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(fHandler.UpdateFeatureStatus).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "feature_updated", msg["msg"])
+		assert.Equal(t, feature.Uuid, msg["feature_uuid"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("watcher was not notified")
+	}
+}
+
+func TestAssignPhaseBounties(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+	fHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key"}
+	feature := db.WorkspaceFeatures{Uuid: "feature-uuid", WorkspaceUuid: workspace.Uuid}
+	phase := db.FeaturePhase{Uuid: "phase-uuid", FeatureUuid: feature.Uuid}
+
+	assign := func(assignee string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("feature_uuid", feature.Uuid)
+		rctx.URLParams.Add("phase_uuid", phase.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		body := `{"bounty_ids": [1, 2], "assignee": "` + assignee + `"}`
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/"+feature.Uuid+"/phase/"+phase.Uuid+"/bounty/assign", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.AssignPhaseBounties).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 400 when the assignee is not a workspace member", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetFeaturePhaseByUuid", feature.Uuid, phase.Uuid).Return(phase, nil).Once()
+		mockDb.On("GetPersonByPubkey", "outsider").Return(db.Person{OwnerPubKey: "outsider"}).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceUser", "outsider", workspace.Uuid).Return(db.WorkspaceUsers{}).Once()
+
+		rr := assign("outsider")
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		mockDb.AssertNotCalled(t, "AssignPhaseBounties", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should assign the bounties to a workspace member", func(t *testing.T) {
+		mockDb.On("GetFeatureByUuid", feature.Uuid).Return(feature).Once()
+		mockDb.On("GetFeaturePhaseByUuid", feature.Uuid, phase.Uuid).Return(phase, nil).Once()
+		mockDb.On("GetPersonByPubkey", "member-key").Return(db.Person{OwnerPubKey: "member-key"}).Once()
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceUser", "member-key", workspace.Uuid).Return(db.WorkspaceUsers{OwnerPubKey: "member-key", WorkspaceUuid: workspace.Uuid}).Once()
+		mockDb.On("AssignPhaseBounties", phase.Uuid, []uint{1, 2}, "member-key").Return([]db.PhaseBountyAssignment{
+			{BountyID: 1, Assigned: true},
+			{BountyID: 2, Assigned: true},
+		}, nil).Once()
+
+		rr := assign("member-key")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestGetWorkspacePhases(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	fHandler := NewFeatureHandler(mockDb)
+
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key"}
+
+	getPhases := func(pubKey string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("workspace_uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/workspace/"+workspace.Uuid+"/phases", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(fHandler.GetWorkspacePhases).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 when the user is not a workspace member", func(t *testing.T) {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceUser", "outsider", workspace.Uuid).Return(db.WorkspaceUsers{}).Once()
+
+		rr := getPhases("outsider")
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		mockDb.AssertNotCalled(t, "GetWorkspacePhases", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return the workspace phases to a member", func(t *testing.T) {
+		phases := []db.WorkspacePhase{{FeaturePhase: db.FeaturePhase{Uuid: "phase-uuid", FeatureUuid: "feature-uuid"}, FeatureName: "feature"}}
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspacePhases", workspace.Uuid, mock.Anything).Return(phases).Once()
+		mockDb.On("GetWorkspacePhasesCount", workspace.Uuid).Return(int64(1)).Once()
+
+		rr := getPhases(workspace.OwnerPubKey)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		returnedPhases := []db.WorkspacePhase{}
+		err := json.Unmarshal(rr.Body.Bytes(), &db.PaginatedResponse{Items: &returnedPhases})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, phases, returnedPhases)
+	})
+}
@@ -419,6 +419,39 @@ func (ph *peopleHandler) GetPersonByPubkey(w http.ResponseWriter, r *http.Reques
 	json.NewEncoder(w).Encode(person)
 }
 
+func (ph *peopleHandler) GetPersonBountyStats(w http.ResponseWriter, r *http.Request) {
+	pubkey := chi.URLParam(r, "pubkey")
+	workspaceUuid := r.URL.Query().Get("workspace_uuid")
+
+	stats := ph.db.GetPersonBountyStats(pubkey, workspaceUuid)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(stats)
+}
+
+// GetPersonActivityCounts returns how many bounties a person posted and
+// worked on, optionally between the start_date and end_date unix timestamps
+func (ph *peopleHandler) GetPersonActivityCounts(w http.ResponseWriter, r *http.Request) {
+	pubkey := chi.URLParam(r, "pubkey")
+	dateRange := db.PaymentDateRange{
+		StartDate: r.URL.Query().Get("start_date"),
+		EndDate:   r.URL.Query().Get("end_date"),
+	}
+
+	if dateRange.StartDate != "" || dateRange.EndDate != "" {
+		startDate, startErr := strconv.ParseInt(dateRange.StartDate, 10, 64)
+		endDate, endErr := strconv.ParseInt(dateRange.EndDate, 10, 64)
+		if startErr != nil || endErr != nil || endDate < startDate {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode("Invalid date range")
+			return
+		}
+	}
+
+	counts := ph.db.GetPersonActivityCounts(pubkey, dateRange)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(counts)
+}
+
 func (ph *peopleHandler) GetPersonById(w http.ResponseWriter, r *http.Request) {
 	idParam := chi.URLParam(r, "id")
 	id, _ := strconv.ParseUint(idParam, 10, 32)
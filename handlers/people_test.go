@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
@@ -54,6 +55,154 @@ func TestGetPersonByPuKey(t *testing.T) {
 	})
 }
 
+func TestGetPersonBountyStats(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	pHandler := NewPeopleHandler(db.TestDB)
+
+	hunter := uuid.New().String()
+	workspaceUuid := uuid.New().String()
+	otherWorkspaceUuid := uuid.New().String()
+
+	bounties := []db.NewBounty{
+		{Price: 1000, Paid: true, Completed: true, WorkspaceUuid: workspaceUuid},
+		{Price: 2500, Paid: true, Completed: true, WorkspaceUuid: otherWorkspaceUuid},
+		{Price: 4000, Completed: true, WorkspaceUuid: workspaceUuid},
+		{Price: 8000, WorkspaceUuid: workspaceUuid},
+	}
+	for _, bounty := range bounties {
+		bounty.Type = "coding"
+		bounty.Title = "Hunter bounty"
+		bounty.Description = "Hunter bounty description"
+		bounty.OwnerID = "bounty_owner_pubkey"
+		bounty.Assignee = hunter
+		bounty.Created = time.Now().Unix()
+		db.TestDB.CreateOrEditBounty(bounty)
+	}
+
+	getStats := func(query string) db.PersonBountyStats {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("pubkey", hunter)
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/person/"+hunter+"/bounty_stats"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(pHandler.GetPersonBountyStats).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		stats := db.PersonBountyStats{}
+		err = json.Unmarshal(rr.Body.Bytes(), &stats)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stats
+	}
+
+	t.Run("should aggregate all bounties assigned to the hunter", func(t *testing.T) {
+		assert.Equal(t, db.PersonBountyStats{
+			BountiesAssigned:  2,
+			BountiesCompleted: 3,
+			BountiesPaid:      2,
+			SatsEarned:        3500,
+		}, getStats(""))
+	})
+
+	t.Run("should scope the stats to a workspace", func(t *testing.T) {
+		assert.Equal(t, db.PersonBountyStats{
+			BountiesAssigned:  2,
+			BountiesCompleted: 2,
+			BountiesPaid:      1,
+			SatsEarned:        1000,
+		}, getStats("?workspace_uuid="+workspaceUuid))
+	})
+}
+
+func TestGetPersonActivityCounts(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	pHandler := NewPeopleHandler(db.TestDB)
+
+	person := uuid.New().String()
+	lastMonth := time.Now().AddDate(0, -1, 0).Unix()
+	now := time.Now().Unix()
+
+	bounties := []db.NewBounty{
+		{OwnerID: person, Created: now},
+		{OwnerID: person, Assignee: "other-hunter", Completed: true, Created: now},
+		{OwnerID: person, Created: lastMonth},
+		{OwnerID: "other-owner", Assignee: person, Completed: true, Paid: true, Created: now},
+		{OwnerID: "other-owner", Assignee: person, Completed: true, Created: lastMonth},
+		{OwnerID: "other-owner", Assignee: person, Created: now},
+		{OwnerID: "other-owner", Assignee: "other-hunter", Created: now},
+	}
+	for _, bounty := range bounties {
+		bounty.Type = "coding"
+		bounty.Title = "Activity bounty"
+		bounty.Description = "Activity bounty description"
+		db.TestDB.CreateOrEditBounty(bounty)
+	}
+
+	getCounts := func(query string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("pubkey", person)
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/person/"+person+"/activity"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(pHandler.GetPersonActivityCounts).ServeHTTP(rr, req)
+		return rr
+	}
+
+	decode := func(rr *httptest.ResponseRecorder) db.PersonActivityCounts {
+		counts := db.PersonActivityCounts{}
+		err := json.Unmarshal(rr.Body.Bytes(), &counts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return counts
+	}
+
+	t.Run("should count bounties posted and worked on", func(t *testing.T) {
+		rr := getCounts("")
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		assert.Equal(t, db.PersonActivityCounts{
+			BountiesPosted: 3,
+			PersonBountyStats: db.PersonBountyStats{
+				BountiesAssigned:  2,
+				BountiesCompleted: 2,
+				BountiesPaid:      1,
+			},
+		}, decode(rr))
+	})
+
+	t.Run("should only count bounties created in the date range", func(t *testing.T) {
+		start := time.Now().AddDate(0, 0, -7).Unix()
+		end := time.Now().AddDate(0, 0, 1).Unix()
+		rr := getCounts("?start_date=" + strconv.FormatInt(start, 10) + "&end_date=" + strconv.FormatInt(end, 10))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		assert.Equal(t, db.PersonActivityCounts{
+			BountiesPosted: 2,
+			PersonBountyStats: db.PersonBountyStats{
+				BountiesAssigned:  1,
+				BountiesCompleted: 1,
+				BountiesPaid:      1,
+			},
+		}, decode(rr))
+	})
+
+	t.Run("should reject a partial date range", func(t *testing.T) {
+		rr := getCounts("?start_date=1700000000")
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
 func TestCreateOrEditPerson(t *testing.T) {
 	teardownSuite := SetupSuite(t)
 	defer teardownSuite(t)
@@ -2,9 +2,12 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,23 +19,29 @@ import (
 	"gorm.io/gorm"
 )
 
+const stakworkProjectsUrl = "https://jobs.stakwork.com/api/v1/projects"
+
 type workspaceHandler struct {
+	httpClient               HttpClient
 	db                       db.Database
 	generateBountyHandler    func(bounties []db.NewBounty) []db.BountyResponse
 	getLightningInvoice      func(payment_request string) (db.InvoiceResult, db.InvoiceError)
 	userHasAccess            func(pubKeyFromAuth string, uuid string, role string) bool
 	userHasManageBountyRoles func(pubKeyFromAuth string, uuid string) bool
+	getAllUserWorkspaces     func(pubkey string) []db.Workspace
 }
 
 func NewWorkspaceHandler(database db.Database) *workspaceHandler {
 	bHandler := NewBountyHandler(http.DefaultClient, database)
 	dbConf := db.NewDatabaseConfig(&gorm.DB{})
 	return &workspaceHandler{
+		httpClient:               http.DefaultClient,
 		db:                       database,
 		generateBountyHandler:    bHandler.GenerateBountyResponse,
 		getLightningInvoice:      bHandler.GetLightningInvoice,
 		userHasAccess:            dbConf.UserHasAccess,
 		userHasManageBountyRoles: dbConf.UserHasManageBountyRoles,
+		getAllUserWorkspaces:     GetAllUserWorkspaces,
 	}
 }
 
@@ -73,6 +82,8 @@ func (oh *workspaceHandler) CreateOrEditWorkspace(w http.ResponseWriter, r *http
 		return
 	}
 
+	workspace.DisplayCurrency = strings.ToLower(strings.TrimSpace(workspace.DisplayCurrency))
+
 	if pubKeyFromAuth != workspace.OwnerPubKey {
 		hasRole := db.UserHasAccess(pubKeyFromAuth, workspace.Uuid, db.EditOrg)
 		if !hasRole {
@@ -124,9 +135,13 @@ func (oh *workspaceHandler) CreateOrEditWorkspace(w http.ResponseWriter, r *http
 		if len(workspace.Uuid) == 0 {
 			workspace.Uuid = xid.New().String()
 		}
+		if workspace.DisplayCurrency == "" {
+			workspace.DisplayCurrency = db.DefaultDisplayCurrency
+		}
 	} else {
 		workspace.Updated = &now
 		workspace.Created = existing.Created
+		keepUnsentWorkspaceFlags(body, &workspace, existing)
 	}
 
 	p, err := oh.db.CreateOrEditWorkspace(workspace)
@@ -139,6 +154,21 @@ func (oh *workspaceHandler) CreateOrEditWorkspace(w http.ResponseWriter, r *http
 	json.NewEncoder(w).Encode(p)
 }
 
+// keepUnsentWorkspaceFlags carries over the stored private and
+// requires_bounty_approval values when an edit does not send them, since
+// CreateOrEditWorkspace always writes both
+func keepUnsentWorkspaceFlags(body []byte, workspace *db.Workspace, existing db.Workspace) {
+	sent := map[string]json.RawMessage{}
+	json.Unmarshal(body, &sent)
+
+	if _, ok := sent["private"]; !ok {
+		workspace.Private = existing.Private
+	}
+	if _, ok := sent["requires_bounty_approval"]; !ok {
+		workspace.RequiresBountyApproval = existing.RequiresBountyApproval
+	}
+}
+
 func GetWorkspaces(w http.ResponseWriter, r *http.Request) {
 	orgs := db.DB.GetWorkspaces(r)
 
@@ -153,15 +183,31 @@ func GetWorkspacesCount(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(count)
 }
 
-func GetWorkspaceByUuid(w http.ResponseWriter, r *http.Request) {
+// GetWorkspaceByUuid returns the full workspace, except for private
+// workspaces where non-members only get the short version
+func (oh *workspaceHandler) GetWorkspaceByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+
 	uuid := chi.URLParam(r, "uuid")
-	workspace := db.DB.GetWorkspaceByUuid(uuid)
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+
+	if workspace.Private && (pubKeyFromAuth == "" || !isWorkspaceMember(oh.db, pubKeyFromAuth, uuid)) {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(db.WorkspaceShort{
+			Uuid:            workspace.Uuid,
+			Name:            workspace.Name,
+			Img:             workspace.Img,
+			DisplayCurrency: workspace.GetDisplayCurrency(),
+		})
+		return
+	}
 
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(workspace)
 }
 
-func CreateWorkspaceUser(w http.ResponseWriter, r *http.Request) {
+func (oh *workspaceHandler) CreateWorkspaceUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	now := time.Now()
@@ -183,7 +229,7 @@ func CreateWorkspaceUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// get orgnanization
-	workspace := db.DB.GetWorkspaceByUuid(workspaceUser.WorkspaceUuid)
+	workspace := oh.db.GetWorkspaceByUuid(workspaceUser.WorkspaceUuid)
 
 	if err != nil {
 		fmt.Println("[workspaces] ", err)
@@ -207,12 +253,12 @@ func CreateWorkspaceUser(w http.ResponseWriter, r *http.Request) {
 	// check if the user tries to add their self
 	if pubKeyFromAuth == workspaceUser.OwnerPubKey {
 		w.WriteHeader(http.StatusUnauthorized)
-		json.NewEncoder(w).Encode("Cannot add userself as a user")
+		json.NewEncoder(w).Encode("Cannot add yourself as a user")
 		return
 	}
 
 	// if not the orgnization admin
-	hasRole := db.UserHasAccess(pubKeyFromAuth, workspaceUser.WorkspaceUuid, db.AddUser)
+	hasRole := oh.userHasAccess(pubKeyFromAuth, workspaceUser.WorkspaceUuid, db.AddUser)
 	if !hasRole {
 		w.WriteHeader(http.StatusUnauthorized)
 		json.NewEncoder(w).Encode("Don't have access to add user")
@@ -220,7 +266,7 @@ func CreateWorkspaceUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// check if the user exists on peoples table
-	isUser := db.DB.GetPersonByPubkey(workspaceUser.OwnerPubKey)
+	isUser := oh.db.GetPersonByPubkey(workspaceUser.OwnerPubKey)
 	if isUser.OwnerPubKey != workspaceUser.OwnerPubKey {
 		w.WriteHeader(http.StatusUnauthorized)
 		json.NewEncoder(w).Encode("User doesn't exists in people")
@@ -228,7 +274,7 @@ func CreateWorkspaceUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// check if user already exists
-	userExists := db.DB.GetWorkspaceUser(workspaceUser.OwnerPubKey, workspaceUser.WorkspaceUuid)
+	userExists := oh.db.GetWorkspaceUser(workspaceUser.OwnerPubKey, workspaceUser.WorkspaceUuid)
 
 	if userExists.ID != 0 {
 		w.WriteHeader(http.StatusUnauthorized)
@@ -236,11 +282,33 @@ func CreateWorkspaceUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// the initial roles follow the same rules as AddUserRoles
+	rolesMap := db.GetRolesMap()
+	for _, role := range workspaceUser.Roles {
+		if _, ok := rolesMap[role]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode("not a valid user role")
+			return
+		}
+
+		if !oh.userHasAccess(pubKeyFromAuth, workspaceUser.WorkspaceUuid, role) {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode("cannot add a role you don't have")
+			return
+		}
+	}
+
 	workspaceUser.Created = &now
 	workspaceUser.Updated = &now
 
 	// create user
-	user := db.DB.CreateWorkspaceUser(workspaceUser)
+	user, err := oh.db.CreateWorkspaceUserWithRoles(workspaceUser)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(user)
 }
@@ -253,6 +321,108 @@ func GetWorkspaceUsers(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(workspaceUsers)
 }
 
+func (oh *workspaceHandler) GetWorkspaceTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.ViewReport)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to view the workspace team")
+		return
+	}
+
+	team, err := oh.db.GetWorkspaceTeam(uuid, r)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.FormatInt(oh.db.GetWorkspaceUsersCount(uuid), 10))
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(team)
+}
+
+// GetAssignableMembers lists the workspace owner and users that bounties can
+// be assigned to, leaving out deleted people
+func (oh *workspaceHandler) GetAssignableMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, uuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to this workspace")
+		return
+	}
+
+	workspaceUsers, err := oh.db.GetWorkspaceUsers(uuid)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	members := []db.AssignableMember{}
+
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+	owner := oh.db.GetPersonByPubkey(workspace.OwnerPubKey)
+	if owner.OwnerPubKey != "" {
+		members = append(members, db.AssignableMember{
+			OwnerPubKey: owner.OwnerPubKey,
+			OwnerAlias:  owner.OwnerAlias,
+			UniqueName:  owner.UniqueName,
+			Img:         owner.Img,
+			IsOwner:     true,
+		})
+	}
+
+	for _, user := range workspaceUsers {
+		// users without a person row come back with an empty pubkey
+		if user.OwnerPubKey == "" || user.Deleted || user.OwnerPubKey == workspace.OwnerPubKey {
+			continue
+		}
+		members = append(members, db.AssignableMember{
+			OwnerPubKey: user.OwnerPubKey,
+			OwnerAlias:  user.OwnerAlias,
+			UniqueName:  user.UniqueName,
+			Img:         user.Img,
+		})
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(members)
+}
+
+// isWorkspaceMember reports whether pubkey owns the workspace or has been
+// added to it as a user.
+func isWorkspaceMember(database db.Database, pubkey string, workspaceUuid string) bool {
+	workspace := database.GetWorkspaceByUuid(workspaceUuid)
+	if workspace.Uuid == "" {
+		return false
+	}
+	if workspace.OwnerPubKey == pubkey {
+		return true
+	}
+
+	workspaceUser := database.GetWorkspaceUser(pubkey, workspaceUuid)
+	return workspaceUser.OwnerPubKey == pubkey
+}
+
 func GetWorkspaceUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
@@ -331,6 +501,73 @@ func DeleteWorkspaceUser(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(workspaceUser)
 }
 
+// RemoveWorkspaceUsers offboards several users at once and reports the
+// outcome for each pubkey. The admin and non-members are skipped.
+func (oh *workspaceHandler) RemoveWorkspaceUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	request := struct {
+		Pubkeys []string `json:"pubkeys"`
+	}{}
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		fmt.Println("[body] ", err)
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	err = json.Unmarshal(body, &request)
+	if err != nil || len(request.Pubkeys) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: pubkeys must be a non-empty list")
+		return
+	}
+
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.DeleteUser)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to delete user")
+		return
+	}
+
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+
+	results := []db.WorkspaceUserRemoval{}
+	toRemove := []string{}
+	for _, pubkey := range request.Pubkeys {
+		result := db.WorkspaceUserRemoval{OwnerPubKey: pubkey}
+		if pubkey == workspace.OwnerPubKey {
+			result.Error = "Cannot delete workspace admin"
+		} else if oh.db.GetWorkspaceUser(pubkey, uuid).ID == 0 {
+			result.Error = "User does not exists in the workspace"
+		} else {
+			result.Removed = true
+			toRemove = append(toRemove, pubkey)
+		}
+		results = append(results, result)
+	}
+
+	if len(toRemove) > 0 {
+		if err := oh.db.RemoveWorkspaceUsers(uuid, toRemove); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(results)
+}
+
 func GetBountyRoles(w http.ResponseWriter, r *http.Request) {
 	roles := db.DB.GetBountyRoles()
 
@@ -444,117 +681,59 @@ func AddUserRoles(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(insertRoles)
 }
 
-func GetUserRoles(w http.ResponseWriter, r *http.Request) {
+// GetEffectivePermissions resolves what a user can do in a workspace, so
+// clients don't have to reimplement the owner and role checks. Users can look
+// up themselves, anyone else needs to be a member or able to view reports.
+func (oh *workspaceHandler) GetEffectivePermissions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	uuid := chi.URLParam(r, "uuid")
 	user := chi.URLParam(r, "user")
 
-	userRoles := db.DB.GetUserRoles(uuid, user)
-
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(userRoles)
-}
-
-func GetUserWorkspaces(w http.ResponseWriter, r *http.Request) {
-	userIdParam := chi.URLParam(r, "userId")
-	userId, _ := utils.ConvertStringToUint(userIdParam)
-
-	if userId == 0 {
-		fmt.Println("[workspaces] provide user id")
-		w.WriteHeader(http.StatusNotAcceptable)
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
 
-	user := db.DB.GetPerson(userId)
-	// get the user workspaces
-	workspaces := GetAllUserWorkspaces(user.OwnerPubKey)
-
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(workspaces)
-}
-
-func (oh *workspaceHandler) GetUserDropdownWorkspaces(w http.ResponseWriter, r *http.Request) {
-	userIdParam := chi.URLParam(r, "userId")
-	userId, _ := utils.ConvertStringToUint(userIdParam)
-
-	if userId == 0 {
-		fmt.Println("[workspaces] provide user id")
-		w.WriteHeader(http.StatusNotAcceptable)
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+	if workspace.Uuid != uuid {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Workspace does not exists")
 		return
 	}
 
-	user := db.DB.GetPerson(userId)
-
-	// get the workspaces created by the user, then get all the workspaces
-	// the user has been added to, loop through to get the workspace
-	workspaces := GetCreatedWorkspaces(user.OwnerPubKey)
-	assignedWorkspaces := db.DB.GetUserAssignedWorkspaces(user.OwnerPubKey)
-	for _, value := range assignedWorkspaces {
-		uuid := value.WorkspaceUuid
-		workspace := db.DB.GetWorkspaceByUuid(uuid)
-		bountyCount := db.DB.GetWorkspaceBountyCount(uuid)
-		hasRole := db.UserHasAccess(user.OwnerPubKey, uuid, db.ViewReport)
-		hasBountyRoles := oh.userHasManageBountyRoles(user.OwnerPubKey, uuid)
-
-		// don't add deleted workspaces to the list
-		if !workspace.Deleted && hasBountyRoles {
-			if hasRole {
-				budget := db.DB.GetWorkspaceBudget(uuid)
-				workspace.Budget = budget.TotalBudget
-			} else {
-				workspace.Budget = 0
-			}
-			workspace.BountyCount = bountyCount
-			workspaces = append(workspaces, workspace)
-		}
+	if pubKeyFromAuth != user && !isWorkspaceMember(oh.db, pubKeyFromAuth, uuid) && !oh.userHasAccess(pubKeyFromAuth, uuid, db.ViewReport) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to this workspace")
+		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(workspaces)
-}
-
-func GetCreatedWorkspaces(pubkey string) []db.Workspace {
-	workspaces := db.DB.GetUserCreatedWorkspaces(pubkey)
-	// add bounty count to the workspace
-	for index, value := range workspaces {
-		uuid := value.Uuid
-		bountyCount := db.DB.GetWorkspaceBountyCount(uuid)
-		hasRole := db.UserHasAccess(pubkey, uuid, db.ViewReport)
+	permissions := db.EffectivePermissions{
+		IsOwner:     workspace.OwnerPubKey == user,
+		Permissions: []string{},
+	}
 
-		if hasRole {
-			budget := db.DB.GetWorkspaceBudget(uuid)
-			workspaces[index].Budget = budget.TotalBudget
-		} else {
-			workspaces[index].Budget = 0
+	userRoles := db.GetUserRolesMap(oh.db.GetUserRoles(uuid, user))
+	for _, role := range db.ConfigBountyRoles {
+		if _, ok := userRoles[role.Name]; permissions.IsOwner || ok {
+			permissions.Permissions = append(permissions.Permissions, role.Name)
 		}
-		workspaces[index].BountyCount = bountyCount
 	}
-	return workspaces
-}
-
-func (oh *workspaceHandler) GetWorkspaceBounties(w http.ResponseWriter, r *http.Request) {
-	uuid := chi.URLParam(r, "uuid")
-
-	// get the workspace bounties
-	workspaceBounties := oh.db.GetWorkspaceBounties(r, uuid)
 
-	var bountyResponse []db.BountyResponse = oh.generateBountyHandler(workspaceBounties)
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(bountyResponse)
+	json.NewEncoder(w).Encode(permissions)
 }
 
-func (oh *workspaceHandler) GetWorkspaceBountiesCount(w http.ResponseWriter, r *http.Request) {
-	uuid := chi.URLParam(r, "uuid")
-
-	workspaceBountiesCount := oh.db.GetWorkspaceBountiesCount(r, uuid)
-
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(workspaceBountiesCount)
-}
+const defaultInviteExpiry = 7 * 24 * time.Hour
 
-func (oh *workspaceHandler) GetWorkspaceBudget(w http.ResponseWriter, r *http.Request) {
+// CreateWorkspaceInvite creates an invite link token that adds whoever
+// redeems it to the workspace with the preset roles
+func (oh *workspaceHandler) CreateWorkspaceInvite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	uuid := chi.URLParam(r, "uuid")
+	now := time.Now()
 
 	if pubKeyFromAuth == "" {
 		fmt.Println("[workspaces] no pubkey from auth")
@@ -562,23 +741,707 @@ func (oh *workspaceHandler) GetWorkspaceBudget(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// if not the workspace admin
-	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.ViewReport)
-	if !hasRole {
-		w.WriteHeader(http.StatusUnauthorized)
-		json.NewEncoder(w).Encode("Don't have access to view budget")
+	invite := db.WorkspaceInvite{}
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		fmt.Println("[body] ", err)
+		w.WriteHeader(http.StatusNotAcceptable)
 		return
 	}
 
-	// get the workspace budget
-	workspaceBudget := oh.db.GetWorkspaceStatusBudget(uuid)
+	err = json.Unmarshal(body, &invite)
+	if err != nil {
+		fmt.Println("[workspaces]:", err)
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(workspaceBudget)
-}
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+	if workspace.Uuid != uuid {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Workspace does not exists")
+		return
+	}
 
-func (oh *workspaceHandler) GetWorkspaceBudgetHistory(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.AddUser)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to add user")
+		return
+	}
+
+	rolesMap := db.GetRolesMap()
+	for _, role := range invite.Roles {
+		if _, ok := rolesMap[role]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode("not a valid user role")
+			return
+		}
+
+		// same rule as AddUserRoles, you can only hand out roles you have
+		if !oh.userHasAccess(pubKeyFromAuth, uuid, role) {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode("cannot add a role you don't have")
+			return
+		}
+	}
+
+	if invite.MaxUses == 0 {
+		invite.MaxUses = 1
+	}
+	if invite.ExpiresAt == nil {
+		expiresAt := now.Add(defaultInviteExpiry)
+		invite.ExpiresAt = &expiresAt
+	}
+
+	// Validate struct data
+	err = db.Validate.Struct(invite)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("Error: did not pass validation test : %s", err)
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	if !invite.ExpiresAt.After(now) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: invite must expire in the future")
+		return
+	}
+
+	invite.Token = utils.GetRandomToken(32)
+	invite.WorkspaceUuid = uuid
+	invite.Uses = 0
+	invite.CreatedBy = pubKeyFromAuth
+
+	p, err := oh.db.CreateWorkspaceInvite(invite)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(p)
+}
+
+func (oh *workspaceHandler) RedeemWorkspaceInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	token := chi.URLParam(r, "token")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	invite := oh.db.GetWorkspaceInviteByToken(token)
+	if invite.ID == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Invite does not exists")
+		return
+	}
+
+	if invite.ExpiresAt != nil && invite.ExpiresAt.Before(time.Now()) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Invite has expired")
+		return
+	}
+
+	if invite.Uses >= invite.MaxUses {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Invite has already been used")
+		return
+	}
+
+	if isWorkspaceMember(oh.db, pubKeyFromAuth, invite.WorkspaceUuid) {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode("User already exists")
+		return
+	}
+
+	err := oh.db.RedeemWorkspaceInvite(invite, pubKeyFromAuth)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(oh.db.GetWorkspaceByUuid(invite.WorkspaceUuid))
+}
+
+func (oh *workspaceHandler) CreateWorkspaceTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	tag := db.WorkspaceTag{}
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		fmt.Println("[body] ", err)
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	err = json.Unmarshal(body, &tag)
+	if err != nil {
+		fmt.Println("[workspaces]:", err)
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+	if workspace.Uuid != uuid {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Workspace does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, uuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	tag.Name = strings.ToLower(strings.TrimSpace(tag.Name))
+
+	// Validate struct data
+	err = db.Validate.Struct(tag)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("Error: did not pass validation test : %s", err)
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	for _, existing := range oh.db.GetWorkspaceTags(uuid) {
+		if existing.Name == tag.Name {
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode("Tag already exists")
+			return
+		}
+	}
+
+	tag.ID = 0
+	tag.WorkspaceUuid = uuid
+	tag.CreatedBy = pubKeyFromAuth
+
+	p, err := oh.db.CreateWorkspaceTag(tag)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(p)
+}
+
+func (oh *workspaceHandler) ListWorkspaceTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, uuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Not a member of this workspace")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(oh.db.GetWorkspaceTags(uuid))
+}
+
+func (oh *workspaceHandler) DeleteWorkspaceTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+	name := strings.ToLower(strings.TrimSpace(chi.URLParam(r, "name")))
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, uuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	err := oh.db.DeleteWorkspaceTag(uuid, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Tag does not exists")
+		return
+	} else if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"message": "Tag deleted successfully"})
+}
+
+// CreateWorkspaceFeatureStatus adds a custom feature status, like
+// "in review", that the workspace's features can be moved to
+func (oh *workspaceHandler) CreateWorkspaceFeatureStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	status := db.WorkspaceFeatureStatus{}
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		fmt.Println("[body] ", err)
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	err = json.Unmarshal(body, &status)
+	if err != nil {
+		fmt.Println("[workspaces]:", err)
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+	if workspace.Uuid != uuid {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Workspace does not exists")
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, uuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	status.Name = strings.ToLower(strings.TrimSpace(status.Name))
+
+	// Validate struct data
+	err = db.Validate.Struct(status)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("Error: did not pass validation test : %s", err)
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	if db.FeatureStatus(status.Name).IsBuiltIn() {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode("Status already exists")
+		return
+	}
+	for _, existing := range oh.db.GetWorkspaceFeatureStatuses(uuid) {
+		if existing.Name == status.Name {
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode("Status already exists")
+			return
+		}
+	}
+
+	status.ID = 0
+	status.WorkspaceUuid = uuid
+	status.CreatedBy = pubKeyFromAuth
+
+	p, err := oh.db.CreateWorkspaceFeatureStatus(status)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(p)
+}
+
+func (oh *workspaceHandler) ListWorkspaceFeatureStatuses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !isWorkspaceMember(oh.db, pubKeyFromAuth, uuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Not a member of this workspace")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(oh.db.GetWorkspaceFeatureStatuses(uuid))
+}
+
+// DeleteWorkspaceFeatureStatus removes a custom status. Features already in
+// it keep it until they are moved to another status.
+func (oh *workspaceHandler) DeleteWorkspaceFeatureStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+	name := strings.ToLower(strings.TrimSpace(chi.URLParam(r, "name")))
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !oh.userHasAccess(pubKeyFromAuth, uuid, db.EditOrg) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	err := oh.db.DeleteWorkspaceFeatureStatus(uuid, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Status does not exists")
+		return
+	} else if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"message": "Status deleted successfully"})
+}
+
+// isAllowedFeatureStatus reports whether status is built in or one of the
+// workspace's custom feature statuses
+func isAllowedFeatureStatus(database db.Database, workspaceUuid string, status db.FeatureStatus) bool {
+	if status.IsBuiltIn() {
+		return true
+	}
+	for _, custom := range database.GetWorkspaceFeatureStatuses(workspaceUuid) {
+		if custom.Name == string(status) {
+			return true
+		}
+	}
+	return false
+}
+
+// unknownWorkspaceTags returns the tags that are not part of the workspace's
+// curated vocabulary, matched case-insensitively
+func unknownWorkspaceTags(database db.Database, workspaceUuid string, tags []string) []string {
+	allowed := map[string]bool{}
+	for _, tag := range database.GetWorkspaceTags(workspaceUuid) {
+		allowed[tag.Name] = true
+	}
+
+	unknown := []string{}
+	for _, tag := range tags {
+		if !allowed[strings.ToLower(tag)] {
+			unknown = append(unknown, tag)
+		}
+	}
+	return unknown
+}
+
+func GetUserRoles(w http.ResponseWriter, r *http.Request) {
+	uuid := chi.URLParam(r, "uuid")
+	user := chi.URLParam(r, "user")
+
+	userRoles := db.DB.GetUserRoles(uuid, user)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(userRoles)
+}
+
+func GetUserWorkspaces(w http.ResponseWriter, r *http.Request) {
+	userIdParam := chi.URLParam(r, "userId")
+	userId, _ := utils.ConvertStringToUint(userIdParam)
+
+	if userId == 0 {
+		fmt.Println("[workspaces] provide user id")
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	user := db.DB.GetPerson(userId)
+	// get the user workspaces
+	workspaces := GetAllUserWorkspaces(user.OwnerPubKey)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(workspaces)
+}
+
+func (oh *workspaceHandler) GetUserDropdownWorkspaces(w http.ResponseWriter, r *http.Request) {
+	userIdParam := chi.URLParam(r, "userId")
+	userId, _ := utils.ConvertStringToUint(userIdParam)
+
+	if userId == 0 {
+		fmt.Println("[workspaces] provide user id")
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	user := db.DB.GetPerson(userId)
+
+	// get the workspaces created by the user, then get all the workspaces
+	// the user has been added to, loop through to get the workspace
+	workspaces := GetCreatedWorkspaces(user.OwnerPubKey)
+	assignedWorkspaces := db.DB.GetUserAssignedWorkspaces(user.OwnerPubKey)
+	for _, value := range assignedWorkspaces {
+		uuid := value.WorkspaceUuid
+		workspace := db.DB.GetWorkspaceByUuid(uuid)
+		bountyCount := db.DB.GetWorkspaceBountyCount(uuid)
+		hasRole := db.UserHasAccess(user.OwnerPubKey, uuid, db.ViewReport)
+		hasBountyRoles := oh.userHasManageBountyRoles(user.OwnerPubKey, uuid)
+
+		// don't add deleted workspaces to the list
+		if !workspace.Deleted && hasBountyRoles {
+			if hasRole {
+				budget := db.DB.GetWorkspaceBudget(uuid)
+				workspace.Budget = budget.TotalBudget
+			} else {
+				workspace.Budget = 0
+			}
+			workspace.BountyCount = bountyCount
+			workspaces = append(workspaces, workspace)
+		}
+	}
+
+	// add the feature counts with a single query for all the workspaces
+	uuids := make([]string, len(workspaces))
+	for i, workspace := range workspaces {
+		uuids[i] = workspace.Uuid
+	}
+	featureCounts := oh.db.GetWorkspacesFeaturesCount(uuids)
+	for i := range workspaces {
+		workspaces[i].FeatureCount = featureCounts[workspaces[i].Uuid]
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(workspaces)
+}
+
+func GetCreatedWorkspaces(pubkey string) []db.Workspace {
+	workspaces := db.DB.GetUserCreatedWorkspaces(pubkey)
+	// add bounty count to the workspace
+	for index, value := range workspaces {
+		uuid := value.Uuid
+		bountyCount := db.DB.GetWorkspaceBountyCount(uuid)
+		hasRole := db.UserHasAccess(pubkey, uuid, db.ViewReport)
+
+		if hasRole {
+			budget := db.DB.GetWorkspaceBudget(uuid)
+			workspaces[index].Budget = budget.TotalBudget
+		} else {
+			workspaces[index].Budget = 0
+		}
+		workspaces[index].BountyCount = bountyCount
+	}
+	return workspaces
+}
+
+// GetWorkspaceBounties lists a workspace's bounties. It accepts the same
+// Open/Assigned/Completed/Paid, languages, tags, search, sort and page params
+// as the feature phase bounty list, wrapped in a db.PaginatedResponse unless
+// legacy=true is set.
+func (oh *workspaceHandler) GetWorkspaceBounties(w http.ResponseWriter, r *http.Request) {
+	uuid := chi.URLParam(r, "uuid")
+
+	if !db.IsValidBountySort(r.URL.Query().Get("sortBy"), r.URL.Query().Get("direction")) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: sortBy must be one of created, updated, price, title and direction asc or desc")
+		return
+	}
+
+	// get the workspace bounties
+	workspaceBounties := oh.db.GetWorkspaceBounties(r, uuid)
+	total := oh.db.GetWorkspaceBountiesCount(r, uuid)
+
+	var bountyResponse []db.BountyResponse = oh.generateBountyHandler(workspaceBounties)
+	writePaginatedResponse(w, r, bountyResponse, total)
+}
+
+// GetWorkspaceBountiesByStatus lists a page of the workspace bounties in one
+// status, with the count and total sats of all bounties in that status
+func (oh *workspaceHandler) GetWorkspaceBountiesByStatus(w http.ResponseWriter, r *http.Request) {
+	uuid := chi.URLParam(r, "uuid")
+	status := strings.ToLower(chi.URLParam(r, "status"))
+
+	if !db.IsValidBountyStatus(status) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: status must be one of open, assigned, completed or paid")
+		return
+	}
+
+	workspaceBounties := oh.db.GetWorkspaceBountiesByStatus(r, uuid, status)
+	total, totalSats := oh.db.GetWorkspaceBountiesStatusTotals(uuid, status)
+
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(db.BountyStatusResponse{
+		PaginatedResponse: db.PaginatedResponse{
+			Items:  oh.generateBountyHandler(workspaceBounties),
+			Total:  total,
+			Offset: offset,
+			Limit:  limit,
+		},
+		Status:    status,
+		TotalSats: totalSats,
+	})
+}
+
+// GetWorkspacePendingBounties lists the bounties waiting for approval, for
+// the bounty managers of the workspace
+func (oh *workspaceHandler) GetWorkspacePendingBounties(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !oh.userHasManageBountyRoles(pubKeyFromAuth, uuid) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("You don't have the right permission to approve bounties")
+		return
+	}
+
+	pendingBounties := oh.db.GetWorkspacePendingBounties(r, uuid)
+	total := oh.db.GetWorkspacePendingBountiesCount(uuid)
+
+	writePaginatedResponse(w, r, oh.generateBountyHandler(pendingBounties), total)
+}
+
+func (oh *workspaceHandler) GetWorkspaceBountiesCount(w http.ResponseWriter, r *http.Request) {
+	uuid := chi.URLParam(r, "uuid")
+
+	workspaceBountiesCount := oh.db.GetWorkspaceBountiesCount(r, uuid)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(workspaceBountiesCount)
+}
+
+// GetWorkspaceBountiesCountByLanguage returns the number of open bounties in
+// the workspace for each coding language
+func (oh *workspaceHandler) GetWorkspaceBountiesCountByLanguage(w http.ResponseWriter, r *http.Request) {
+	uuid := chi.URLParam(r, "uuid")
+
+	counts := oh.db.GetWorkspaceBountiesCountByLanguage(uuid)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(counts)
+}
+
+func (oh *workspaceHandler) GetWorkspaceBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	// if not the workspace admin
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.ViewReport)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to view budget")
+		return
+	}
+
+	// get the workspace budget
+	workspaceBudget := oh.db.GetWorkspaceStatusBudget(uuid)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(workspaceBudget)
+}
+
+// GetWorkspaceBudgetByFeature breaks the workspace bounty budget down per
+// feature, with open, assigned and paid totals for each
+func (oh *workspaceHandler) GetWorkspaceBudgetByFeature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.ViewReport)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to view budget")
+		return
+	}
+
+	budgets := oh.db.GetWorkspaceBudgetByFeature(uuid)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(budgets)
+}
+
+// GetWorkspaceBountyAging counts the open bounties of the workspace by how
+// long they have been waiting, for triage
+func (oh *workspaceHandler) GetWorkspaceBountyAging(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.ViewReport)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to view report")
+		return
+	}
+
+	buckets := oh.db.GetWorkspaceBountyAging(uuid)
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(buckets)
+}
+
+func (oh *workspaceHandler) GetWorkspaceBudgetHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	uuid := chi.URLParam(r, "uuid")
 
@@ -597,7 +1460,63 @@ func (oh *workspaceHandler) GetWorkspaceBudgetHistory(w http.ResponseWriter, r *
 	json.NewEncoder(w).Encode(workspaceBudget)
 }
 
-func GetPaymentHistory(w http.ResponseWriter, r *http.Request) {
+func (oh *workspaceHandler) GetPaymentHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	paymentType := db.PaymentType(r.URL.Query().Get("payment_type"))
+	if paymentType != "" && paymentType != db.Deposit && paymentType != db.Withdraw && paymentType != db.Payment {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: payment_type must be one of deposit, withdraw or payment")
+		return
+	}
+
+	direction := r.URL.Query().Get("direction")
+	if direction != "" && direction != "sent" && direction != "received" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: direction must be sent or received")
+		return
+	}
+
+	// if not the workspace admin
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.ViewReport)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to view payments")
+		return
+	}
+
+	// get the workspace payment history
+	paymentHistory := oh.db.GetPaymentHistory(uuid, r)
+	paymentHistoryData := []db.PaymentHistoryData{}
+
+	for _, payment := range paymentHistory {
+		sender := oh.db.GetPersonByPubkey(payment.SenderPubKey)
+		receiver := oh.db.GetPersonByPubkey(payment.ReceiverPubKey)
+		paymentData := db.PaymentHistoryData{
+			NewPaymentHistory: payment,
+			SenderName:        sender.UniqueName,
+			SenderImg:         sender.Img,
+			ReceiverName:      receiver.UniqueName,
+			ReceiverImg:       receiver.Img,
+		}
+		paymentHistoryData = append(paymentHistoryData, paymentData)
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(paymentHistoryData)
+}
+
+// GetWorkspacePendingPaymentsSummary is a read-only view of the payments that
+// have not completed yet, so admins can see what is in flight before polling
+func (oh *workspaceHandler) GetWorkspacePendingPaymentsSummary(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
 	uuid := chi.URLParam(r, "uuid")
@@ -608,33 +1527,24 @@ func GetPaymentHistory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// if not the workspace admin
-	hasRole := db.UserHasAccess(pubKeyFromAuth, uuid, db.ViewReport)
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.ViewReport)
 	if !hasRole {
 		w.WriteHeader(http.StatusUnauthorized)
 		json.NewEncoder(w).Encode("Don't have access to view payments")
 		return
 	}
 
-	// get the workspace payment history
-	paymentHistory := db.DB.GetPaymentHistory(uuid, r)
-	paymentHistoryData := []db.PaymentHistoryData{}
-
-	for _, payment := range paymentHistory {
-		sender := db.DB.GetPersonByPubkey(payment.SenderPubKey)
-		receiver := db.DB.GetPersonByPubkey(payment.ReceiverPubKey)
-		paymentData := db.PaymentHistoryData{
-			NewPaymentHistory: payment,
-			SenderName:        sender.UniqueName,
-			SenderImg:         sender.Img,
-			ReceiverName:      receiver.UniqueName,
-			ReceiverImg:       receiver.Img,
-		}
-		paymentHistoryData = append(paymentHistoryData, paymentData)
+	payments := oh.db.GetWorkspacePendingPayments(uuid)
+	summary := db.PendingPaymentsSummary{
+		Count:    len(payments),
+		Payments: payments,
+	}
+	for _, payment := range payments {
+		summary.TotalAmount += payment.Amount
 	}
 
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(paymentHistoryData)
+	json.NewEncoder(w).Encode(summary)
 }
 
 func (oh *workspaceHandler) PollBudgetInvoices(w http.ResponseWriter, r *http.Request) {
@@ -676,6 +1586,49 @@ func (oh *workspaceHandler) PollBudgetInvoices(w http.ResponseWriter, r *http.Re
 	json.NewEncoder(w).Encode("Polled invoices")
 }
 
+// CleanupExpiredInvoices deletes the workspace's unpaid invoices that have
+// expired, skipping any the lightning node reports as settled
+func (oh *workspaceHandler) CleanupExpiredInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.AddBudget)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to clean up the workspace invoices")
+		return
+	}
+
+	removed := 0
+	for _, inv := range oh.db.GetWorkspaceInvoices(uuid) {
+		invoiceRes, invoiceErr := oh.getLightningInvoice(inv.PaymentRequest)
+		if invoiceErr.Error != "" {
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(invoiceErr)
+			return
+		}
+
+		if invoiceRes.Response.Settled {
+			continue
+		}
+
+		if utils.GetInvoiceExpired(inv.PaymentRequest) {
+			oh.db.DeleteInvoice(inv.PaymentRequest)
+			removed++
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]int{"removed": removed})
+}
+
 func (oh *workspaceHandler) PollUserWorkspacesBudget(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
@@ -737,6 +1690,77 @@ func GetInvoicesCount(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(invoiceCount)
 }
 
+// ListWorkspaceInvoices returns the workspace invoices with their state.
+// Unpaid invoices are checked against the lightning node, so the status
+// filter and pagination are applied after the lookup.
+func (oh *workspaceHandler) ListWorkspaceInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	status := db.InvoiceState(r.URL.Query().Get("status"))
+	if status != "" && status != db.InvoicePending && status != db.InvoiceSettled && status != db.InvoiceExpired {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Error: status must be one of pending, settled or expired")
+		return
+	}
+
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.ViewReport)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to view the workspace invoices")
+		return
+	}
+
+	invoices := []db.WorkspaceInvoice{}
+	for _, inv := range oh.db.GetAllWorkspaceInvoices(uuid) {
+		state := db.InvoiceSettled
+		if !inv.Status {
+			invoiceRes, invoiceErr := oh.getLightningInvoice(inv.PaymentRequest)
+			if invoiceErr.Error != "" {
+				w.WriteHeader(http.StatusForbidden)
+				json.NewEncoder(w).Encode(invoiceErr)
+				return
+			}
+
+			if !invoiceRes.Response.Settled {
+				state = db.InvoicePending
+				if utils.GetInvoiceExpired(inv.PaymentRequest) {
+					state = db.InvoiceExpired
+				}
+			}
+		}
+
+		if status != "" && state != status {
+			continue
+		}
+		invoices = append(invoices, db.WorkspaceInvoice{NewInvoiceList: inv, State: state})
+	}
+
+	total := len(invoices)
+	offset, limit, _, _, _ := utils.GetPaginationParams(r)
+	if limit > 1 {
+		if offset > total {
+			offset = total
+		}
+		end := offset + limit
+		if end > total {
+			end = total
+		}
+		invoices = invoices[offset:end]
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(invoices)
+}
+
 func GetAllUserInvoicesCount(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
@@ -757,6 +1781,8 @@ func GetAllUserInvoicesCount(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(allCount)
 }
 
+// DeleteWorkspace soft deletes a workspace owned by the caller. With
+// dry_run=true it returns the affected record counts and deletes nothing.
 func (oh *workspaceHandler) DeleteWorkspace(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
@@ -777,6 +1803,14 @@ func (oh *workspaceHandler) DeleteWorkspace(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// dry_run only reports what the delete would touch
+	if r.URL.Query().Get("dry_run") == "true" {
+		preview := oh.db.GetWorkspaceDeletionPreview(uuid)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(preview)
+		return
+	}
+
 	// Soft delete Workspace and delete user data
 	if err := oh.db.ProcessDeleteWorkspace(uuid); err != nil {
 		msg := "Error removing users from workspace"
@@ -790,6 +1824,49 @@ func (oh *workspaceHandler) DeleteWorkspace(w http.ResponseWriter, r *http.Reque
 	json.NewEncoder(w).Encode(workspace)
 }
 
+// RestoreWorkspace clears the deleted flag set by DeleteWorkspace, the
+// workspace keeps the show value it had before. The website, github,
+// description, users and roles wiped on delete are not restored and have to
+// be added back.
+func (oh *workspaceHandler) RestoreWorkspace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	uuid := chi.URLParam(r, "uuid")
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+	if workspace.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Workspace does not exists")
+		return
+	}
+
+	if pubKeyFromAuth != workspace.OwnerPubKey {
+		msg := "only workspace admin can restore an workspace"
+		fmt.Println("[workspaces]", msg)
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	if !workspace.Deleted {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Workspace is not deleted")
+		return
+	}
+
+	oh.db.ChangeWorkspaceDeleteStatus(uuid, false)
+	workspace.Deleted = false
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(workspace)
+}
+
 func (oh *workspaceHandler) UpdateWorkspace(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
@@ -831,6 +1908,8 @@ func (oh *workspaceHandler) UpdateWorkspace(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	keepUnsentWorkspaceFlags(body, &workspace, oh.db.GetWorkspaceByUuid(workspace.Uuid))
+
 	p, err := oh.db.CreateOrEditWorkspace(workspace)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -841,6 +1920,152 @@ func (oh *workspaceHandler) UpdateWorkspace(w http.ResponseWriter, r *http.Reque
 	json.NewEncoder(w).Encode(p)
 }
 
+func (oh *workspaceHandler) GetWorkspaceProductBrief(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	uuid := chi.URLParam(r, "uuid")
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+	if workspace.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Workspace not found")
+		return
+	}
+
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.EditOrg)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(db.WorkspaceProductBrief{
+		Mission: &workspace.Mission,
+		Tactics: &workspace.Tactics,
+	})
+}
+
+func (oh *workspaceHandler) UpdateWorkspaceProductBrief(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	uuid := chi.URLParam(r, "uuid")
+	workspace := oh.db.GetWorkspaceByUuid(uuid)
+	if workspace.Uuid == "" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode("Workspace not found")
+		return
+	}
+
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.EditOrg)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	brief := db.WorkspaceProductBrief{}
+	body, _ := io.ReadAll(r.Body)
+	r.Body.Close()
+	err := json.Unmarshal(body, &brief)
+	if err != nil {
+		fmt.Println("[workspaces]", err)
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	err = db.Validate.Struct(brief)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("Error: did not pass validation test : %s", err)
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	p, err := oh.db.UpdateWorkspaceProductBrief(uuid, brief)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(db.WorkspaceProductBrief{
+		Mission: &p.Mission,
+		Tactics: &p.Tactics,
+	})
+}
+
+// TestStakworkConnection makes an authenticated read-only request to Stakwork
+// with the configured key, so admins can check it before relying on it
+func (oh *workspaceHandler) TestStakworkConnection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	uuid := chi.URLParam(r, "uuid")
+	hasRole := oh.userHasAccess(pubKeyFromAuth, uuid, db.EditOrg)
+	if !hasRole {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode("Don't have access to Edit workspace")
+		return
+	}
+
+	stakworkKey := os.Getenv("STAKWORK_KEY")
+	if stakworkKey == "" {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]interface{}{"ok": false, "error": "Stakwork key not configured"})
+		return
+	}
+
+	request, err := http.NewRequest(http.MethodGet, stakworkProjectsUrl, nil)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]interface{}{"ok": false, "error": err.Error()})
+		return
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", fmt.Sprintf("Token token=%s", stakworkKey))
+
+	response, err := oh.httpClient.Do(request)
+	if err != nil {
+		fmt.Println("[workspaces] Stakwork connection error", err)
+		w.WriteHeader(http.StatusBadGateway)
+		json.NewEncoder(w).Encode(map[string]interface{}{"ok": false, "error": err.Error()})
+		return
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		res, _ := io.ReadAll(response.Body)
+		w.WriteHeader(http.StatusBadGateway)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"ok":     false,
+			"status": response.StatusCode,
+			"error":  string(res),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"ok": true})
+}
+
 func (oh *workspaceHandler) CreateOrEditWorkspaceRepository(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
@@ -995,6 +2220,65 @@ func (oh *workspaceHandler) GetFeaturesByWorkspaceUuid(w http.ResponseWriter, r
 	json.NewEncoder(w).Encode(workspaceFeatures)
 }
 
+const (
+	defaultRecentActivityLimit = 20
+	maxRecentActivityLimit     = 100
+)
+
+// GetUserRecentActivity is the personal home feed: the latest bounties and
+// features touched across every workspace the user owns or belongs to
+func (oh *workspaceHandler) GetUserRecentActivity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	limit := defaultRecentActivityLimit
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		parsed, err := strconv.Atoi(limitParam)
+		if err != nil || parsed < 1 || parsed > maxRecentActivityLimit {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(fmt.Sprintf("Error: limit must be between 1 and %d", maxRecentActivityLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	workspaceUuids := []string{}
+	for _, workspace := range oh.getAllUserWorkspaces(pubKeyFromAuth) {
+		workspaceUuids = append(workspaceUuids, workspace.Uuid)
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(oh.db.GetUserRecentActivity(workspaceUuids, limit))
+}
+
+// SearchUserBounties searches the bounties of every workspace the user
+// belongs to, so hunters can look for work without picking a workspace first
+func (oh *workspaceHandler) SearchUserBounties(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKeyFromAuth, _ := ctx.Value(auth.ContextKey).(string)
+
+	if pubKeyFromAuth == "" {
+		fmt.Println("[workspaces] no pubkey from auth")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	workspaceUuids := []string{}
+	for _, workspace := range oh.getAllUserWorkspaces(pubKeyFromAuth) {
+		workspaceUuids = append(workspaceUuids, workspace.Uuid)
+	}
+
+	bounties, total := oh.db.SearchWorkspacesBounties(r, workspaceUuids)
+
+	writePaginatedResponse(w, r, oh.generateBountyHandler(bounties), total)
+}
+
 func GetAllUserWorkspaces(pubkey string) []db.Workspace {
 	// get the workspaces created by the user, then get all the workspaces
 	// the user has been added to, loop through to get the workspace
@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -16,7 +18,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/stakwork/sphinx-tribes/auth"
 	"github.com/stakwork/sphinx-tribes/db"
+	"github.com/stakwork/sphinx-tribes/handlers/mocks"
+	dbMocks "github.com/stakwork/sphinx-tribes/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestUnitCreateOrEditWorkspace(t *testing.T) {
@@ -140,6 +145,65 @@ func TestUnitCreateOrEditWorkspace(t *testing.T) {
 		assert.Equal(t, name, responseOrg.Name)
 	})
 
+	t.Run("should store and return the workspace display currency", func(t *testing.T) {
+		workspace := db.Workspace{
+			Uuid:        uuid.New().String(),
+			Name:        fmt.Sprintf("currency-%d", rand.Intn(100000)),
+			OwnerPubKey: "test-key",
+			Description: "Workspace Currency Description",
+		}
+
+		postWorkspace := func(displayCurrency string) *httptest.ResponseRecorder {
+			workspace.DisplayCurrency = displayCurrency
+			requestBody, _ := json.Marshal(workspace)
+			ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(requestBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(oHandler.CreateOrEditWorkspace).ServeHTTP(rr, req)
+			return rr
+		}
+
+		assert.Equal(t, http.StatusBadRequest, postWorkspace("eur").Code)
+
+		assert.Equal(t, http.StatusOK, postWorkspace("").Code)
+		assert.Equal(t, db.DefaultDisplayCurrency, db.TestDB.GetWorkspaceByUuid(workspace.Uuid).DisplayCurrency)
+
+		workspace.ID = db.TestDB.GetWorkspaceByUuid(workspace.Uuid).ID
+		assert.Equal(t, http.StatusOK, postWorkspace(" BTC ").Code)
+		assert.Equal(t, "btc", db.TestDB.GetWorkspaceByUuid(workspace.Uuid).DisplayCurrency)
+		assert.Equal(t, "btc", db.TestDB.GetWorkspaceStatusBudget(workspace.Uuid).DisplayCurrency)
+	})
+
+	t.Run("should keep a workspace private when an edit does not send the flag", func(t *testing.T) {
+		workspace := db.Workspace{
+			Uuid:        uuid.New().String(),
+			Name:        fmt.Sprintf("private-%d", rand.Intn(100000)),
+			OwnerPubKey: "test-key",
+			Private:     true,
+		}
+		db.TestDB.CreateOrEditWorkspace(workspace)
+		workspace.ID = db.TestDB.GetWorkspaceByUuid(workspace.Uuid).ID
+
+		requestBody := []byte(fmt.Sprintf(`{"id": %d, "uuid": "%s", "name": "renamed-%d", "owner_pubkey": "%s"}`, workspace.ID, workspace.Uuid, rand.Intn(100000), workspace.OwnerPubKey))
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(requestBody))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.CreateOrEditWorkspace).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		updatedWorkspace := db.TestDB.GetWorkspaceByUuid(workspace.Uuid)
+		assert.Contains(t, updatedWorkspace.Name, "renamed-")
+		assert.True(t, updatedWorkspace.Private)
+	})
+
 	t.Run("should successfully add workspace if request is valid", func(t *testing.T) {
 		rr := httptest.NewRecorder()
 		handler := http.HandlerFunc(oHandler.CreateOrEditWorkspace)
@@ -204,6 +268,22 @@ func TestUnitCreateOrEditWorkspace(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("should return error if default bounty price is negative", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(oHandler.CreateOrEditWorkspace)
+
+		invalidJson := []byte(`{"name": "TestWorkspace", "owner_pubkey": "test-key", "default_bounty_price": -21}`)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(invalidJson))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
 }
 
 func TestDeleteWorkspace(t *testing.T) {
@@ -352,6 +432,196 @@ func TestDeleteWorkspace(t *testing.T) {
 	})
 }
 
+func TestDeleteWorkspaceDryRun(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	oHandler := NewWorkspaceHandler(mockDb)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        "Dry Run Workspace",
+		OwnerPubKey: "owner-key",
+	}
+	preview := db.WorkspaceDeletionPreview{
+		Users:     3,
+		UserRoles: 5,
+		Features:  2,
+		Bounties:  7,
+		Invoices:  1,
+	}
+
+	t.Run("should return counts without deleting the workspace", func(t *testing.T) {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceDeletionPreview", workspace.Uuid).Return(preview).Once()
+
+		rr := httptest.NewRecorder()
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodDelete, "/delete/"+workspace.Uuid+"?dry_run=true", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		http.HandlerFunc(oHandler.DeleteWorkspace).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var returned db.WorkspaceDeletionPreview
+		err = json.Unmarshal(rr.Body.Bytes(), &returned)
+		assert.NoError(t, err)
+		assert.Equal(t, preview, returned)
+		mockDb.AssertNotCalled(t, "ProcessDeleteWorkspace", workspace.Uuid)
+	})
+
+	t.Run("should not return counts to a non owner", func(t *testing.T) {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+
+		rr := httptest.NewRecorder()
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, "other-key")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodDelete, "/delete/"+workspace.Uuid+"?dry_run=true", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		http.HandlerFunc(oHandler.DeleteWorkspace).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		mockDb.AssertNotCalled(t, "ProcessDeleteWorkspace", workspace.Uuid)
+	})
+}
+
+func TestRestoreWorkspace(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        fmt.Sprintf("Workspace %s", uuid.New().String()),
+		OwnerPubKey: uuid.New().String(),
+		Github:      "https://github.com/test",
+		Website:     "https://www.testwebsite.com",
+		Description: "Workspace Description",
+		Show:        true,
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+	workspace = db.TestDB.GetWorkspaceByUuid(workspace.Uuid)
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	// GetAllUserWorkspaces reads through the global db
+	globalDB := db.DB
+	db.DB = db.TestDB
+	defer func() { db.DB = globalDB }()
+
+	findWorkspace := func(workspaces []db.Workspace, uuid string) (db.Workspace, bool) {
+		for _, w := range workspaces {
+			if w.Uuid == uuid {
+				return w, true
+			}
+		}
+		return db.Workspace{}, false
+	}
+
+	t.Run("should return error if not the workspace admin", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(oHandler.RestoreWorkspace)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		otherCtx := context.WithValue(context.Background(), auth.ContextKey, "other-pubkey")
+		req, err := http.NewRequestWithContext(context.WithValue(otherCtx, chi.RouteCtxKey, rctx), http.MethodPost, "/restore/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return bad request if the workspace is not deleted", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(oHandler.RestoreWorkspace)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/restore/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should list the workspace again after deleting and restoring it", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodDelete, "/delete/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		http.HandlerFunc(oHandler.DeleteWorkspace).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		_, found := findWorkspace(GetAllUserWorkspaces(workspace.OwnerPubKey), workspace.Uuid)
+		assert.False(t, found)
+
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/restore/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		http.HandlerFunc(oHandler.RestoreWorkspace).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		responseWorkspace := db.Workspace{}
+		err = json.Unmarshal(rr.Body.Bytes(), &responseWorkspace)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, responseWorkspace.Deleted)
+		assert.True(t, responseWorkspace.Show)
+
+		restoredWorkspace := db.TestDB.GetWorkspaceByUuid(workspace.Uuid)
+		assert.False(t, restoredWorkspace.Deleted)
+		assert.True(t, restoredWorkspace.Show)
+
+		listedWorkspace, found := findWorkspace(GetAllUserWorkspaces(workspace.OwnerPubKey), workspace.Uuid)
+		assert.True(t, found)
+		assert.True(t, listedWorkspace.Show)
+	})
+
+	t.Run("should keep a hidden workspace hidden after restoring it", func(t *testing.T) {
+		hiddenWorkspace := db.Workspace{
+			Uuid:        uuid.New().String(),
+			Name:        fmt.Sprintf("Workspace %s", uuid.New().String()),
+			OwnerPubKey: workspace.OwnerPubKey,
+			Show:        false,
+		}
+		db.TestDB.CreateOrEditWorkspace(hiddenWorkspace)
+		db.TestDB.ProcessDeleteWorkspace(hiddenWorkspace.Uuid)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", hiddenWorkspace.Uuid)
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/restore/"+hiddenWorkspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		http.HandlerFunc(oHandler.RestoreWorkspace).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		restoredWorkspace := db.TestDB.GetWorkspaceByUuid(hiddenWorkspace.Uuid)
+		assert.False(t, restoredWorkspace.Deleted)
+		assert.False(t, restoredWorkspace.Show)
+	})
+}
+
 func TestGetWorkspaceBounties(t *testing.T) {
 	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
 	teardownSuite := SetupSuite(t)
@@ -425,90 +695,1692 @@ func TestGetWorkspaceBounties(t *testing.T) {
 		// Assert that the response status code is as expected
 		assert.Equal(t, http.StatusOK, rr.Code)
 
-		// Assert that the response body is an empty array
-		assert.Equal(t, "[]\n", rr.Body.String())
+		// Assert that the response body is an empty page
+		assert.JSONEq(t, `{"items": [], "total": 0, "offset": 0, "limit": 10}`, rr.Body.String())
 		assert.NotEqual(t, workspace, fetchedWorkspaceWrong)
 	})
+
+	t.Run("should apply the status filters at the workspace level", func(t *testing.T) {
+		db.TestDB.CreateOrEditBounty(db.NewBounty{
+			Type:          "coding",
+			Title:         "paid bounty",
+			Description:   "paid bounty description",
+			WorkspaceUuid: workspace.Uuid,
+			OwnerID:       "workspace-user",
+			Assignee:      "assignee-user",
+			Paid:          true,
+			Price:         3000,
+			Created:       time.Now().Unix(),
+		})
+		db.TestDB.CreateOrEditBounty(db.NewBounty{
+			Type:          "coding",
+			Title:         "assigned bounty",
+			Description:   "assigned bounty description",
+			WorkspaceUuid: workspace.Uuid,
+			OwnerID:       "workspace-user",
+			Assignee:      "assignee-user",
+			Price:         4000,
+			Created:       time.Now().Unix() + 1,
+		})
+
+		var listedBounties []db.NewBounty
+		oHandler.generateBountyHandler = func(bounties []db.NewBounty) []db.BountyResponse {
+			listedBounties = bounties
+			return []db.BountyResponse{}
+		}
+
+		getBounties := func(query string) {
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("uuid", workspace.Uuid)
+			req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/bounties/"+workspace.Uuid+query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(oHandler.GetWorkspaceBounties).ServeHTTP(rr, req)
+			assert.Equal(t, http.StatusOK, rr.Code)
+		}
+
+		getBounties("?limit=10&Paid=true")
+		assert.Len(t, listedBounties, 1)
+		assert.Equal(t, "paid bounty", listedBounties[0].Title)
+
+		getBounties("?limit=10&Open=true&Assigned=true")
+		titles := []string{}
+		for _, bounty := range listedBounties {
+			titles = append(titles, bounty.Title)
+		}
+		assert.ElementsMatch(t, []string{"existing bounty", "assigned bounty"}, titles)
+
+		getBounties("?limit=10&search=" + url.QueryEscape("paid' OR '1'='1"))
+		assert.Empty(t, listedBounties)
+
+		getBounties("?limit=10&sortBy=price&direction=desc")
+		if assert.NotEmpty(t, listedBounties) {
+			assert.Equal(t, "assigned bounty", listedBounties[0].Title)
+		}
+	})
+
+	t.Run("should reject an unknown sort column", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		query := "?sortBy=" + url.QueryEscape("created; DROP TABLE bounty")
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/bounties/"+workspace.Uuid+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetWorkspaceBounties).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
 }
 
-func TestGetWorkspaceBudget(t *testing.T) {
+func TestGetWorkspaceBountiesByStatus(t *testing.T) {
 	teardownSuite := SetupSuite(t)
 	defer teardownSuite(t)
-	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
 	oHandler := NewWorkspaceHandler(db.TestDB)
-	handlerUserHasAccess := func(pubKeyFromAuth string, uuid string, role string) bool {
-		return true
+	oHandler.generateBountyHandler = func(bounties []db.NewBounty) []db.BountyResponse {
+		response := []db.BountyResponse{}
+		for _, b := range bounties {
+			response = append(response, db.BountyResponse{Bounty: b})
+		}
+		return response
 	}
+
 	workspace := db.Workspace{
 		Uuid:        uuid.New().String(),
-		Name:        "Workspace Budget Name " + uuid.New().String(),
-		OwnerPubKey: "workspace_owner_budget_pubkey",
-		Github:      "https://github.com/budget",
-		Website:     "https://www.budgetwebsite.com",
-		Description: "Workspace Budget Description",
+		Name:        uuid.New().String(),
+		OwnerPubKey: "workspace_status_bounties_pubkey",
 	}
 	db.TestDB.CreateOrEditWorkspace(workspace)
 
-	budgetAmount := uint(5000)
-	bounty := db.NewBountyBudget{
-		WorkspaceUuid: workspace.Uuid,
-		TotalBudget:   budgetAmount,
+	bounties := []db.NewBounty{
+		{Type: "coding", Title: "paid bounty one", WorkspaceUuid: workspace.Uuid, OwnerID: "workspace-user", Assignee: "assignee-user", Paid: true, Price: 1500},
+		{Type: "coding", Title: "paid bounty two", WorkspaceUuid: workspace.Uuid, OwnerID: "workspace-user", Assignee: "assignee-user", Paid: true, Price: 2500},
+		{Type: "coding", Title: "open bounty", WorkspaceUuid: workspace.Uuid, OwnerID: "workspace-user", Price: 4000},
+	}
+	for _, b := range bounties {
+		db.TestDB.CreateOrEditBounty(b)
 	}
-	db.TestDB.CreateWorkspaceBudget(bounty)
 
-	workspace = db.TestDB.GetWorkspaceByUuid(workspace.Uuid)
+	t.Run("should return paid bounties with their total sats", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		rctx.URLParams.Add("status", "paid")
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/bounties/"+workspace.Uuid+"/status/paid?limit=1", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	t.Run("Should test that a 401 is returned when trying to view an workspace's budget without a token", func(t *testing.T) {
-		workspaceUUID := workspace.Uuid
+		http.HandlerFunc(oHandler.GetWorkspaceBountiesByStatus).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
 
+		var returned []db.BountyResponse
+		response := db.BountyStatusResponse{PaginatedResponse: db.PaginatedResponse{Items: &returned}}
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "paid", response.Status)
+		assert.Equal(t, int64(2), response.Total)
+		assert.Equal(t, uint(4000), response.TotalSats)
+		assert.Len(t, returned, 1)
+		assert.True(t, returned[0].Bounty.Paid)
+	})
+
+	t.Run("should return 400 for an unknown status", func(t *testing.T) {
+		rr := httptest.NewRecorder()
 		rctx := chi.NewRouteContext()
-		rctx.URLParams.Add("uuid", workspaceUUID)
-		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/budget/"+workspaceUUID, nil)
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		rctx.URLParams.Add("status", "archived")
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/bounties/"+workspace.Uuid+"/status/archived", nil)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		rr := httptest.NewRecorder()
-		http.HandlerFunc(oHandler.GetWorkspaceBudget).ServeHTTP(rr, req)
+		http.HandlerFunc(oHandler.GetWorkspaceBountiesByStatus).ServeHTTP(rr, req)
 
-		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
 	})
+}
 
-	t.Run("Should test that the right workspace budget is returned, if the user is the workspace admin or has the ViewReport role", func(t *testing.T) {
-		workspaceUUID := workspace.Uuid
+func TestGetWorkspaceBountiesCountByLanguage(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
 
-		oHandler.userHasAccess = handlerUserHasAccess
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "workspace_language_bounties_pubkey",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	bounties := []db.NewBounty{
+		{Type: "coding", Title: "golang bounty", WorkspaceUuid: workspace.Uuid, OwnerID: "workspace-user", CodingLanguages: []string{"golang"}},
+		{Type: "coding", Title: "full stack bounty", WorkspaceUuid: workspace.Uuid, OwnerID: "workspace-user", CodingLanguages: []string{"golang", "javascript"}},
+		{Type: "coding", Title: "assigned javascript bounty", WorkspaceUuid: workspace.Uuid, OwnerID: "workspace-user", Assignee: "assignee-user", CodingLanguages: []string{"javascript"}},
+	}
+	for _, b := range bounties {
+		db.TestDB.CreateOrEditBounty(b)
+	}
 
+	t.Run("should count open bounties per language", func(t *testing.T) {
+		rr := httptest.NewRecorder()
 		rctx := chi.NewRouteContext()
-		rctx.URLParams.Add("uuid", workspaceUUID)
-		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/budget/"+workspaceUUID, nil)
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/bounties/"+workspace.Uuid+"/languages", nil)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		rr := httptest.NewRecorder()
-		http.HandlerFunc(oHandler.GetWorkspaceBudget).ServeHTTP(rr, req)
+		http.HandlerFunc(oHandler.GetWorkspaceBountiesCountByLanguage).ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusOK, rr.Code)
 
-		var responseBudget db.StatusBudget
-		err = json.Unmarshal(rr.Body.Bytes(), &responseBudget)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		assert.Equal(t, budgetAmount, responseBudget.CurrentBudget)
+		var counts map[string]int64
+		err = json.Unmarshal(rr.Body.Bytes(), &counts)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{"golang": 2, "javascript": 1}, counts)
 	})
 }
 
-func TestGetWorkspaceBudgetHistory(t *testing.T) {
+func TestGetWorkspaceTeam(t *testing.T) {
 	teardownSuite := SetupSuite(t)
 	defer teardownSuite(t)
 	oHandler := NewWorkspaceHandler(db.TestDB)
 
 	workspace := db.Workspace{
 		Uuid:        uuid.New().String(),
-		Name:        "Workspace History Name" + uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "team_owner_pubkey",
+		Description: "Workspace Team Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	activeMember := db.Person{
+		Uuid:        uuid.New().String(),
+		OwnerAlias:  "active-member",
+		UniqueName:  "active-member",
+		OwnerPubKey: uuid.New().String(),
+	}
+	inactiveMember := db.Person{
+		Uuid:        uuid.New().String(),
+		OwnerAlias:  "inactive-member",
+		UniqueName:  "inactive-member",
+		OwnerPubKey: uuid.New().String(),
+	}
+
+	now := time.Now()
+	for _, member := range []db.Person{activeMember, inactiveMember} {
+		db.TestDB.CreateOrEditPerson(member)
+		db.TestDB.CreateWorkspaceUser(db.WorkspaceUsers{
+			OwnerPubKey:   member.OwnerPubKey,
+			WorkspaceUuid: workspace.Uuid,
+			Created:       &now,
+		})
+	}
+	db.TestDB.CreateUserRoles([]db.WorkspaceUserRoles{
+		{Role: db.ViewReport, OwnerPubKey: activeMember.OwnerPubKey, WorkspaceUuid: workspace.Uuid, Created: &now},
+	}, workspace.Uuid, activeMember.OwnerPubKey)
+
+	db.TestDB.CreateOrEditBounty(db.NewBounty{
+		Type:          "coding",
+		Title:         "team bounty",
+		Description:   "team bounty description",
+		OwnerID:       activeMember.OwnerPubKey,
+		WorkspaceUuid: workspace.Uuid,
+		Created:       now.Unix(),
+	})
+
+	getTeam := func(ctx context.Context) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/team/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetWorkspaceTeam).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 without a token", func(t *testing.T) {
+		rr := getTeam(context.Background())
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return 401 if the user does not have the ViewReport role", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+
+		rr := getTeam(context.WithValue(context.Background(), auth.ContextKey, inactiveMember.OwnerPubKey))
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should return members with roles and last activity, most recently active first", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return true
+		}
+
+		rr := getTeam(context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "2", rr.Header().Get("X-Total-Count"))
+
+		team := []db.WorkspaceTeamMember{}
+		err := json.Unmarshal(rr.Body.Bytes(), &team)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Len(t, team, 2)
+
+		assert.Equal(t, activeMember.OwnerPubKey, team[0].OwnerPubKey)
+		assert.NotNil(t, team[0].LastActive)
+		assert.Len(t, team[0].Roles, 1)
+		assert.Equal(t, db.ViewReport, team[0].Roles[0].Role)
+
+		assert.Equal(t, inactiveMember.OwnerPubKey, team[1].OwnerPubKey)
+		assert.Nil(t, team[1].LastActive)
+		assert.Empty(t, team[1].Roles)
+	})
+}
+
+func TestGetWorkspaceByUuid(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "private_owner_pubkey",
+		Description: "Private Workspace Description",
+		Mission:     "Private mission",
+		Private:     true,
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	memberPubkey := uuid.New().String()
+	db.TestDB.CreateWorkspaceUser(db.WorkspaceUsers{
+		OwnerPubKey:   memberPubkey,
+		WorkspaceUuid: workspace.Uuid,
+	})
+
+	getWorkspace := func(pubkey string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.Background()
+		if pubkey != "" {
+			ctx = context.WithValue(ctx, auth.ContextKey, pubkey)
+		}
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetWorkspaceByUuid).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return the full private workspace to a member", func(t *testing.T) {
+		for _, pubkey := range []string{workspace.OwnerPubKey, memberPubkey} {
+			rr := getWorkspace(pubkey)
+			assert.Equal(t, http.StatusOK, rr.Code)
+
+			fetched := db.Workspace{}
+			err := json.Unmarshal(rr.Body.Bytes(), &fetched)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, workspace.Uuid, fetched.Uuid)
+			assert.Equal(t, workspace.OwnerPubKey, fetched.OwnerPubKey)
+			assert.Equal(t, workspace.Mission, fetched.Mission)
+		}
+	})
+
+	t.Run("should return only the short workspace to a non-member", func(t *testing.T) {
+		for _, pubkey := range []string{"", uuid.New().String()} {
+			rr := getWorkspace(pubkey)
+			assert.Equal(t, http.StatusOK, rr.Code)
+
+			fetched := map[string]interface{}{}
+			err := json.Unmarshal(rr.Body.Bytes(), &fetched)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, workspace.Uuid, fetched["uuid"])
+			assert.Equal(t, workspace.Name, fetched["name"])
+			assert.NotContains(t, fetched, "owner_pubkey")
+			assert.NotContains(t, fetched, "mission")
+		}
+	})
+
+	t.Run("should keep public workspaces fully readable", func(t *testing.T) {
+		workspace.Private = false
+		db.TestDB.CreateOrEditWorkspace(workspace)
+
+		rr := getWorkspace("")
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		fetched := db.Workspace{}
+		err := json.Unmarshal(rr.Body.Bytes(), &fetched)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.False(t, fetched.Private)
+		assert.Equal(t, workspace.OwnerPubKey, fetched.OwnerPubKey)
+	})
+}
+
+func TestWorkspaceProductBrief(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "brief_owner_pubkey",
+		Description: "Workspace Brief Description",
+		Mission:     "Initial mission",
+		Tactics:     "Initial tactics",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	updateBrief := func(body string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPatch, "/product_brief/"+workspace.Uuid, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.UpdateWorkspaceProductBrief).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 if the user cannot edit the workspace", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+
+		rr := updateBrief(`{"mission": "Not allowed"}`)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should reject a brief that is too long", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return true
+		}
+
+		rr := updateBrief(fmt.Sprintf(`{"tactics": "%s"}`, strings.Repeat("a", 10001)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should update only the provided fields and return them on get", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return true
+		}
+
+		rr := updateBrief(`{"mission": "Updated mission"}`)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/product_brief/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr = httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetWorkspaceProductBrief).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		brief := db.WorkspaceProductBrief{}
+		err = json.Unmarshal(rr.Body.Bytes(), &brief)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, "Updated mission", *brief.Mission)
+		assert.Equal(t, "Initial tactics", *brief.Tactics)
+	})
+}
+
+func TestGetWorkspaceProductBriefAccess(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	oHandler := NewWorkspaceHandler(mockDb)
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return false
+	}
+
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key", Mission: "Private mission"}
+	mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("uuid", workspace.Uuid)
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "outsider")
+	req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/product_brief/"+workspace.Uuid, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(oHandler.GetWorkspaceProductBrief).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.NotContains(t, rr.Body.String(), workspace.Mission)
+}
+
+func TestListWorkspaceInvoices(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	oHandler := NewWorkspaceHandler(mockDb)
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+
+	// an old mainnet invoice that has long expired
+	expiredInvoice := "lnbc15u1p3xnhl2pp5jptserfk3zk4qy42tlucycrfwxhydvlemu9pqr93tuzlv9cc7g3sdqsvfhkcap3xyhx7un8cqzpgxqzjcsp5f8c52y2stc300gl6s4xswtjpc37hrnnr3c9wvtgjfuvqmpm35evq9qyyssqy4lgd8tj637qcjp05rdpxxykjenthxftej7a2zzmwrmrl70fyj9hvj0rewhzj7jfyuwkwcg9g2jpwtk3wkjtwnkdks84hsnu8xps5vsq4gj5hs"
+	invoices := []db.NewInvoiceList{
+		{PaymentRequest: "lnbc1paidinvoice", Status: true, Type: db.Budget, WorkspaceUuid: "workspace-uuid"},
+		{PaymentRequest: "lnbc1settledinvoice", Status: false, Type: db.Budget, WorkspaceUuid: "workspace-uuid"},
+		{PaymentRequest: "lnbc1pendinginvoice", Status: false, Type: db.Budget, WorkspaceUuid: "workspace-uuid"},
+		{PaymentRequest: expiredInvoice, Status: false, Type: db.Budget, WorkspaceUuid: "workspace-uuid"},
+	}
+	mockDb.On("GetAllWorkspaceInvoices", "workspace-uuid").Return(invoices)
+
+	oHandler.getLightningInvoice = func(payment_request string) (db.InvoiceResult, db.InvoiceError) {
+		return db.InvoiceResult{Response: db.InvoiceCheckResponse{Settled: payment_request == "lnbc1settledinvoice"}}, db.InvoiceError{}
+	}
+
+	listInvoices := func(query string) ([]db.WorkspaceInvoice, *httptest.ResponseRecorder) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", "workspace-uuid")
+		ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/invoices/workspace-uuid"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.ListWorkspaceInvoices).ServeHTTP(rr, req)
+
+		result := []db.WorkspaceInvoice{}
+		if rr.Code == http.StatusOK {
+			err = json.Unmarshal(rr.Body.Bytes(), &result)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return result, rr
+	}
+
+	t.Run("should derive the state of every invoice", func(t *testing.T) {
+		result, rr := listInvoices("")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "4", rr.Header().Get("X-Total-Count"))
+
+		states := map[string]db.InvoiceState{}
+		for _, inv := range result {
+			states[inv.PaymentRequest] = inv.State
+		}
+		assert.Equal(t, map[string]db.InvoiceState{
+			"lnbc1paidinvoice":    db.InvoiceSettled,
+			"lnbc1settledinvoice": db.InvoiceSettled,
+			"lnbc1pendinginvoice": db.InvoicePending,
+			expiredInvoice:        db.InvoiceExpired,
+		}, states)
+	})
+
+	t.Run("should filter by status", func(t *testing.T) {
+		result, rr := listInvoices("?status=settled")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "2", rr.Header().Get("X-Total-Count"))
+		assert.Len(t, result, 2)
+
+		result, _ = listInvoices("?status=expired")
+		assert.Len(t, result, 1)
+		assert.Equal(t, expiredInvoice, result[0].PaymentRequest)
+	})
+
+	t.Run("should paginate the filtered invoices", func(t *testing.T) {
+		result, rr := listInvoices("?page=2&limit=3")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "4", rr.Header().Get("X-Total-Count"))
+		assert.Len(t, result, 1)
+		assert.Equal(t, expiredInvoice, result[0].PaymentRequest)
+	})
+
+	t.Run("should reject an unknown status", func(t *testing.T) {
+		_, rr := listInvoices("?status=unknown")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return 401 without the ViewReport role", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+
+		_, rr := listInvoices("")
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestCleanupExpiredInvoices(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	oHandler := NewWorkspaceHandler(mockDb)
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+	oHandler.getLightningInvoice = func(payment_request string) (db.InvoiceResult, db.InvoiceError) {
+		return db.InvoiceResult{Response: db.InvoiceCheckResponse{Settled: false}}, db.InvoiceError{}
+	}
+
+	// an old mainnet invoice that has long expired
+	expiredInvoice := "lnbc15u1p3xnhl2pp5jptserfk3zk4qy42tlucycrfwxhydvlemu9pqr93tuzlv9cc7g3sdqsvfhkcap3xyhx7un8cqzpgxqzjcsp5f8c52y2stc300gl6s4xswtjpc37hrnnr3c9wvtgjfuvqmpm35evq9qyyssqy4lgd8tj637qcjp05rdpxxykjenthxftej7a2zzmwrmrl70fyj9hvj0rewhzj7jfyuwkwcg9g2jpwtk3wkjtwnkdks84hsnu8xps5vsq4gj5hs"
+	mockDb.On("GetWorkspaceInvoices", "workspace-uuid").Return([]db.NewInvoiceList{
+		{PaymentRequest: expiredInvoice, Type: db.Budget, WorkspaceUuid: "workspace-uuid"},
+		{PaymentRequest: "lnbc1validinvoice", Type: db.Budget, WorkspaceUuid: "workspace-uuid"},
+	})
+
+	cleanup := func() *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", "workspace-uuid")
+		ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/invoices/cleanup/workspace-uuid", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.CleanupExpiredInvoices).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should only delete the expired invoice", func(t *testing.T) {
+		mockDb.On("DeleteInvoice", expiredInvoice).Return(db.NewInvoiceList{}).Once()
+
+		rr := cleanup()
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"removed": 1}`, rr.Body.String())
+		mockDb.AssertNotCalled(t, "DeleteInvoice", "lnbc1validinvoice")
+	})
+
+	t.Run("should return 401 without the AddBudget role", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+
+		rr := cleanup()
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestGetPaymentHistory(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "payments_owner_pubkey",
+		Description: "Payments Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	now := time.Now()
+	for _, paymentType := range []db.PaymentType{db.Deposit, db.Deposit, db.Withdraw, db.Payment} {
+		db.TestDB.AddPaymentHistory(db.NewPaymentHistory{
+			Amount:         1000,
+			PaymentType:    paymentType,
+			WorkspaceUuid:  workspace.Uuid,
+			SenderPubKey:   workspace.OwnerPubKey,
+			ReceiverPubKey: workspace.OwnerPubKey,
+			Created:        &now,
+			Updated:        &now,
+			Status:         true,
+		})
+	}
+
+	getPayments := func(query string) ([]db.PaymentHistoryData, *httptest.ResponseRecorder) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/payments/"+workspace.Uuid+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetPaymentHistory).ServeHTTP(rr, req)
+
+		payments := []db.PaymentHistoryData{}
+		if rr.Code == http.StatusOK {
+			err = json.Unmarshal(rr.Body.Bytes(), &payments)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return payments, rr
+	}
+
+	t.Run("should only return payments received by the workspace", func(t *testing.T) {
+		payments, rr := getPayments("?direction=received&limit=10")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Len(t, payments, 2)
+		for _, payment := range payments {
+			assert.Equal(t, db.Deposit, payment.PaymentType)
+		}
+	})
+
+	t.Run("should filter by payment type", func(t *testing.T) {
+		payments, rr := getPayments("?payment_type=withdraw&limit=10")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Len(t, payments, 1)
+		assert.Equal(t, db.Withdraw, payments[0].PaymentType)
+	})
+
+	t.Run("should reject an unknown direction", func(t *testing.T) {
+		_, rr := getPayments("?direction=sideways")
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetWorkspacePendingPaymentsSummary(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "pending_owner_pubkey",
+		Description: "Pending Payments Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	bounty, _ := db.TestDB.CreateOrEditBounty(db.NewBounty{
+		Type:          "coding",
+		Title:         "Pending bounty",
+		Description:   "Pending bounty description",
+		OwnerID:       workspace.OwnerPubKey,
+		WorkspaceUuid: workspace.Uuid,
+		Price:         2000,
+		Created:       time.Now().Unix(),
+	})
+
+	now := time.Now()
+	payments := []db.NewPaymentHistory{
+		{Amount: 1000, PaymentType: db.Deposit, Status: false},
+		{Amount: 2000, PaymentType: db.Payment, BountyId: bounty.ID, Status: false},
+		{Amount: 5000, PaymentType: db.Deposit, Status: true},
+	}
+	for _, payment := range payments {
+		payment.WorkspaceUuid = workspace.Uuid
+		payment.SenderPubKey = workspace.OwnerPubKey
+		payment.Created = &now
+		payment.Updated = &now
+		db.TestDB.AddPaymentHistory(payment)
+	}
+
+	t.Run("should summarize only the pending payments", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/payments/pending/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetWorkspacePendingPaymentsSummary).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		summary := db.PendingPaymentsSummary{}
+		err = json.Unmarshal(rr.Body.Bytes(), &summary)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, 2, summary.Count)
+		assert.Equal(t, uint(3000), summary.TotalAmount)
+
+		titles := []string{}
+		for _, payment := range summary.Payments {
+			titles = append(titles, payment.BountyTitle)
+		}
+		assert.ElementsMatch(t, []string{"", "Pending bounty"}, titles)
+	})
+}
+
+func TestWorkspaceInvites(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "invite_owner_pubkey",
+		Description: "Invite Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	redeemInvite := func(token string, pubkey string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("token", token)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/invite/redeem/"+token, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.RedeemWorkspaceInvite).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should redeem a valid invite with its preset roles", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		body := bytes.NewReader([]byte(`{"roles": ["ADD BOUNTY", "VIEW REPORT"], "max_uses": 1}`))
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/invite/"+workspace.Uuid, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.CreateWorkspaceInvite).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		invite := db.WorkspaceInvite{}
+		err = json.Unmarshal(rr.Body.Bytes(), &invite)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotEmpty(t, invite.Token)
+
+		redeemer := uuid.New().String()
+		rr = redeemInvite(invite.Token, redeemer)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		workspaceUser := db.TestDB.GetWorkspaceUser(redeemer, workspace.Uuid)
+		assert.Equal(t, redeemer, workspaceUser.OwnerPubKey)
+
+		roles := []string{}
+		for _, role := range db.TestDB.GetUserRoles(workspace.Uuid, redeemer) {
+			roles = append(roles, role.Role)
+		}
+		assert.ElementsMatch(t, []string{db.AddBounty, db.ViewReport}, roles)
+
+		// single use, so a second person can't join with the same link
+		rr = redeemInvite(invite.Token, uuid.New().String())
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should reject an expired invite", func(t *testing.T) {
+		expiredAt := time.Now().Add(-time.Hour)
+		invite, err := db.TestDB.CreateWorkspaceInvite(db.WorkspaceInvite{
+			Token:         uuid.New().String(),
+			WorkspaceUuid: workspace.Uuid,
+			MaxUses:       1,
+			ExpiresAt:     &expiredAt,
+			CreatedBy:     workspace.OwnerPubKey,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		redeemer := uuid.New().String()
+		rr := redeemInvite(invite.Token, redeemer)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, uint(0), db.TestDB.GetWorkspaceUser(redeemer, workspace.Uuid).ID)
+	})
+}
+
+func TestGetEffectivePermissions(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "permissions_owner_pubkey",
+		Description: "Permissions Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	now := time.Now()
+	member := uuid.New().String()
+	db.TestDB.CreateWorkspaceUser(db.WorkspaceUsers{
+		OwnerPubKey:   member,
+		WorkspaceUuid: workspace.Uuid,
+	})
+	db.TestDB.CreateUserRoles([]db.WorkspaceUserRoles{
+		{Role: db.ViewReport, OwnerPubKey: member, WorkspaceUuid: workspace.Uuid, Created: &now},
+		{Role: db.AddBounty, OwnerPubKey: member, WorkspaceUuid: workspace.Uuid, Created: &now},
+	}, workspace.Uuid, member)
+
+	getPermissions := func(user string) db.EffectivePermissions {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		rctx.URLParams.Add("user", user)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/users/permissions/"+workspace.Uuid+"/"+user, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetEffectivePermissions).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		permissions := db.EffectivePermissions{}
+		err = json.Unmarshal(rr.Body.Bytes(), &permissions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return permissions
+	}
+
+	t.Run("should give the owner every permission", func(t *testing.T) {
+		permissions := getPermissions(workspace.OwnerPubKey)
+
+		assert.True(t, permissions.IsOwner)
+		assert.Len(t, permissions.Permissions, len(db.ConfigBountyRoles))
+	})
+
+	t.Run("should only give a member their own roles", func(t *testing.T) {
+		permissions := getPermissions(member)
+
+		assert.False(t, permissions.IsOwner)
+		assert.ElementsMatch(t, []string{db.AddBounty, db.ViewReport}, permissions.Permissions)
+	})
+}
+
+func TestGetEffectivePermissionsAccess(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	oHandler := NewWorkspaceHandler(mockDb)
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return false
+	}
+
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key"}
+
+	getPermissions := func(pubkey string, user string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		rctx.URLParams.Add("user", user)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/users/permissions/"+workspace.Uuid+"/"+user, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetEffectivePermissions).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 when a non member looks up another user", func(t *testing.T) {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Twice()
+		mockDb.On("GetWorkspaceUser", "outsider", workspace.Uuid).Return(db.WorkspaceUsers{}).Once()
+
+		rr := getPermissions("outsider", workspace.OwnerPubKey)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		mockDb.AssertNotCalled(t, "GetUserRoles", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should let a user look up their own permissions", func(t *testing.T) {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetUserRoles", workspace.Uuid, "outsider").Return([]db.WorkspaceUserRoles{}).Once()
+
+		rr := getPermissions("outsider", "outsider")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestRemoveWorkspaceUsers(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "remove_owner_pubkey",
+		Description: "Remove Users Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	now := time.Now()
+	member := uuid.New().String()
+	db.TestDB.CreateWorkspaceUserWithRoles(db.WorkspaceUsers{
+		OwnerPubKey:   member,
+		WorkspaceUuid: workspace.Uuid,
+		Created:       &now,
+		Updated:       &now,
+		Roles:         []string{db.ViewReport},
+	})
+
+	t.Run("should remove members but never the admin", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		body := fmt.Sprintf(`{"pubkeys": ["%s", "%s"]}`, member, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/users/remove/"+workspace.Uuid, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.RemoveWorkspaceUsers).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		results := []db.WorkspaceUserRemoval{}
+		err = json.Unmarshal(rr.Body.Bytes(), &results)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, []db.WorkspaceUserRemoval{
+			{OwnerPubKey: member, Removed: true},
+			{OwnerPubKey: workspace.OwnerPubKey, Removed: false, Error: "Cannot delete workspace admin"},
+		}, results)
+		assert.Equal(t, uint(0), db.TestDB.GetWorkspaceUser(member, workspace.Uuid).ID)
+		assert.Empty(t, db.TestDB.GetUserRoles(workspace.Uuid, member))
+		assert.Equal(t, workspace.OwnerPubKey, db.TestDB.GetWorkspaceByUuid(workspace.Uuid).OwnerPubKey)
+	})
+}
+
+func TestWorkspaceTags(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "tags_owner_pubkey",
+		Description: "Tags Workspace Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+
+	createTag := func(name string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		body, _ := json.Marshal(db.WorkspaceTag{Name: name})
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/tags/"+workspace.Uuid, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.CreateWorkspaceTag).ServeHTTP(rr, req)
+		return rr
+	}
+
+	listTags := func(pubkey string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		listCtx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(context.WithValue(listCtx, chi.RouteCtxKey, rctx), http.MethodGet, "/tags/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.ListWorkspaceTags).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should create normalized tags and reject duplicates", func(t *testing.T) {
+		rr := createTag("  Backend ")
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		tag := db.WorkspaceTag{}
+		err := json.Unmarshal(rr.Body.Bytes(), &tag)
+		assert.NoError(t, err)
+		assert.Equal(t, "backend", tag.Name)
+		assert.Equal(t, workspace.Uuid, tag.WorkspaceUuid)
+
+		assert.Equal(t, http.StatusConflict, createTag("backend").Code)
+		assert.Equal(t, http.StatusBadRequest, createTag(" ").Code)
+	})
+
+	t.Run("should reject users without the EditOrg role", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+		defer func() { oHandler.userHasAccess = db.TestDB.UserHasAccess }()
+
+		assert.Equal(t, http.StatusUnauthorized, createTag("frontend").Code)
+	})
+
+	t.Run("should list tags to members only", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, listTags("outsider_pubkey").Code)
+
+		rr := listTags(workspace.OwnerPubKey)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		tags := []db.WorkspaceTag{}
+		err := json.Unmarshal(rr.Body.Bytes(), &tags)
+		assert.NoError(t, err)
+		assert.Len(t, tags, 1)
+		assert.Equal(t, "backend", tags[0].Name)
+	})
+
+	t.Run("should delete a tag", func(t *testing.T) {
+		deleteTag := func(name string) *httptest.ResponseRecorder {
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("uuid", workspace.Uuid)
+			rctx.URLParams.Add("name", name)
+			req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodDelete, "/tags/"+workspace.Uuid+"/"+name, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(oHandler.DeleteWorkspaceTag).ServeHTTP(rr, req)
+			return rr
+		}
+
+		assert.Equal(t, http.StatusOK, deleteTag("backend").Code)
+		assert.Equal(t, http.StatusNotFound, deleteTag("backend").Code)
+		assert.Empty(t, db.TestDB.GetWorkspaceTags(workspace.Uuid))
+	})
+}
+
+func TestGetUserRecentActivity(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+
+	pubkey := "activity_user_pubkey"
+	workspaces := []db.Workspace{}
+	for i := 0; i < 2; i++ {
+		workspace := db.Workspace{
+			Uuid:        uuid.New().String(),
+			Name:        uuid.New().String(),
+			OwnerPubKey: pubkey,
+			Description: "Activity Workspace Description",
+		}
+		db.TestDB.CreateOrEditWorkspace(workspace)
+		workspaces = append(workspaces, workspace)
+	}
+	oHandler.getAllUserWorkspaces = func(pubkey string) []db.Workspace {
+		return workspaces
+	}
+
+	db.TestDB.CreateOrEditBounty(db.NewBounty{
+		Type:          "coding",
+		Title:         "activity bounty",
+		Description:   "activity bounty description",
+		WorkspaceUuid: workspaces[0].Uuid,
+		OwnerID:       pubkey,
+		Created:       time.Now().Unix(),
+	})
+	db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+		Uuid:          uuid.New().String(),
+		WorkspaceUuid: workspaces[1].Uuid,
+		Name:          "activity feature",
+	})
+
+	getActivity := func(query string) *httptest.ResponseRecorder {
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/user/activity"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetUserRecentActivity).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should include items from every workspace of the user", func(t *testing.T) {
+		rr := getActivity("")
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		activity := []db.UserActivity{}
+		err := json.Unmarshal(rr.Body.Bytes(), &activity)
+		assert.NoError(t, err)
+
+		seen := map[string]string{}
+		for _, item := range activity {
+			seen[item.WorkspaceUuid] = item.Title
+		}
+		assert.Equal(t, "activity bounty", seen[workspaces[0].Uuid])
+		assert.Equal(t, "activity feature", seen[workspaces[1].Uuid])
+		for i := 1; i < len(activity); i++ {
+			assert.False(t, activity[i].Date.After(activity[i-1].Date))
+		}
+	})
+
+	t.Run("should respect the limit", func(t *testing.T) {
+		rr := getActivity("?limit=1")
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		activity := []db.UserActivity{}
+		err := json.Unmarshal(rr.Body.Bytes(), &activity)
+		assert.NoError(t, err)
+		assert.Len(t, activity, 1)
+	})
+
+	t.Run("should reject an invalid limit", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, getActivity("?limit=0").Code)
+	})
+}
+
+func TestSearchUserBounties(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+	oHandler.generateBountyHandler = func(bounties []db.NewBounty) []db.BountyResponse {
+		response := []db.BountyResponse{}
+		for _, b := range bounties {
+			response = append(response, db.BountyResponse{Bounty: b})
+		}
+		return response
+	}
+
+	pubkey := "search_user_pubkey"
+	workspaces := []db.Workspace{}
+	for i := 0; i < 3; i++ {
+		workspace := db.Workspace{
+			Uuid:        uuid.New().String(),
+			Name:        uuid.New().String(),
+			OwnerPubKey: pubkey,
+		}
+		db.TestDB.CreateOrEditWorkspace(workspace)
+		workspaces = append(workspaces, workspace)
+	}
+	// the third workspace holds a matching bounty but the user can't see it
+	oHandler.getAllUserWorkspaces = func(pubkey string) []db.Workspace {
+		return workspaces[:2]
+	}
+
+	db.TestDB.CreateOrEditBounty(db.NewBounty{Type: "coding", Title: "Fix Lightning payments", WorkspaceUuid: workspaces[0].Uuid, OwnerID: pubkey})
+	db.TestDB.CreateOrEditBounty(db.NewBounty{Type: "coding", Title: "Lightning invoice UI", WorkspaceUuid: workspaces[1].Uuid, OwnerID: pubkey})
+	db.TestDB.CreateOrEditBounty(db.NewBounty{Type: "coding", Title: "Update docs", Description: "covers lightning setup", WorkspaceUuid: workspaces[1].Uuid, OwnerID: pubkey, Assignee: "hunter", Paid: true})
+	db.TestDB.CreateOrEditBounty(db.NewBounty{Type: "coding", Title: "Lightning node", WorkspaceUuid: workspaces[2].Uuid, OwnerID: pubkey})
+	db.TestDB.CreateOrEditBounty(db.NewBounty{Type: "coding", Title: "Unrelated", WorkspaceUuid: workspaces[0].Uuid, OwnerID: pubkey})
+
+	search := func(query string) (int, db.PaginatedResponse, []db.BountyResponse) {
+		rr := httptest.NewRecorder()
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/user/bounties/search?"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		http.HandlerFunc(oHandler.SearchUserBounties).ServeHTTP(rr, req)
+
+		var bounties []db.BountyResponse
+		response := db.PaginatedResponse{Items: &bounties}
+		json.Unmarshal(rr.Body.Bytes(), &response)
+		return rr.Code, response, bounties
+	}
+
+	t.Run("should match a title substring across the user's workspaces", func(t *testing.T) {
+		code, response, bounties := search("search=lightning&Open=true")
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int64(2), response.Total)
+
+		titles := []string{}
+		for _, b := range bounties {
+			titles = append(titles, b.Bounty.Title)
+		}
+		assert.ElementsMatch(t, []string{"Fix Lightning payments", "Lightning invoice UI"}, titles)
+	})
+
+	t.Run("should match descriptions too", func(t *testing.T) {
+		_, response, _ := search("search=lightning")
+
+		assert.Equal(t, int64(3), response.Total)
+	})
+
+	t.Run("should return 401 without auth", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/user/bounties/search?search=lightning", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		http.HandlerFunc(oHandler.SearchUserBounties).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestStakworkConnection(t *testing.T) {
+	mockHttpClient := &mocks.HttpClient{}
+	oHandler := NewWorkspaceHandler(&dbMocks.Database{})
+	oHandler.httpClient = mockHttpClient
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+
+	t.Setenv("STAKWORK_KEY", "stakwork-test-key")
+
+	testConnection := func() *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", "workspace-uuid")
+		ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/stakwork/test/workspace-uuid", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.TestStakworkConnection).ServeHTTP(rr, req)
+		return rr
+	}
+
+	matchStakworkRequest := mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodGet &&
+			req.URL.String() == stakworkProjectsUrl &&
+			req.Header.Get("Authorization") == "Token token=stakwork-test-key"
+	})
+
+	t.Run("should return ok when Stakwork accepts the key", func(t *testing.T) {
+		mockHttpClient.ExpectedCalls = nil
+		mockHttpClient.On("Do", matchStakworkRequest).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"success": true}`)),
+		}, nil).Once()
+
+		rr := testConnection()
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"ok": true}`, rr.Body.String())
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("should return the upstream error when Stakwork rejects the key", func(t *testing.T) {
+		mockHttpClient.ExpectedCalls = nil
+		mockHttpClient.On("Do", matchStakworkRequest).Return(&http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Body:       io.NopCloser(strings.NewReader(`Unauthorized`)),
+		}, nil).Once()
+
+		rr := testConnection()
+
+		assert.Equal(t, http.StatusBadGateway, rr.Code)
+		assert.JSONEq(t, `{"ok": false, "status": 401, "error": "Unauthorized"}`, rr.Body.String())
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestGetWorkspaceBudget(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+	oHandler := NewWorkspaceHandler(db.TestDB)
+	handlerUserHasAccess := func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        "Workspace Budget Name " + uuid.New().String(),
+		OwnerPubKey: "workspace_owner_budget_pubkey",
+		Github:      "https://github.com/budget",
+		Website:     "https://www.budgetwebsite.com",
+		Description: "Workspace Budget Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	budgetAmount := uint(5000)
+	bounty := db.NewBountyBudget{
+		WorkspaceUuid: workspace.Uuid,
+		TotalBudget:   budgetAmount,
+	}
+	db.TestDB.CreateWorkspaceBudget(bounty)
+
+	workspace = db.TestDB.GetWorkspaceByUuid(workspace.Uuid)
+
+	t.Run("Should test that a 401 is returned when trying to view an workspace's budget without a token", func(t *testing.T) {
+		workspaceUUID := workspace.Uuid
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspaceUUID)
+		req, err := http.NewRequestWithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx), http.MethodGet, "/budget/"+workspaceUUID, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetWorkspaceBudget).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("Should test that the right workspace budget is returned, if the user is the workspace admin or has the ViewReport role", func(t *testing.T) {
+		workspaceUUID := workspace.Uuid
+
+		oHandler.userHasAccess = handlerUserHasAccess
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspaceUUID)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/budget/"+workspaceUUID, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetWorkspaceBudget).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var responseBudget db.StatusBudget
+		err = json.Unmarshal(rr.Body.Bytes(), &responseBudget)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, budgetAmount, responseBudget.CurrentBudget)
+	})
+}
+
+func TestGetWorkspaceBudgetByFeature(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+	oHandler := NewWorkspaceHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	features := []db.WorkspaceFeatures{}
+	for i, name := range []string{"Payments", "Onboarding"} {
+		feature, _ := db.TestDB.CreateOrEditFeature(db.WorkspaceFeatures{
+			Uuid:          uuid.New().String(),
+			WorkspaceUuid: workspace.Uuid,
+			Name:          name,
+			Priority:      i,
+		})
+		features = append(features, feature)
+	}
+
+	phases := []db.FeaturePhase{}
+	for _, feature := range features {
+		phase, _ := db.TestDB.CreateOrEditFeaturePhase(db.FeaturePhase{
+			Uuid:        uuid.New().String(),
+			FeatureUuid: feature.Uuid,
+			Name:        "Phase",
+		})
+		phases = append(phases, phase)
+	}
+
+	bounties := []db.NewBounty{
+		{Title: "open payments", Price: 1000, PhaseUuid: phases[0].Uuid},
+		{Title: "assigned payments", Price: 2000, Assignee: "hunter", PhaseUuid: phases[0].Uuid},
+		{Title: "paid payments", Price: 3000, Assignee: "hunter", Paid: true, PhaseUuid: phases[0].Uuid},
+		{Title: "open onboarding", Price: 500, PhaseUuid: phases[1].Uuid},
+		{Title: "second open onboarding", Price: 700, PhaseUuid: phases[1].Uuid},
+	}
+	for _, b := range bounties {
+		b.Type = "coding"
+		b.OwnerID = "test-key"
+		b.WorkspaceUuid = workspace.Uuid
+		db.TestDB.CreateOrEditBounty(b)
+	}
+
+	request := func() (*httptest.ResponseRecorder, *http.Request) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/budget/features/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return httptest.NewRecorder(), req
+	}
+
+	t.Run("should return 401 without ViewReport", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+		rr, req := request()
+		http.HandlerFunc(oHandler.GetWorkspaceBudgetByFeature).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should break the budget down per feature", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return true
+		}
+		rr, req := request()
+		http.HandlerFunc(oHandler.GetWorkspaceBudgetByFeature).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		budgets := []db.FeatureBudget{}
+		err := json.Unmarshal(rr.Body.Bytes(), &budgets)
+		assert.NoError(t, err)
+		assert.Equal(t, []db.FeatureBudget{
+			{
+				FeatureUuid:    features[0].Uuid,
+				FeatureName:    "Payments",
+				OpenBudget:     1000,
+				OpenCount:      1,
+				AssignedBudget: 2000,
+				AssignedCount:  1,
+				PaidBudget:     3000,
+				PaidCount:      1,
+			},
+			{
+				FeatureUuid: features[1].Uuid,
+				FeatureName: "Onboarding",
+				OpenBudget:  1200,
+				OpenCount:   2,
+			},
+		}, budgets)
+	})
+}
+
+func TestGetWorkspacePendingBounties(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+	oHandler := NewWorkspaceHandler(db.TestDB)
+	oHandler.generateBountyHandler = func(bounties []db.NewBounty) []db.BountyResponse {
+		responses := []db.BountyResponse{}
+		for _, bounty := range bounties {
+			responses = append(responses, db.BountyResponse{Bounty: bounty})
+		}
+		return responses
+	}
+
+	workspace := db.Workspace{
+		Uuid:                   uuid.New().String(),
+		Name:                   uuid.New().String(),
+		OwnerPubKey:            "test-key",
+		RequiresBountyApproval: true,
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	created := time.Now().Unix()
+	bounties := []db.NewBounty{
+		{Title: "first pending", PendingApproval: true},
+		{Title: "second pending", PendingApproval: true},
+		{Title: "approved", Show: true},
+	}
+	for i, b := range bounties {
+		b.Type = "coding"
+		b.OwnerID = "test-key"
+		b.WorkspaceUuid = workspace.Uuid
+		b.Created = created + int64(i)
+		db.TestDB.CreateOrEditBounty(b)
+	}
+
+	request := func() (*httptest.ResponseRecorder, *http.Request) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/bounties/pending/"+workspace.Uuid+"?page=1&limit=10", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return httptest.NewRecorder(), req
+	}
+
+	t.Run("should return 401 without the manage bounty roles", func(t *testing.T) {
+		oHandler.userHasManageBountyRoles = func(pubKeyFromAuth string, uuid string) bool {
+			return false
+		}
+		rr, req := request()
+		http.HandlerFunc(oHandler.GetWorkspacePendingBounties).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should only return the bounties pending approval", func(t *testing.T) {
+		oHandler.userHasManageBountyRoles = func(pubKeyFromAuth string, uuid string) bool {
+			return true
+		}
+		rr, req := request()
+		http.HandlerFunc(oHandler.GetWorkspacePendingBounties).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		page := struct {
+			Items []db.BountyResponse `json:"items"`
+			Total int64               `json:"total"`
+		}{}
+		err := json.Unmarshal(rr.Body.Bytes(), &page)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), page.Total)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, "first pending", page.Items[0].Bounty.Title)
+		assert.Equal(t, "second pending", page.Items[1].Bounty.Title)
+	})
+}
+
+func TestGetWorkspaceBountyAging(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	ctx := context.WithValue(context.Background(), auth.ContextKey, "test-key")
+	oHandler := NewWorkspaceHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "test-key",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	daysAgo := func(days int) int64 {
+		return time.Now().AddDate(0, 0, -days).Unix()
+	}
+
+	bounties := []db.NewBounty{
+		{Title: "new", Price: 100, Created: daysAgo(1)},
+		{Title: "week old", Price: 200, Created: daysAgo(7)},
+		{Title: "two weeks old", Price: 300, Created: daysAgo(14)},
+		{Title: "stale", Price: 400, Created: daysAgo(45)},
+		{Title: "stale assigned", Price: 500, Assignee: "hunter", Created: daysAgo(60)},
+	}
+	for _, b := range bounties {
+		b.Type = "coding"
+		b.OwnerID = "test-key"
+		b.WorkspaceUuid = workspace.Uuid
+		db.TestDB.CreateOrEditBounty(b)
+	}
+
+	request := func() (*httptest.ResponseRecorder, *http.Request) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/bounties/aging/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return httptest.NewRecorder(), req
+	}
+
+	t.Run("should return 401 without ViewReport", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return false
+		}
+		rr, req := request()
+		http.HandlerFunc(oHandler.GetWorkspaceBountyAging).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should bucket the open bounties by age", func(t *testing.T) {
+		oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+			return role == db.ViewReport
+		}
+		rr, req := request()
+		http.HandlerFunc(oHandler.GetWorkspaceBountyAging).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		buckets := []db.BountyAgeBucket{}
+		err := json.Unmarshal(rr.Body.Bytes(), &buckets)
+		assert.NoError(t, err)
+		assert.Equal(t, []db.BountyAgeBucket{
+			{Bucket: "0-7d", Count: 2, TotalSats: 300},
+			{Bucket: "8-30d", Count: 1, TotalSats: 300},
+			{Bucket: "31+d", Count: 1, TotalSats: 400},
+		}, buckets)
+	})
+}
+
+func TestGetWorkspaceBudgetHistory(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        "Workspace History Name" + uuid.New().String(),
 		OwnerPubKey: "test-key",
 		Github:      "https://github.com/history",
 		Website:     "https://www.historywebsite.com",
@@ -657,7 +2529,67 @@ func TestGetUserRoles(t *testing.T) {
 }
 
 func TestCreateWorkspaceUser(t *testing.T) {
+	teardownSuite := SetupSuite(t)
+	defer teardownSuite(t)
+	oHandler := NewWorkspaceHandler(db.TestDB)
+	oHandler.userHasAccess = func(pubKeyFromAuth string, uuid string, role string) bool {
+		return true
+	}
+
+	workspace := db.Workspace{
+		Uuid:        uuid.New().String(),
+		Name:        uuid.New().String(),
+		OwnerPubKey: "user_owner_pubkey",
+		Description: "Workspace Users Description",
+	}
+	db.TestDB.CreateOrEditWorkspace(workspace)
+
+	person := db.Person{
+		Uuid:        uuid.New().String(),
+		OwnerPubKey: uuid.New().String(),
+		OwnerAlias:  "new-member",
+		UniqueName:  "new-member",
+		Description: "new member",
+	}
+	db.TestDB.CreateOrEditPerson(person)
+
+	addUser := func(body string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, workspace.OwnerPubKey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodPost, "/users/"+workspace.Uuid, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.CreateWorkspaceUser).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should reject an unknown initial role", func(t *testing.T) {
+		rr := addUser(fmt.Sprintf(`{"owner_pubkey": "%s", "workspace_uuid": "%s", "roles": ["NOT A ROLE"]}`, person.OwnerPubKey, workspace.Uuid))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, uint(0), db.TestDB.GetWorkspaceUser(person.OwnerPubKey, workspace.Uuid).ID)
+	})
+
+	t.Run("should add the user with an initial VIEW REPORT role", func(t *testing.T) {
+		rr := addUser(fmt.Sprintf(`{"owner_pubkey": "%s", "workspace_uuid": "%s", "roles": ["%s"]}`, person.OwnerPubKey, workspace.Uuid, db.ViewReport))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		user := db.WorkspaceUsers{}
+		err := json.Unmarshal(rr.Body.Bytes(), &user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, person.OwnerPubKey, user.OwnerPubKey)
+		assert.Equal(t, []string{db.ViewReport}, user.Roles)
 
+		roles := db.TestDB.GetUserRoles(workspace.Uuid, person.OwnerPubKey)
+		assert.Len(t, roles, 1)
+		assert.Equal(t, db.ViewReport, roles[0].Role)
+	})
 }
 
 func TestGetWorkspaceUsers(t *testing.T) {
@@ -687,3 +2619,55 @@ func GetFeaturesByWorkspaceUuid(t *testing.T) {
 func TestDeleteWorkspaceRepository(t *testing.T) {
 
 }
+
+func TestGetAssignableMembers(t *testing.T) {
+	mockDb := dbMocks.NewDatabase(t)
+	oHandler := NewWorkspaceHandler(mockDb)
+
+	workspace := db.Workspace{Uuid: "workspace-uuid", OwnerPubKey: "owner-key"}
+
+	request := func(pubkey string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("uuid", workspace.Uuid)
+		ctx := context.WithValue(context.Background(), auth.ContextKey, pubkey)
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx), http.MethodGet, "/assignable/"+workspace.Uuid, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(oHandler.GetAssignableMembers).ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should return 401 for a non member", func(t *testing.T) {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Once()
+		mockDb.On("GetWorkspaceUser", "outsider", workspace.Uuid).Return(db.WorkspaceUsers{}).Once()
+
+		rr := request("outsider")
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should list the owner and members without deleted people", func(t *testing.T) {
+		mockDb.On("GetWorkspaceByUuid", workspace.Uuid).Return(workspace).Twice()
+		mockDb.On("GetWorkspaceUsers", workspace.Uuid).Return([]db.WorkspaceUsersData{
+			{WorkspaceUuid: workspace.Uuid, Person: db.Person{OwnerPubKey: "member-key", OwnerAlias: "member"}},
+			{WorkspaceUuid: workspace.Uuid, Person: db.Person{OwnerPubKey: "deleted-key", OwnerAlias: "gone", Deleted: true}},
+			{WorkspaceUuid: workspace.Uuid},
+		}, nil).Once()
+		mockDb.On("GetPersonByPubkey", workspace.OwnerPubKey).Return(db.Person{OwnerPubKey: "owner-key", OwnerAlias: "owner"}).Once()
+
+		rr := request(workspace.OwnerPubKey)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		members := []db.AssignableMember{}
+		err := json.Unmarshal(rr.Body.Bytes(), &members)
+		assert.NoError(t, err)
+		assert.Equal(t, []db.AssignableMember{
+			{OwnerPubKey: "owner-key", OwnerAlias: "owner", IsOwner: true},
+			{OwnerPubKey: "member-key", OwnerAlias: "member"},
+		}, members)
+	})
+}
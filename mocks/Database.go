@@ -173,6 +173,62 @@ func (_c *Database_AddBudgetHistory_Call) RunAndReturn(run func(db.BudgetHistory
 	return _c
 }
 
+// AddFeatureDependency provides a mock function with given fields: dependency
+func (_m *Database) AddFeatureDependency(dependency db.FeatureDependency) (db.FeatureDependency, error) {
+	ret := _m.Called(dependency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFeatureDependency")
+	}
+
+	var r0 db.FeatureDependency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.FeatureDependency) (db.FeatureDependency, error)); ok {
+		return rf(dependency)
+	}
+	if rf, ok := ret.Get(0).(func(db.FeatureDependency) db.FeatureDependency); ok {
+		r0 = rf(dependency)
+	} else {
+		r0 = ret.Get(0).(db.FeatureDependency)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.FeatureDependency) error); ok {
+		r1 = rf(dependency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_AddFeatureDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatureDependency'
+type Database_AddFeatureDependency_Call struct {
+	*mock.Call
+}
+
+// AddFeatureDependency is a helper method to define mock.On call
+//   - dependency db.FeatureDependency
+func (_e *Database_Expecter) AddFeatureDependency(dependency interface{}) *Database_AddFeatureDependency_Call {
+	return &Database_AddFeatureDependency_Call{Call: _e.mock.On("AddFeatureDependency", dependency)}
+}
+
+func (_c *Database_AddFeatureDependency_Call) Run(run func(dependency db.FeatureDependency)) *Database_AddFeatureDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.FeatureDependency))
+	})
+	return _c
+}
+
+func (_c *Database_AddFeatureDependency_Call) Return(_a0 db.FeatureDependency, _a1 error) *Database_AddFeatureDependency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_AddFeatureDependency_Call) RunAndReturn(run func(db.FeatureDependency) (db.FeatureDependency, error)) *Database_AddFeatureDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddInvoice provides a mock function with given fields: invoice
 func (_m *Database) AddInvoice(invoice db.NewInvoiceList) db.NewInvoiceList {
 	ret := _m.Called(invoice)
@@ -311,6 +367,235 @@ func (_c *Database_AddUserInvoiceData_Call) RunAndReturn(run func(db.UserInvoice
 	return _c
 }
 
+// ApproveBounty provides a mock function with given fields: id
+func (_m *Database) ApproveBounty(id uint) (db.NewBounty, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveBounty")
+	}
+
+	var r0 db.NewBounty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (db.NewBounty, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) db.NewBounty); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(db.NewBounty)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_ApproveBounty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveBounty'
+type Database_ApproveBounty_Call struct {
+	*mock.Call
+}
+
+// ApproveBounty is a helper method to define mock.On call
+//   - id uint
+func (_e *Database_Expecter) ApproveBounty(id interface{}) *Database_ApproveBounty_Call {
+	return &Database_ApproveBounty_Call{Call: _e.mock.On("ApproveBounty", id)}
+}
+
+func (_c *Database_ApproveBounty_Call) Run(run func(id uint)) *Database_ApproveBounty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *Database_ApproveBounty_Call) Return(_a0 db.NewBounty, _a1 error) *Database_ApproveBounty_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_ApproveBounty_Call) RunAndReturn(run func(uint) (db.NewBounty, error)) *Database_ApproveBounty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArchiveAllWorkspaceFeatures provides a mock function with given fields: workspaceUuid
+func (_m *Database) ArchiveAllWorkspaceFeatures(workspaceUuid string) (int64, error) {
+	ret := _m.Called(workspaceUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveAllWorkspaceFeatures")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(workspaceUuid)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(workspaceUuid)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workspaceUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_ArchiveAllWorkspaceFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveAllWorkspaceFeatures'
+type Database_ArchiveAllWorkspaceFeatures_Call struct {
+	*mock.Call
+}
+
+// ArchiveAllWorkspaceFeatures is a helper method to define mock.On call
+//   - workspaceUuid string
+func (_e *Database_Expecter) ArchiveAllWorkspaceFeatures(workspaceUuid interface{}) *Database_ArchiveAllWorkspaceFeatures_Call {
+	return &Database_ArchiveAllWorkspaceFeatures_Call{Call: _e.mock.On("ArchiveAllWorkspaceFeatures", workspaceUuid)}
+}
+
+func (_c *Database_ArchiveAllWorkspaceFeatures_Call) Run(run func(workspaceUuid string)) *Database_ArchiveAllWorkspaceFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_ArchiveAllWorkspaceFeatures_Call) Return(_a0 int64, _a1 error) *Database_ArchiveAllWorkspaceFeatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_ArchiveAllWorkspaceFeatures_Call) RunAndReturn(run func(string) (int64, error)) *Database_ArchiveAllWorkspaceFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArchiveStaleFeatures provides a mock function with given fields: workspaceUuid, cutoff
+func (_m *Database) ArchiveStaleFeatures(workspaceUuid string, cutoff time.Time) (int64, error) {
+	ret := _m.Called(workspaceUuid, cutoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveStaleFeatures")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (int64, error)); ok {
+		return rf(workspaceUuid, cutoff)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) int64); ok {
+		r0 = rf(workspaceUuid, cutoff)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(workspaceUuid, cutoff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_ArchiveStaleFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveStaleFeatures'
+type Database_ArchiveStaleFeatures_Call struct {
+	*mock.Call
+}
+
+// ArchiveStaleFeatures is a helper method to define mock.On call
+//   - workspaceUuid string
+//   - cutoff time.Time
+func (_e *Database_Expecter) ArchiveStaleFeatures(workspaceUuid interface{}, cutoff interface{}) *Database_ArchiveStaleFeatures_Call {
+	return &Database_ArchiveStaleFeatures_Call{Call: _e.mock.On("ArchiveStaleFeatures", workspaceUuid, cutoff)}
+}
+
+func (_c *Database_ArchiveStaleFeatures_Call) Run(run func(workspaceUuid string, cutoff time.Time)) *Database_ArchiveStaleFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Database_ArchiveStaleFeatures_Call) Return(_a0 int64, _a1 error) *Database_ArchiveStaleFeatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_ArchiveStaleFeatures_Call) RunAndReturn(run func(string, time.Time) (int64, error)) *Database_ArchiveStaleFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignPhaseBounties provides a mock function with given fields: phaseUuid, bountyIds, assignee
+func (_m *Database) AssignPhaseBounties(phaseUuid string, bountyIds []uint, assignee string) ([]db.PhaseBountyAssignment, error) {
+	ret := _m.Called(phaseUuid, bountyIds, assignee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignPhaseBounties")
+	}
+
+	var r0 []db.PhaseBountyAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []uint, string) ([]db.PhaseBountyAssignment, error)); ok {
+		return rf(phaseUuid, bountyIds, assignee)
+	}
+	if rf, ok := ret.Get(0).(func(string, []uint, string) []db.PhaseBountyAssignment); ok {
+		r0 = rf(phaseUuid, bountyIds, assignee)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.PhaseBountyAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []uint, string) error); ok {
+		r1 = rf(phaseUuid, bountyIds, assignee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_AssignPhaseBounties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignPhaseBounties'
+type Database_AssignPhaseBounties_Call struct {
+	*mock.Call
+}
+
+// AssignPhaseBounties is a helper method to define mock.On call
+//   - phaseUuid string
+//   - bountyIds []uint
+//   - assignee string
+func (_e *Database_Expecter) AssignPhaseBounties(phaseUuid interface{}, bountyIds interface{}, assignee interface{}) *Database_AssignPhaseBounties_Call {
+	return &Database_AssignPhaseBounties_Call{Call: _e.mock.On("AssignPhaseBounties", phaseUuid, bountyIds, assignee)}
+}
+
+func (_c *Database_AssignPhaseBounties_Call) Run(run func(phaseUuid string, bountyIds []uint, assignee string)) *Database_AssignPhaseBounties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Database_AssignPhaseBounties_Call) Return(_a0 []db.PhaseBountyAssignment, _a1 error) *Database_AssignPhaseBounties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_AssignPhaseBounties_Call) RunAndReturn(run func(string, []uint, string) ([]db.PhaseBountyAssignment, error)) *Database_AssignPhaseBounties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AverageCompletedTime provides a mock function with given fields: r, workspace
 func (_m *Database) AverageCompletedTime(r db.PaymentDateRange, workspace string) uint {
 	ret := _m.Called(r, workspace)
@@ -703,6 +988,62 @@ func (_c *Database_CreateConnectionCode_Call) RunAndReturn(run func([]db.Connect
 	return _c
 }
 
+// CreateFeatureSnapshot provides a mock function with given fields: snapshot
+func (_m *Database) CreateFeatureSnapshot(snapshot db.FeatureSnapshot) (db.FeatureSnapshot, error) {
+	ret := _m.Called(snapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeatureSnapshot")
+	}
+
+	var r0 db.FeatureSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.FeatureSnapshot) (db.FeatureSnapshot, error)); ok {
+		return rf(snapshot)
+	}
+	if rf, ok := ret.Get(0).(func(db.FeatureSnapshot) db.FeatureSnapshot); ok {
+		r0 = rf(snapshot)
+	} else {
+		r0 = ret.Get(0).(db.FeatureSnapshot)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.FeatureSnapshot) error); ok {
+		r1 = rf(snapshot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_CreateFeatureSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeatureSnapshot'
+type Database_CreateFeatureSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateFeatureSnapshot is a helper method to define mock.On call
+//   - snapshot db.FeatureSnapshot
+func (_e *Database_Expecter) CreateFeatureSnapshot(snapshot interface{}) *Database_CreateFeatureSnapshot_Call {
+	return &Database_CreateFeatureSnapshot_Call{Call: _e.mock.On("CreateFeatureSnapshot", snapshot)}
+}
+
+func (_c *Database_CreateFeatureSnapshot_Call) Run(run func(snapshot db.FeatureSnapshot)) *Database_CreateFeatureSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.FeatureSnapshot))
+	})
+	return _c
+}
+
+func (_c *Database_CreateFeatureSnapshot_Call) Return(_a0 db.FeatureSnapshot, _a1 error) *Database_CreateFeatureSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_CreateFeatureSnapshot_Call) RunAndReturn(run func(db.FeatureSnapshot) (db.FeatureSnapshot, error)) *Database_CreateFeatureSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateLeaderBoard provides a mock function with given fields: uuid, leaderboards
 func (_m *Database) CreateLeaderBoard(uuid string, leaderboards []db.LeaderBoard) ([]db.LeaderBoard, error) {
 	ret := _m.Called(uuid, leaderboards)
@@ -1418,55 +1759,279 @@ func (_c *Database_CreateWorkspaceBudget_Call) RunAndReturn(run func(db.NewBount
 	return _c
 }
 
-// CreateWorkspaceUser provides a mock function with given fields: orgUser
-func (_m *Database) CreateWorkspaceUser(orgUser db.WorkspaceUsers) db.WorkspaceUsers {
-	ret := _m.Called(orgUser)
+// CreateWorkspaceFeatureStatus provides a mock function with given fields: status
+func (_m *Database) CreateWorkspaceFeatureStatus(status db.WorkspaceFeatureStatus) (db.WorkspaceFeatureStatus, error) {
+	ret := _m.Called(status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateWorkspaceUser")
+		panic("no return value specified for CreateWorkspaceFeatureStatus")
 	}
 
-	var r0 db.WorkspaceUsers
-	if rf, ok := ret.Get(0).(func(db.WorkspaceUsers) db.WorkspaceUsers); ok {
-		r0 = rf(orgUser)
+	var r0 db.WorkspaceFeatureStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.WorkspaceFeatureStatus) (db.WorkspaceFeatureStatus, error)); ok {
+		return rf(status)
+	}
+	if rf, ok := ret.Get(0).(func(db.WorkspaceFeatureStatus) db.WorkspaceFeatureStatus); ok {
+		r0 = rf(status)
 	} else {
-		r0 = ret.Get(0).(db.WorkspaceUsers)
+		r0 = ret.Get(0).(db.WorkspaceFeatureStatus)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(db.WorkspaceFeatureStatus) error); ok {
+		r1 = rf(status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Database_CreateWorkspaceUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkspaceUser'
-type Database_CreateWorkspaceUser_Call struct {
+// Database_CreateWorkspaceFeatureStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkspaceFeatureStatus'
+type Database_CreateWorkspaceFeatureStatus_Call struct {
 	*mock.Call
 }
 
-// CreateWorkspaceUser is a helper method to define mock.On call
-//   - orgUser db.WorkspaceUsers
-func (_e *Database_Expecter) CreateWorkspaceUser(orgUser interface{}) *Database_CreateWorkspaceUser_Call {
-	return &Database_CreateWorkspaceUser_Call{Call: _e.mock.On("CreateWorkspaceUser", orgUser)}
+// CreateWorkspaceFeatureStatus is a helper method to define mock.On call
+//   - status db.WorkspaceFeatureStatus
+func (_e *Database_Expecter) CreateWorkspaceFeatureStatus(status interface{}) *Database_CreateWorkspaceFeatureStatus_Call {
+	return &Database_CreateWorkspaceFeatureStatus_Call{Call: _e.mock.On("CreateWorkspaceFeatureStatus", status)}
 }
 
-func (_c *Database_CreateWorkspaceUser_Call) Run(run func(orgUser db.WorkspaceUsers)) *Database_CreateWorkspaceUser_Call {
+func (_c *Database_CreateWorkspaceFeatureStatus_Call) Run(run func(status db.WorkspaceFeatureStatus)) *Database_CreateWorkspaceFeatureStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(db.WorkspaceUsers))
+		run(args[0].(db.WorkspaceFeatureStatus))
 	})
 	return _c
 }
 
-func (_c *Database_CreateWorkspaceUser_Call) Return(_a0 db.WorkspaceUsers) *Database_CreateWorkspaceUser_Call {
-	_c.Call.Return(_a0)
+func (_c *Database_CreateWorkspaceFeatureStatus_Call) Return(_a0 db.WorkspaceFeatureStatus, _a1 error) *Database_CreateWorkspaceFeatureStatus_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Database_CreateWorkspaceUser_Call) RunAndReturn(run func(db.WorkspaceUsers) db.WorkspaceUsers) *Database_CreateWorkspaceUser_Call {
+func (_c *Database_CreateWorkspaceFeatureStatus_Call) RunAndReturn(run func(db.WorkspaceFeatureStatus) (db.WorkspaceFeatureStatus, error)) *Database_CreateWorkspaceFeatureStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAllUsersFromWorkspace provides a mock function with given fields: uuid
-func (_m *Database) DeleteAllUsersFromWorkspace(uuid string) error {
-	ret := _m.Called(uuid)
+// CreateWorkspaceInvite provides a mock function with given fields: invite
+func (_m *Database) CreateWorkspaceInvite(invite db.WorkspaceInvite) (db.WorkspaceInvite, error) {
+	ret := _m.Called(invite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspaceInvite")
+	}
+
+	var r0 db.WorkspaceInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.WorkspaceInvite) (db.WorkspaceInvite, error)); ok {
+		return rf(invite)
+	}
+	if rf, ok := ret.Get(0).(func(db.WorkspaceInvite) db.WorkspaceInvite); ok {
+		r0 = rf(invite)
+	} else {
+		r0 = ret.Get(0).(db.WorkspaceInvite)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.WorkspaceInvite) error); ok {
+		r1 = rf(invite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_CreateWorkspaceInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkspaceInvite'
+type Database_CreateWorkspaceInvite_Call struct {
+	*mock.Call
+}
+
+// CreateWorkspaceInvite is a helper method to define mock.On call
+//   - invite db.WorkspaceInvite
+func (_e *Database_Expecter) CreateWorkspaceInvite(invite interface{}) *Database_CreateWorkspaceInvite_Call {
+	return &Database_CreateWorkspaceInvite_Call{Call: _e.mock.On("CreateWorkspaceInvite", invite)}
+}
+
+func (_c *Database_CreateWorkspaceInvite_Call) Run(run func(invite db.WorkspaceInvite)) *Database_CreateWorkspaceInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.WorkspaceInvite))
+	})
+	return _c
+}
+
+func (_c *Database_CreateWorkspaceInvite_Call) Return(_a0 db.WorkspaceInvite, _a1 error) *Database_CreateWorkspaceInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_CreateWorkspaceInvite_Call) RunAndReturn(run func(db.WorkspaceInvite) (db.WorkspaceInvite, error)) *Database_CreateWorkspaceInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWorkspaceTag provides a mock function with given fields: tag
+func (_m *Database) CreateWorkspaceTag(tag db.WorkspaceTag) (db.WorkspaceTag, error) {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspaceTag")
+	}
+
+	var r0 db.WorkspaceTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.WorkspaceTag) (db.WorkspaceTag, error)); ok {
+		return rf(tag)
+	}
+	if rf, ok := ret.Get(0).(func(db.WorkspaceTag) db.WorkspaceTag); ok {
+		r0 = rf(tag)
+	} else {
+		r0 = ret.Get(0).(db.WorkspaceTag)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.WorkspaceTag) error); ok {
+		r1 = rf(tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_CreateWorkspaceTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkspaceTag'
+type Database_CreateWorkspaceTag_Call struct {
+	*mock.Call
+}
+
+// CreateWorkspaceTag is a helper method to define mock.On call
+//   - tag db.WorkspaceTag
+func (_e *Database_Expecter) CreateWorkspaceTag(tag interface{}) *Database_CreateWorkspaceTag_Call {
+	return &Database_CreateWorkspaceTag_Call{Call: _e.mock.On("CreateWorkspaceTag", tag)}
+}
+
+func (_c *Database_CreateWorkspaceTag_Call) Run(run func(tag db.WorkspaceTag)) *Database_CreateWorkspaceTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.WorkspaceTag))
+	})
+	return _c
+}
+
+func (_c *Database_CreateWorkspaceTag_Call) Return(_a0 db.WorkspaceTag, _a1 error) *Database_CreateWorkspaceTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_CreateWorkspaceTag_Call) RunAndReturn(run func(db.WorkspaceTag) (db.WorkspaceTag, error)) *Database_CreateWorkspaceTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWorkspaceUser provides a mock function with given fields: orgUser
+func (_m *Database) CreateWorkspaceUser(orgUser db.WorkspaceUsers) db.WorkspaceUsers {
+	ret := _m.Called(orgUser)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspaceUser")
+	}
+
+	var r0 db.WorkspaceUsers
+	if rf, ok := ret.Get(0).(func(db.WorkspaceUsers) db.WorkspaceUsers); ok {
+		r0 = rf(orgUser)
+	} else {
+		r0 = ret.Get(0).(db.WorkspaceUsers)
+	}
+
+	return r0
+}
+
+// Database_CreateWorkspaceUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkspaceUser'
+type Database_CreateWorkspaceUser_Call struct {
+	*mock.Call
+}
+
+// CreateWorkspaceUser is a helper method to define mock.On call
+//   - orgUser db.WorkspaceUsers
+func (_e *Database_Expecter) CreateWorkspaceUser(orgUser interface{}) *Database_CreateWorkspaceUser_Call {
+	return &Database_CreateWorkspaceUser_Call{Call: _e.mock.On("CreateWorkspaceUser", orgUser)}
+}
+
+func (_c *Database_CreateWorkspaceUser_Call) Run(run func(orgUser db.WorkspaceUsers)) *Database_CreateWorkspaceUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.WorkspaceUsers))
+	})
+	return _c
+}
+
+func (_c *Database_CreateWorkspaceUser_Call) Return(_a0 db.WorkspaceUsers) *Database_CreateWorkspaceUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_CreateWorkspaceUser_Call) RunAndReturn(run func(db.WorkspaceUsers) db.WorkspaceUsers) *Database_CreateWorkspaceUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWorkspaceUserWithRoles provides a mock function with given fields: orgUser
+func (_m *Database) CreateWorkspaceUserWithRoles(orgUser db.WorkspaceUsers) (db.WorkspaceUsers, error) {
+	ret := _m.Called(orgUser)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspaceUserWithRoles")
+	}
+
+	var r0 db.WorkspaceUsers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.WorkspaceUsers) (db.WorkspaceUsers, error)); ok {
+		return rf(orgUser)
+	}
+	if rf, ok := ret.Get(0).(func(db.WorkspaceUsers) db.WorkspaceUsers); ok {
+		r0 = rf(orgUser)
+	} else {
+		r0 = ret.Get(0).(db.WorkspaceUsers)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.WorkspaceUsers) error); ok {
+		r1 = rf(orgUser)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_CreateWorkspaceUserWithRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkspaceUserWithRoles'
+type Database_CreateWorkspaceUserWithRoles_Call struct {
+	*mock.Call
+}
+
+// CreateWorkspaceUserWithRoles is a helper method to define mock.On call
+//   - orgUser db.WorkspaceUsers
+func (_e *Database_Expecter) CreateWorkspaceUserWithRoles(orgUser interface{}) *Database_CreateWorkspaceUserWithRoles_Call {
+	return &Database_CreateWorkspaceUserWithRoles_Call{Call: _e.mock.On("CreateWorkspaceUserWithRoles", orgUser)}
+}
+
+func (_c *Database_CreateWorkspaceUserWithRoles_Call) Run(run func(orgUser db.WorkspaceUsers)) *Database_CreateWorkspaceUserWithRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.WorkspaceUsers))
+	})
+	return _c
+}
+
+func (_c *Database_CreateWorkspaceUserWithRoles_Call) Return(_a0 db.WorkspaceUsers, _a1 error) *Database_CreateWorkspaceUserWithRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_CreateWorkspaceUserWithRoles_Call) RunAndReturn(run func(db.WorkspaceUsers) (db.WorkspaceUsers, error)) *Database_CreateWorkspaceUserWithRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAllUsersFromWorkspace provides a mock function with given fields: uuid
+func (_m *Database) DeleteAllUsersFromWorkspace(uuid string) error {
+	ret := _m.Called(uuid)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteAllUsersFromWorkspace")
@@ -1613,6 +2178,53 @@ func (_c *Database_DeleteFeatureByUuid_Call) RunAndReturn(run func(string) error
 	return _c
 }
 
+// DeleteFeatureDependency provides a mock function with given fields: featureUuid, dependsOnUuid
+func (_m *Database) DeleteFeatureDependency(featureUuid string, dependsOnUuid string) error {
+	ret := _m.Called(featureUuid, dependsOnUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeatureDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(featureUuid, dependsOnUuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Database_DeleteFeatureDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFeatureDependency'
+type Database_DeleteFeatureDependency_Call struct {
+	*mock.Call
+}
+
+// DeleteFeatureDependency is a helper method to define mock.On call
+//   - featureUuid string
+//   - dependsOnUuid string
+func (_e *Database_Expecter) DeleteFeatureDependency(featureUuid interface{}, dependsOnUuid interface{}) *Database_DeleteFeatureDependency_Call {
+	return &Database_DeleteFeatureDependency_Call{Call: _e.mock.On("DeleteFeatureDependency", featureUuid, dependsOnUuid)}
+}
+
+func (_c *Database_DeleteFeatureDependency_Call) Run(run func(featureUuid string, dependsOnUuid string)) *Database_DeleteFeatureDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_DeleteFeatureDependency_Call) Return(_a0 error) *Database_DeleteFeatureDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_DeleteFeatureDependency_Call) RunAndReturn(run func(string, string) error) *Database_DeleteFeatureDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteFeaturePhase provides a mock function with given fields: featureUuid, phaseUuid
 func (_m *Database) DeleteFeaturePhase(featureUuid string, phaseUuid string) error {
 	ret := _m.Called(featureUuid, phaseUuid)
@@ -1660,6 +2272,63 @@ func (_c *Database_DeleteFeaturePhase_Call) RunAndReturn(run func(string, string
 	return _c
 }
 
+// DeleteFeatureStories provides a mock function with given fields: featureUuid, storyUuids
+func (_m *Database) DeleteFeatureStories(featureUuid string, storyUuids []string) (db.FeatureStoriesDeletion, error) {
+	ret := _m.Called(featureUuid, storyUuids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeatureStories")
+	}
+
+	var r0 db.FeatureStoriesDeletion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string) (db.FeatureStoriesDeletion, error)); ok {
+		return rf(featureUuid, storyUuids)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string) db.FeatureStoriesDeletion); ok {
+		r0 = rf(featureUuid, storyUuids)
+	} else {
+		r0 = ret.Get(0).(db.FeatureStoriesDeletion)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(featureUuid, storyUuids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_DeleteFeatureStories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFeatureStories'
+type Database_DeleteFeatureStories_Call struct {
+	*mock.Call
+}
+
+// DeleteFeatureStories is a helper method to define mock.On call
+//   - featureUuid string
+//   - storyUuids []string
+func (_e *Database_Expecter) DeleteFeatureStories(featureUuid interface{}, storyUuids interface{}) *Database_DeleteFeatureStories_Call {
+	return &Database_DeleteFeatureStories_Call{Call: _e.mock.On("DeleteFeatureStories", featureUuid, storyUuids)}
+}
+
+func (_c *Database_DeleteFeatureStories_Call) Run(run func(featureUuid string, storyUuids []string)) *Database_DeleteFeatureStories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *Database_DeleteFeatureStories_Call) Return(_a0 db.FeatureStoriesDeletion, _a1 error) *Database_DeleteFeatureStories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_DeleteFeatureStories_Call) RunAndReturn(run func(string, []string) (db.FeatureStoriesDeletion, error)) *Database_DeleteFeatureStories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteFeatureStoryByUuid provides a mock function with given fields: featureUuid, storyUuid
 func (_m *Database) DeleteFeatureStoryByUuid(featureUuid string, storyUuid string) error {
 	ret := _m.Called(featureUuid, storyUuid)
@@ -1799,62 +2468,156 @@ func (_c *Database_DeleteUserInvoiceData_Call) RunAndReturn(run func(string) db.
 	return _c
 }
 
-// DeleteWorkspaceRepository provides a mock function with given fields: workspace_uuid, uuid
-func (_m *Database) DeleteWorkspaceRepository(workspace_uuid string, uuid string) bool {
-	ret := _m.Called(workspace_uuid, uuid)
+// DeleteWorkspaceFeatureStatus provides a mock function with given fields: workspace_uuid, name
+func (_m *Database) DeleteWorkspaceFeatureStatus(workspace_uuid string, name string) error {
+	ret := _m.Called(workspace_uuid, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteWorkspaceRepository")
+		panic("no return value specified for DeleteWorkspaceFeatureStatus")
 	}
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
-		r0 = rf(workspace_uuid, uuid)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(workspace_uuid, name)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// Database_DeleteWorkspaceRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspaceRepository'
-type Database_DeleteWorkspaceRepository_Call struct {
+// Database_DeleteWorkspaceFeatureStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspaceFeatureStatus'
+type Database_DeleteWorkspaceFeatureStatus_Call struct {
 	*mock.Call
 }
 
-// DeleteWorkspaceRepository is a helper method to define mock.On call
+// DeleteWorkspaceFeatureStatus is a helper method to define mock.On call
 //   - workspace_uuid string
-//   - uuid string
-func (_e *Database_Expecter) DeleteWorkspaceRepository(workspace_uuid interface{}, uuid interface{}) *Database_DeleteWorkspaceRepository_Call {
-	return &Database_DeleteWorkspaceRepository_Call{Call: _e.mock.On("DeleteWorkspaceRepository", workspace_uuid, uuid)}
+//   - name string
+func (_e *Database_Expecter) DeleteWorkspaceFeatureStatus(workspace_uuid interface{}, name interface{}) *Database_DeleteWorkspaceFeatureStatus_Call {
+	return &Database_DeleteWorkspaceFeatureStatus_Call{Call: _e.mock.On("DeleteWorkspaceFeatureStatus", workspace_uuid, name)}
 }
 
-func (_c *Database_DeleteWorkspaceRepository_Call) Run(run func(workspace_uuid string, uuid string)) *Database_DeleteWorkspaceRepository_Call {
+func (_c *Database_DeleteWorkspaceFeatureStatus_Call) Run(run func(workspace_uuid string, name string)) *Database_DeleteWorkspaceFeatureStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_DeleteWorkspaceRepository_Call) Return(_a0 bool) *Database_DeleteWorkspaceRepository_Call {
+func (_c *Database_DeleteWorkspaceFeatureStatus_Call) Return(_a0 error) *Database_DeleteWorkspaceFeatureStatus_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_DeleteWorkspaceRepository_Call) RunAndReturn(run func(string, string) bool) *Database_DeleteWorkspaceRepository_Call {
+func (_c *Database_DeleteWorkspaceFeatureStatus_Call) RunAndReturn(run func(string, string) error) *Database_DeleteWorkspaceFeatureStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteWorkspaceUser provides a mock function with given fields: orgUser, org
-func (_m *Database) DeleteWorkspaceUser(orgUser db.WorkspaceUsersData, org string) db.WorkspaceUsersData {
-	ret := _m.Called(orgUser, org)
+// DeleteWorkspaceRepository provides a mock function with given fields: workspace_uuid, uuid
+func (_m *Database) DeleteWorkspaceRepository(workspace_uuid string, uuid string) bool {
+	ret := _m.Called(workspace_uuid, uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteWorkspaceUser")
+		panic("no return value specified for DeleteWorkspaceRepository")
 	}
 
-	var r0 db.WorkspaceUsersData
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(workspace_uuid, uuid)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Database_DeleteWorkspaceRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspaceRepository'
+type Database_DeleteWorkspaceRepository_Call struct {
+	*mock.Call
+}
+
+// DeleteWorkspaceRepository is a helper method to define mock.On call
+//   - workspace_uuid string
+//   - uuid string
+func (_e *Database_Expecter) DeleteWorkspaceRepository(workspace_uuid interface{}, uuid interface{}) *Database_DeleteWorkspaceRepository_Call {
+	return &Database_DeleteWorkspaceRepository_Call{Call: _e.mock.On("DeleteWorkspaceRepository", workspace_uuid, uuid)}
+}
+
+func (_c *Database_DeleteWorkspaceRepository_Call) Run(run func(workspace_uuid string, uuid string)) *Database_DeleteWorkspaceRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_DeleteWorkspaceRepository_Call) Return(_a0 bool) *Database_DeleteWorkspaceRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_DeleteWorkspaceRepository_Call) RunAndReturn(run func(string, string) bool) *Database_DeleteWorkspaceRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWorkspaceTag provides a mock function with given fields: workspace_uuid, name
+func (_m *Database) DeleteWorkspaceTag(workspace_uuid string, name string) error {
+	ret := _m.Called(workspace_uuid, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspaceTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(workspace_uuid, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Database_DeleteWorkspaceTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspaceTag'
+type Database_DeleteWorkspaceTag_Call struct {
+	*mock.Call
+}
+
+// DeleteWorkspaceTag is a helper method to define mock.On call
+//   - workspace_uuid string
+//   - name string
+func (_e *Database_Expecter) DeleteWorkspaceTag(workspace_uuid interface{}, name interface{}) *Database_DeleteWorkspaceTag_Call {
+	return &Database_DeleteWorkspaceTag_Call{Call: _e.mock.On("DeleteWorkspaceTag", workspace_uuid, name)}
+}
+
+func (_c *Database_DeleteWorkspaceTag_Call) Run(run func(workspace_uuid string, name string)) *Database_DeleteWorkspaceTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_DeleteWorkspaceTag_Call) Return(_a0 error) *Database_DeleteWorkspaceTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_DeleteWorkspaceTag_Call) RunAndReturn(run func(string, string) error) *Database_DeleteWorkspaceTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWorkspaceUser provides a mock function with given fields: orgUser, org
+func (_m *Database) DeleteWorkspaceUser(orgUser db.WorkspaceUsersData, org string) db.WorkspaceUsersData {
+	ret := _m.Called(orgUser, org)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspaceUser")
+	}
+
+	var r0 db.WorkspaceUsersData
 	if rf, ok := ret.Get(0).(func(db.WorkspaceUsersData, string) db.WorkspaceUsersData); ok {
 		r0 = rf(orgUser, org)
 	} else {
@@ -2036,6 +2799,54 @@ func (_c *Database_GetAllTribesByOwner_Call) RunAndReturn(run func(string) []db.
 	return _c
 }
 
+// GetAllWorkspaceInvoices provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetAllWorkspaceInvoices(workspace_uuid string) []db.NewInvoiceList {
+	ret := _m.Called(workspace_uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWorkspaceInvoices")
+	}
+
+	var r0 []db.NewInvoiceList
+	if rf, ok := ret.Get(0).(func(string) []db.NewInvoiceList); ok {
+		r0 = rf(workspace_uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.NewInvoiceList)
+		}
+	}
+
+	return r0
+}
+
+// Database_GetAllWorkspaceInvoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWorkspaceInvoices'
+type Database_GetAllWorkspaceInvoices_Call struct {
+	*mock.Call
+}
+
+// GetAllWorkspaceInvoices is a helper method to define mock.On call
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetAllWorkspaceInvoices(workspace_uuid interface{}) *Database_GetAllWorkspaceInvoices_Call {
+	return &Database_GetAllWorkspaceInvoices_Call{Call: _e.mock.On("GetAllWorkspaceInvoices", workspace_uuid)}
+}
+
+func (_c *Database_GetAllWorkspaceInvoices_Call) Run(run func(workspace_uuid string)) *Database_GetAllWorkspaceInvoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetAllWorkspaceInvoices_Call) Return(_a0 []db.NewInvoiceList) *Database_GetAllWorkspaceInvoices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetAllWorkspaceInvoices_Call) RunAndReturn(run func(string) []db.NewInvoiceList) *Database_GetAllWorkspaceInvoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAssignedBounties provides a mock function with given fields: r
 func (_m *Database) GetAssignedBounties(r *http.Request) ([]db.NewBounty, error) {
 	ret := _m.Called(r)
@@ -2390,6 +3201,65 @@ func (_c *Database_GetBountiesByFeatureAndPhaseUuid_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetBountiesByFeatureUuid provides a mock function with given fields: featureUuid, r
+func (_m *Database) GetBountiesByFeatureUuid(featureUuid string, r *http.Request) ([]db.NewBounty, error) {
+	ret := _m.Called(featureUuid, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBountiesByFeatureUuid")
+	}
+
+	var r0 []db.NewBounty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *http.Request) ([]db.NewBounty, error)); ok {
+		return rf(featureUuid, r)
+	}
+	if rf, ok := ret.Get(0).(func(string, *http.Request) []db.NewBounty); ok {
+		r0 = rf(featureUuid, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.NewBounty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *http.Request) error); ok {
+		r1 = rf(featureUuid, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetBountiesByFeatureUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBountiesByFeatureUuid'
+type Database_GetBountiesByFeatureUuid_Call struct {
+	*mock.Call
+}
+
+// GetBountiesByFeatureUuid is a helper method to define mock.On call
+//   - featureUuid string
+//   - r *http.Request
+func (_e *Database_Expecter) GetBountiesByFeatureUuid(featureUuid interface{}, r interface{}) *Database_GetBountiesByFeatureUuid_Call {
+	return &Database_GetBountiesByFeatureUuid_Call{Call: _e.mock.On("GetBountiesByFeatureUuid", featureUuid, r)}
+}
+
+func (_c *Database_GetBountiesByFeatureUuid_Call) Run(run func(featureUuid string, r *http.Request)) *Database_GetBountiesByFeatureUuid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetBountiesByFeatureUuid_Call) Return(_a0 []db.NewBounty, _a1 error) *Database_GetBountiesByFeatureUuid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetBountiesByFeatureUuid_Call) RunAndReturn(run func(string, *http.Request) ([]db.NewBounty, error)) *Database_GetBountiesByFeatureUuid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBountiesByPhaseUuid provides a mock function with given fields: phaseUuid
 func (_m *Database) GetBountiesByPhaseUuid(phaseUuid string) []db.Bounty {
 	ret := _m.Called(phaseUuid)
@@ -2532,6 +3402,53 @@ func (_c *Database_GetBountiesCountByFeatureAndPhaseUuid_Call) RunAndReturn(run
 	return _c
 }
 
+// GetBountiesCountByFeatureUuid provides a mock function with given fields: featureUuid, r
+func (_m *Database) GetBountiesCountByFeatureUuid(featureUuid string, r *http.Request) int64 {
+	ret := _m.Called(featureUuid, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBountiesCountByFeatureUuid")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, *http.Request) int64); ok {
+		r0 = rf(featureUuid, r)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// Database_GetBountiesCountByFeatureUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBountiesCountByFeatureUuid'
+type Database_GetBountiesCountByFeatureUuid_Call struct {
+	*mock.Call
+}
+
+// GetBountiesCountByFeatureUuid is a helper method to define mock.On call
+//   - featureUuid string
+//   - r *http.Request
+func (_e *Database_Expecter) GetBountiesCountByFeatureUuid(featureUuid interface{}, r interface{}) *Database_GetBountiesCountByFeatureUuid_Call {
+	return &Database_GetBountiesCountByFeatureUuid_Call{Call: _e.mock.On("GetBountiesCountByFeatureUuid", featureUuid, r)}
+}
+
+func (_c *Database_GetBountiesCountByFeatureUuid_Call) Run(run func(featureUuid string, r *http.Request)) *Database_GetBountiesCountByFeatureUuid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetBountiesCountByFeatureUuid_Call) Return(_a0 int64) *Database_GetBountiesCountByFeatureUuid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetBountiesCountByFeatureUuid_Call) RunAndReturn(run func(string, *http.Request) int64) *Database_GetBountiesCountByFeatureUuid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBountiesLeaderboard provides a mock function with given fields:
 func (_m *Database) GetBountiesLeaderboard() []db.LeaderData {
 	ret := _m.Called()
@@ -3136,2287 +4053,3837 @@ func (_c *Database_GetCreatedBounties_Call) RunAndReturn(run func(*http.Request)
 	return _c
 }
 
-// GetFeatureByUuid provides a mock function with given fields: uuid
-func (_m *Database) GetFeatureByUuid(uuid string) db.WorkspaceFeatures {
-	ret := _m.Called(uuid)
+// GetDownstreamFeatures provides a mock function with given fields: featureUuid
+func (_m *Database) GetDownstreamFeatures(featureUuid string) []db.WorkspaceFeatures {
+	ret := _m.Called(featureUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFeatureByUuid")
+		panic("no return value specified for GetDownstreamFeatures")
 	}
 
-	var r0 db.WorkspaceFeatures
-	if rf, ok := ret.Get(0).(func(string) db.WorkspaceFeatures); ok {
-		r0 = rf(uuid)
+	var r0 []db.WorkspaceFeatures
+	if rf, ok := ret.Get(0).(func(string) []db.WorkspaceFeatures); ok {
+		r0 = rf(featureUuid)
 	} else {
-		r0 = ret.Get(0).(db.WorkspaceFeatures)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.WorkspaceFeatures)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetFeatureByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureByUuid'
-type Database_GetFeatureByUuid_Call struct {
+// Database_GetDownstreamFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDownstreamFeatures'
+type Database_GetDownstreamFeatures_Call struct {
 	*mock.Call
 }
 
-// GetFeatureByUuid is a helper method to define mock.On call
-//   - uuid string
-func (_e *Database_Expecter) GetFeatureByUuid(uuid interface{}) *Database_GetFeatureByUuid_Call {
-	return &Database_GetFeatureByUuid_Call{Call: _e.mock.On("GetFeatureByUuid", uuid)}
+// GetDownstreamFeatures is a helper method to define mock.On call
+//   - featureUuid string
+func (_e *Database_Expecter) GetDownstreamFeatures(featureUuid interface{}) *Database_GetDownstreamFeatures_Call {
+	return &Database_GetDownstreamFeatures_Call{Call: _e.mock.On("GetDownstreamFeatures", featureUuid)}
 }
 
-func (_c *Database_GetFeatureByUuid_Call) Run(run func(uuid string)) *Database_GetFeatureByUuid_Call {
+func (_c *Database_GetDownstreamFeatures_Call) Run(run func(featureUuid string)) *Database_GetDownstreamFeatures_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetFeatureByUuid_Call) Return(_a0 db.WorkspaceFeatures) *Database_GetFeatureByUuid_Call {
+func (_c *Database_GetDownstreamFeatures_Call) Return(_a0 []db.WorkspaceFeatures) *Database_GetDownstreamFeatures_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetFeatureByUuid_Call) RunAndReturn(run func(string) db.WorkspaceFeatures) *Database_GetFeatureByUuid_Call {
+func (_c *Database_GetDownstreamFeatures_Call) RunAndReturn(run func(string) []db.WorkspaceFeatures) *Database_GetDownstreamFeatures_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFeaturePhaseByUuid provides a mock function with given fields: featureUuid, phaseUuid
-func (_m *Database) GetFeaturePhaseByUuid(featureUuid string, phaseUuid string) (db.FeaturePhase, error) {
-	ret := _m.Called(featureUuid, phaseUuid)
+// GetFeatureActivity provides a mock function with given fields: featureUuid, r
+func (_m *Database) GetFeatureActivity(featureUuid string, r *http.Request) []db.FeatureActivity {
+	ret := _m.Called(featureUuid, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFeaturePhaseByUuid")
-	}
-
-	var r0 db.FeaturePhase
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (db.FeaturePhase, error)); ok {
-		return rf(featureUuid, phaseUuid)
-	}
-	if rf, ok := ret.Get(0).(func(string, string) db.FeaturePhase); ok {
-		r0 = rf(featureUuid, phaseUuid)
-	} else {
-		r0 = ret.Get(0).(db.FeaturePhase)
+		panic("no return value specified for GetFeatureActivity")
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(featureUuid, phaseUuid)
+	var r0 []db.FeatureActivity
+	if rf, ok := ret.Get(0).(func(string, *http.Request) []db.FeatureActivity); ok {
+		r0 = rf(featureUuid, r)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.FeatureActivity)
+		}
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetFeaturePhaseByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeaturePhaseByUuid'
-type Database_GetFeaturePhaseByUuid_Call struct {
+// Database_GetFeatureActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureActivity'
+type Database_GetFeatureActivity_Call struct {
 	*mock.Call
 }
 
-// GetFeaturePhaseByUuid is a helper method to define mock.On call
+// GetFeatureActivity is a helper method to define mock.On call
 //   - featureUuid string
-//   - phaseUuid string
-func (_e *Database_Expecter) GetFeaturePhaseByUuid(featureUuid interface{}, phaseUuid interface{}) *Database_GetFeaturePhaseByUuid_Call {
-	return &Database_GetFeaturePhaseByUuid_Call{Call: _e.mock.On("GetFeaturePhaseByUuid", featureUuid, phaseUuid)}
+//   - r *http.Request
+func (_e *Database_Expecter) GetFeatureActivity(featureUuid interface{}, r interface{}) *Database_GetFeatureActivity_Call {
+	return &Database_GetFeatureActivity_Call{Call: _e.mock.On("GetFeatureActivity", featureUuid, r)}
 }
 
-func (_c *Database_GetFeaturePhaseByUuid_Call) Run(run func(featureUuid string, phaseUuid string)) *Database_GetFeaturePhaseByUuid_Call {
+func (_c *Database_GetFeatureActivity_Call) Run(run func(featureUuid string, r *http.Request)) *Database_GetFeatureActivity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *Database_GetFeaturePhaseByUuid_Call) Return(_a0 db.FeaturePhase, _a1 error) *Database_GetFeaturePhaseByUuid_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetFeatureActivity_Call) Return(_a0 []db.FeatureActivity) *Database_GetFeatureActivity_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetFeaturePhaseByUuid_Call) RunAndReturn(run func(string, string) (db.FeaturePhase, error)) *Database_GetFeaturePhaseByUuid_Call {
+func (_c *Database_GetFeatureActivity_Call) RunAndReturn(run func(string, *http.Request) []db.FeatureActivity) *Database_GetFeatureActivity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFeaturePhasesBountiesCount provides a mock function with given fields: bountyType, phaseUuid
-func (_m *Database) GetFeaturePhasesBountiesCount(bountyType string, phaseUuid string) int64 {
-	ret := _m.Called(bountyType, phaseUuid)
+// GetFeatureBurndown provides a mock function with given fields: featureUuid, start, end
+func (_m *Database) GetFeatureBurndown(featureUuid string, start time.Time, end time.Time) []db.FeatureBurndown {
+	ret := _m.Called(featureUuid, start, end)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFeaturePhasesBountiesCount")
+		panic("no return value specified for GetFeatureBurndown")
 	}
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string, string) int64); ok {
-		r0 = rf(bountyType, phaseUuid)
+	var r0 []db.FeatureBurndown
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time) []db.FeatureBurndown); ok {
+		r0 = rf(featureUuid, start, end)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.FeatureBurndown)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetFeaturePhasesBountiesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeaturePhasesBountiesCount'
-type Database_GetFeaturePhasesBountiesCount_Call struct {
+// Database_GetFeatureBurndown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureBurndown'
+type Database_GetFeatureBurndown_Call struct {
 	*mock.Call
 }
 
-// GetFeaturePhasesBountiesCount is a helper method to define mock.On call
-//   - bountyType string
-//   - phaseUuid string
-func (_e *Database_Expecter) GetFeaturePhasesBountiesCount(bountyType interface{}, phaseUuid interface{}) *Database_GetFeaturePhasesBountiesCount_Call {
-	return &Database_GetFeaturePhasesBountiesCount_Call{Call: _e.mock.On("GetFeaturePhasesBountiesCount", bountyType, phaseUuid)}
+// GetFeatureBurndown is a helper method to define mock.On call
+//   - featureUuid string
+//   - start time.Time
+//   - end time.Time
+func (_e *Database_Expecter) GetFeatureBurndown(featureUuid interface{}, start interface{}, end interface{}) *Database_GetFeatureBurndown_Call {
+	return &Database_GetFeatureBurndown_Call{Call: _e.mock.On("GetFeatureBurndown", featureUuid, start, end)}
 }
 
-func (_c *Database_GetFeaturePhasesBountiesCount_Call) Run(run func(bountyType string, phaseUuid string)) *Database_GetFeaturePhasesBountiesCount_Call {
+func (_c *Database_GetFeatureBurndown_Call) Run(run func(featureUuid string, start time.Time, end time.Time)) *Database_GetFeatureBurndown_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string), args[1].(time.Time), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *Database_GetFeaturePhasesBountiesCount_Call) Return(_a0 int64) *Database_GetFeaturePhasesBountiesCount_Call {
+func (_c *Database_GetFeatureBurndown_Call) Return(_a0 []db.FeatureBurndown) *Database_GetFeatureBurndown_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetFeaturePhasesBountiesCount_Call) RunAndReturn(run func(string, string) int64) *Database_GetFeaturePhasesBountiesCount_Call {
+func (_c *Database_GetFeatureBurndown_Call) RunAndReturn(run func(string, time.Time, time.Time) []db.FeatureBurndown) *Database_GetFeatureBurndown_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFeatureStoriesByFeatureUuid provides a mock function with given fields: featureUuid
-func (_m *Database) GetFeatureStoriesByFeatureUuid(featureUuid string) ([]db.FeatureStory, error) {
-	ret := _m.Called(featureUuid)
+// GetFeatureByUuid provides a mock function with given fields: uuid
+func (_m *Database) GetFeatureByUuid(uuid string) db.WorkspaceFeatures {
+	ret := _m.Called(uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFeatureStoriesByFeatureUuid")
-	}
-
-	var r0 []db.FeatureStory
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]db.FeatureStory, error)); ok {
-		return rf(featureUuid)
-	}
-	if rf, ok := ret.Get(0).(func(string) []db.FeatureStory); ok {
-		r0 = rf(featureUuid)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.FeatureStory)
-		}
+		panic("no return value specified for GetFeatureByUuid")
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(featureUuid)
+	var r0 db.WorkspaceFeatures
+	if rf, ok := ret.Get(0).(func(string) db.WorkspaceFeatures); ok {
+		r0 = rf(uuid)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(db.WorkspaceFeatures)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetFeatureStoriesByFeatureUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureStoriesByFeatureUuid'
-type Database_GetFeatureStoriesByFeatureUuid_Call struct {
+// Database_GetFeatureByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureByUuid'
+type Database_GetFeatureByUuid_Call struct {
 	*mock.Call
 }
 
-// GetFeatureStoriesByFeatureUuid is a helper method to define mock.On call
-//   - featureUuid string
-func (_e *Database_Expecter) GetFeatureStoriesByFeatureUuid(featureUuid interface{}) *Database_GetFeatureStoriesByFeatureUuid_Call {
-	return &Database_GetFeatureStoriesByFeatureUuid_Call{Call: _e.mock.On("GetFeatureStoriesByFeatureUuid", featureUuid)}
+// GetFeatureByUuid is a helper method to define mock.On call
+//   - uuid string
+func (_e *Database_Expecter) GetFeatureByUuid(uuid interface{}) *Database_GetFeatureByUuid_Call {
+	return &Database_GetFeatureByUuid_Call{Call: _e.mock.On("GetFeatureByUuid", uuid)}
 }
 
-func (_c *Database_GetFeatureStoriesByFeatureUuid_Call) Run(run func(featureUuid string)) *Database_GetFeatureStoriesByFeatureUuid_Call {
+func (_c *Database_GetFeatureByUuid_Call) Run(run func(uuid string)) *Database_GetFeatureByUuid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetFeatureStoriesByFeatureUuid_Call) Return(_a0 []db.FeatureStory, _a1 error) *Database_GetFeatureStoriesByFeatureUuid_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetFeatureByUuid_Call) Return(_a0 db.WorkspaceFeatures) *Database_GetFeatureByUuid_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetFeatureStoriesByFeatureUuid_Call) RunAndReturn(run func(string) ([]db.FeatureStory, error)) *Database_GetFeatureStoriesByFeatureUuid_Call {
+func (_c *Database_GetFeatureByUuid_Call) RunAndReturn(run func(string) db.WorkspaceFeatures) *Database_GetFeatureByUuid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFeatureStoryByUuid provides a mock function with given fields: featureUuid, storyUuid
-func (_m *Database) GetFeatureStoryByUuid(featureUuid string, storyUuid string) (db.FeatureStory, error) {
-	ret := _m.Called(featureUuid, storyUuid)
+// GetFeatureEstimatedHours provides a mock function with given fields: featureUuid
+func (_m *Database) GetFeatureEstimatedHours(featureUuid string) db.FeatureEstimatedHours {
+	ret := _m.Called(featureUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFeatureStoryByUuid")
-	}
-
-	var r0 db.FeatureStory
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (db.FeatureStory, error)); ok {
-		return rf(featureUuid, storyUuid)
-	}
-	if rf, ok := ret.Get(0).(func(string, string) db.FeatureStory); ok {
-		r0 = rf(featureUuid, storyUuid)
-	} else {
-		r0 = ret.Get(0).(db.FeatureStory)
+		panic("no return value specified for GetFeatureEstimatedHours")
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(featureUuid, storyUuid)
+	var r0 db.FeatureEstimatedHours
+	if rf, ok := ret.Get(0).(func(string) db.FeatureEstimatedHours); ok {
+		r0 = rf(featureUuid)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(db.FeatureEstimatedHours)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetFeatureStoryByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureStoryByUuid'
-type Database_GetFeatureStoryByUuid_Call struct {
+// Database_GetFeatureEstimatedHours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureEstimatedHours'
+type Database_GetFeatureEstimatedHours_Call struct {
 	*mock.Call
 }
 
-// GetFeatureStoryByUuid is a helper method to define mock.On call
+// GetFeatureEstimatedHours is a helper method to define mock.On call
 //   - featureUuid string
-//   - storyUuid string
-func (_e *Database_Expecter) GetFeatureStoryByUuid(featureUuid interface{}, storyUuid interface{}) *Database_GetFeatureStoryByUuid_Call {
-	return &Database_GetFeatureStoryByUuid_Call{Call: _e.mock.On("GetFeatureStoryByUuid", featureUuid, storyUuid)}
+func (_e *Database_Expecter) GetFeatureEstimatedHours(featureUuid interface{}) *Database_GetFeatureEstimatedHours_Call {
+	return &Database_GetFeatureEstimatedHours_Call{Call: _e.mock.On("GetFeatureEstimatedHours", featureUuid)}
 }
 
-func (_c *Database_GetFeatureStoryByUuid_Call) Run(run func(featureUuid string, storyUuid string)) *Database_GetFeatureStoryByUuid_Call {
+func (_c *Database_GetFeatureEstimatedHours_Call) Run(run func(featureUuid string)) *Database_GetFeatureEstimatedHours_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetFeatureStoryByUuid_Call) Return(_a0 db.FeatureStory, _a1 error) *Database_GetFeatureStoryByUuid_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetFeatureEstimatedHours_Call) Return(_a0 db.FeatureEstimatedHours) *Database_GetFeatureEstimatedHours_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetFeatureStoryByUuid_Call) RunAndReturn(run func(string, string) (db.FeatureStory, error)) *Database_GetFeatureStoryByUuid_Call {
+func (_c *Database_GetFeatureEstimatedHours_Call) RunAndReturn(run func(string) db.FeatureEstimatedHours) *Database_GetFeatureEstimatedHours_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFeaturesByWorkspaceUuid provides a mock function with given fields: uuid, r
-func (_m *Database) GetFeaturesByWorkspaceUuid(uuid string, r *http.Request) []db.WorkspaceFeatures {
-	ret := _m.Called(uuid, r)
+// GetFeaturePhaseByUuid provides a mock function with given fields: featureUuid, phaseUuid
+func (_m *Database) GetFeaturePhaseByUuid(featureUuid string, phaseUuid string) (db.FeaturePhase, error) {
+	ret := _m.Called(featureUuid, phaseUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFeaturesByWorkspaceUuid")
+		panic("no return value specified for GetFeaturePhaseByUuid")
 	}
 
-	var r0 []db.WorkspaceFeatures
-	if rf, ok := ret.Get(0).(func(string, *http.Request) []db.WorkspaceFeatures); ok {
-		r0 = rf(uuid, r)
+	var r0 db.FeaturePhase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (db.FeaturePhase, error)); ok {
+		return rf(featureUuid, phaseUuid)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) db.FeaturePhase); ok {
+		r0 = rf(featureUuid, phaseUuid)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.WorkspaceFeatures)
-		}
+		r0 = ret.Get(0).(db.FeaturePhase)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(featureUuid, phaseUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Database_GetFeaturesByWorkspaceUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeaturesByWorkspaceUuid'
-type Database_GetFeaturesByWorkspaceUuid_Call struct {
+// Database_GetFeaturePhaseByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeaturePhaseByUuid'
+type Database_GetFeaturePhaseByUuid_Call struct {
 	*mock.Call
 }
 
-// GetFeaturesByWorkspaceUuid is a helper method to define mock.On call
-//   - uuid string
-//   - r *http.Request
-func (_e *Database_Expecter) GetFeaturesByWorkspaceUuid(uuid interface{}, r interface{}) *Database_GetFeaturesByWorkspaceUuid_Call {
-	return &Database_GetFeaturesByWorkspaceUuid_Call{Call: _e.mock.On("GetFeaturesByWorkspaceUuid", uuid, r)}
+// GetFeaturePhaseByUuid is a helper method to define mock.On call
+//   - featureUuid string
+//   - phaseUuid string
+func (_e *Database_Expecter) GetFeaturePhaseByUuid(featureUuid interface{}, phaseUuid interface{}) *Database_GetFeaturePhaseByUuid_Call {
+	return &Database_GetFeaturePhaseByUuid_Call{Call: _e.mock.On("GetFeaturePhaseByUuid", featureUuid, phaseUuid)}
 }
 
-func (_c *Database_GetFeaturesByWorkspaceUuid_Call) Run(run func(uuid string, r *http.Request)) *Database_GetFeaturesByWorkspaceUuid_Call {
+func (_c *Database_GetFeaturePhaseByUuid_Call) Run(run func(featureUuid string, phaseUuid string)) *Database_GetFeaturePhaseByUuid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(*http.Request))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetFeaturesByWorkspaceUuid_Call) Return(_a0 []db.WorkspaceFeatures) *Database_GetFeaturesByWorkspaceUuid_Call {
-	_c.Call.Return(_a0)
+func (_c *Database_GetFeaturePhaseByUuid_Call) Return(_a0 db.FeaturePhase, _a1 error) *Database_GetFeaturePhaseByUuid_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Database_GetFeaturesByWorkspaceUuid_Call) RunAndReturn(run func(string, *http.Request) []db.WorkspaceFeatures) *Database_GetFeaturesByWorkspaceUuid_Call {
+func (_c *Database_GetFeaturePhaseByUuid_Call) RunAndReturn(run func(string, string) (db.FeaturePhase, error)) *Database_GetFeaturePhaseByUuid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFilterStatusCount provides a mock function with given fields:
-func (_m *Database) GetFilterStatusCount() db.FilterStattuCount {
-	ret := _m.Called()
+// GetFeaturePhasesBountiesCount provides a mock function with given fields: bountyType, phaseUuid
+func (_m *Database) GetFeaturePhasesBountiesCount(bountyType string, phaseUuid string) int64 {
+	ret := _m.Called(bountyType, phaseUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFilterStatusCount")
+		panic("no return value specified for GetFeaturePhasesBountiesCount")
 	}
 
-	var r0 db.FilterStattuCount
-	if rf, ok := ret.Get(0).(func() db.FilterStattuCount); ok {
-		r0 = rf()
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, string) int64); ok {
+		r0 = rf(bountyType, phaseUuid)
 	} else {
-		r0 = ret.Get(0).(db.FilterStattuCount)
+		r0 = ret.Get(0).(int64)
 	}
 
 	return r0
 }
 
-// Database_GetFilterStatusCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFilterStatusCount'
-type Database_GetFilterStatusCount_Call struct {
+// Database_GetFeaturePhasesBountiesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeaturePhasesBountiesCount'
+type Database_GetFeaturePhasesBountiesCount_Call struct {
 	*mock.Call
 }
 
-// GetFilterStatusCount is a helper method to define mock.On call
-func (_e *Database_Expecter) GetFilterStatusCount() *Database_GetFilterStatusCount_Call {
-	return &Database_GetFilterStatusCount_Call{Call: _e.mock.On("GetFilterStatusCount")}
+// GetFeaturePhasesBountiesCount is a helper method to define mock.On call
+//   - bountyType string
+//   - phaseUuid string
+func (_e *Database_Expecter) GetFeaturePhasesBountiesCount(bountyType interface{}, phaseUuid interface{}) *Database_GetFeaturePhasesBountiesCount_Call {
+	return &Database_GetFeaturePhasesBountiesCount_Call{Call: _e.mock.On("GetFeaturePhasesBountiesCount", bountyType, phaseUuid)}
 }
 
-func (_c *Database_GetFilterStatusCount_Call) Run(run func()) *Database_GetFilterStatusCount_Call {
+func (_c *Database_GetFeaturePhasesBountiesCount_Call) Run(run func(bountyType string, phaseUuid string)) *Database_GetFeaturePhasesBountiesCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetFilterStatusCount_Call) Return(_a0 db.FilterStattuCount) *Database_GetFilterStatusCount_Call {
+func (_c *Database_GetFeaturePhasesBountiesCount_Call) Return(_a0 int64) *Database_GetFeaturePhasesBountiesCount_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetFilterStatusCount_Call) RunAndReturn(run func() db.FilterStattuCount) *Database_GetFilterStatusCount_Call {
+func (_c *Database_GetFeaturePhasesBountiesCount_Call) RunAndReturn(run func(string, string) int64) *Database_GetFeaturePhasesBountiesCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFirstTribeByFeedURL provides a mock function with given fields: feedURL
-func (_m *Database) GetFirstTribeByFeedURL(feedURL string) db.Tribe {
-	ret := _m.Called(feedURL)
+// GetFeatureSnapshot provides a mock function with given fields: featureUuid, snapshotUuid
+func (_m *Database) GetFeatureSnapshot(featureUuid string, snapshotUuid string) (db.FeatureSnapshot, error) {
+	ret := _m.Called(featureUuid, snapshotUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFirstTribeByFeedURL")
+		panic("no return value specified for GetFeatureSnapshot")
 	}
 
-	var r0 db.Tribe
-	if rf, ok := ret.Get(0).(func(string) db.Tribe); ok {
-		r0 = rf(feedURL)
+	var r0 db.FeatureSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (db.FeatureSnapshot, error)); ok {
+		return rf(featureUuid, snapshotUuid)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) db.FeatureSnapshot); ok {
+		r0 = rf(featureUuid, snapshotUuid)
 	} else {
-		r0 = ret.Get(0).(db.Tribe)
+		r0 = ret.Get(0).(db.FeatureSnapshot)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(featureUuid, snapshotUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Database_GetFirstTribeByFeedURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFirstTribeByFeedURL'
-type Database_GetFirstTribeByFeedURL_Call struct {
+// Database_GetFeatureSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureSnapshot'
+type Database_GetFeatureSnapshot_Call struct {
 	*mock.Call
 }
 
-// GetFirstTribeByFeedURL is a helper method to define mock.On call
-//   - feedURL string
-func (_e *Database_Expecter) GetFirstTribeByFeedURL(feedURL interface{}) *Database_GetFirstTribeByFeedURL_Call {
-	return &Database_GetFirstTribeByFeedURL_Call{Call: _e.mock.On("GetFirstTribeByFeedURL", feedURL)}
+// GetFeatureSnapshot is a helper method to define mock.On call
+//   - featureUuid string
+//   - snapshotUuid string
+func (_e *Database_Expecter) GetFeatureSnapshot(featureUuid interface{}, snapshotUuid interface{}) *Database_GetFeatureSnapshot_Call {
+	return &Database_GetFeatureSnapshot_Call{Call: _e.mock.On("GetFeatureSnapshot", featureUuid, snapshotUuid)}
 }
 
-func (_c *Database_GetFirstTribeByFeedURL_Call) Run(run func(feedURL string)) *Database_GetFirstTribeByFeedURL_Call {
+func (_c *Database_GetFeatureSnapshot_Call) Run(run func(featureUuid string, snapshotUuid string)) *Database_GetFeatureSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetFirstTribeByFeedURL_Call) Return(_a0 db.Tribe) *Database_GetFirstTribeByFeedURL_Call {
-	_c.Call.Return(_a0)
+func (_c *Database_GetFeatureSnapshot_Call) Return(_a0 db.FeatureSnapshot, _a1 error) *Database_GetFeatureSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Database_GetFirstTribeByFeedURL_Call) RunAndReturn(run func(string) db.Tribe) *Database_GetFirstTribeByFeedURL_Call {
+func (_c *Database_GetFeatureSnapshot_Call) RunAndReturn(run func(string, string) (db.FeatureSnapshot, error)) *Database_GetFeatureSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetInvoice provides a mock function with given fields: payment_request
-func (_m *Database) GetInvoice(payment_request string) db.NewInvoiceList {
-	ret := _m.Called(payment_request)
+// GetFeatureSnapshots provides a mock function with given fields: featureUuid
+func (_m *Database) GetFeatureSnapshots(featureUuid string) []db.FeatureSnapshot {
+	ret := _m.Called(featureUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetInvoice")
+		panic("no return value specified for GetFeatureSnapshots")
 	}
 
-	var r0 db.NewInvoiceList
-	if rf, ok := ret.Get(0).(func(string) db.NewInvoiceList); ok {
-		r0 = rf(payment_request)
+	var r0 []db.FeatureSnapshot
+	if rf, ok := ret.Get(0).(func(string) []db.FeatureSnapshot); ok {
+		r0 = rf(featureUuid)
 	} else {
-		r0 = ret.Get(0).(db.NewInvoiceList)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.FeatureSnapshot)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetInvoice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvoice'
-type Database_GetInvoice_Call struct {
+// Database_GetFeatureSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureSnapshots'
+type Database_GetFeatureSnapshots_Call struct {
 	*mock.Call
 }
 
-// GetInvoice is a helper method to define mock.On call
-//   - payment_request string
-func (_e *Database_Expecter) GetInvoice(payment_request interface{}) *Database_GetInvoice_Call {
-	return &Database_GetInvoice_Call{Call: _e.mock.On("GetInvoice", payment_request)}
+// GetFeatureSnapshots is a helper method to define mock.On call
+//   - featureUuid string
+func (_e *Database_Expecter) GetFeatureSnapshots(featureUuid interface{}) *Database_GetFeatureSnapshots_Call {
+	return &Database_GetFeatureSnapshots_Call{Call: _e.mock.On("GetFeatureSnapshots", featureUuid)}
 }
 
-func (_c *Database_GetInvoice_Call) Run(run func(payment_request string)) *Database_GetInvoice_Call {
+func (_c *Database_GetFeatureSnapshots_Call) Run(run func(featureUuid string)) *Database_GetFeatureSnapshots_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetInvoice_Call) Return(_a0 db.NewInvoiceList) *Database_GetInvoice_Call {
+func (_c *Database_GetFeatureSnapshots_Call) Return(_a0 []db.FeatureSnapshot) *Database_GetFeatureSnapshots_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetInvoice_Call) RunAndReturn(run func(string) db.NewInvoiceList) *Database_GetInvoice_Call {
+func (_c *Database_GetFeatureSnapshots_Call) RunAndReturn(run func(string) []db.FeatureSnapshot) *Database_GetFeatureSnapshots_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLeaderBoard provides a mock function with given fields: uuid
-func (_m *Database) GetLeaderBoard(uuid string) []db.LeaderBoard {
-	ret := _m.Called(uuid)
+// GetFeatureStoriesByFeatureUuid provides a mock function with given fields: featureUuid
+func (_m *Database) GetFeatureStoriesByFeatureUuid(featureUuid string) ([]db.FeatureStory, error) {
+	ret := _m.Called(featureUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLeaderBoard")
+		panic("no return value specified for GetFeatureStoriesByFeatureUuid")
 	}
 
-	var r0 []db.LeaderBoard
-	if rf, ok := ret.Get(0).(func(string) []db.LeaderBoard); ok {
-		r0 = rf(uuid)
+	var r0 []db.FeatureStory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]db.FeatureStory, error)); ok {
+		return rf(featureUuid)
+	}
+	if rf, ok := ret.Get(0).(func(string) []db.FeatureStory); ok {
+		r0 = rf(featureUuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.LeaderBoard)
+			r0 = ret.Get(0).([]db.FeatureStory)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(featureUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Database_GetLeaderBoard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaderBoard'
-type Database_GetLeaderBoard_Call struct {
+// Database_GetFeatureStoriesByFeatureUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureStoriesByFeatureUuid'
+type Database_GetFeatureStoriesByFeatureUuid_Call struct {
 	*mock.Call
 }
 
-// GetLeaderBoard is a helper method to define mock.On call
-//   - uuid string
-func (_e *Database_Expecter) GetLeaderBoard(uuid interface{}) *Database_GetLeaderBoard_Call {
-	return &Database_GetLeaderBoard_Call{Call: _e.mock.On("GetLeaderBoard", uuid)}
+// GetFeatureStoriesByFeatureUuid is a helper method to define mock.On call
+//   - featureUuid string
+func (_e *Database_Expecter) GetFeatureStoriesByFeatureUuid(featureUuid interface{}) *Database_GetFeatureStoriesByFeatureUuid_Call {
+	return &Database_GetFeatureStoriesByFeatureUuid_Call{Call: _e.mock.On("GetFeatureStoriesByFeatureUuid", featureUuid)}
 }
 
-func (_c *Database_GetLeaderBoard_Call) Run(run func(uuid string)) *Database_GetLeaderBoard_Call {
+func (_c *Database_GetFeatureStoriesByFeatureUuid_Call) Run(run func(featureUuid string)) *Database_GetFeatureStoriesByFeatureUuid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetLeaderBoard_Call) Return(_a0 []db.LeaderBoard) *Database_GetLeaderBoard_Call {
-	_c.Call.Return(_a0)
+func (_c *Database_GetFeatureStoriesByFeatureUuid_Call) Return(_a0 []db.FeatureStory, _a1 error) *Database_GetFeatureStoriesByFeatureUuid_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Database_GetLeaderBoard_Call) RunAndReturn(run func(string) []db.LeaderBoard) *Database_GetLeaderBoard_Call {
+func (_c *Database_GetFeatureStoriesByFeatureUuid_Call) RunAndReturn(run func(string) ([]db.FeatureStory, error)) *Database_GetFeatureStoriesByFeatureUuid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLeaderBoardByUuidAndAlias provides a mock function with given fields: uuid, alias
-func (_m *Database) GetLeaderBoardByUuidAndAlias(uuid string, alias string) db.LeaderBoard {
-	ret := _m.Called(uuid, alias)
+// GetFeatureStoryByUuid provides a mock function with given fields: featureUuid, storyUuid
+func (_m *Database) GetFeatureStoryByUuid(featureUuid string, storyUuid string) (db.FeatureStory, error) {
+	ret := _m.Called(featureUuid, storyUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLeaderBoardByUuidAndAlias")
+		panic("no return value specified for GetFeatureStoryByUuid")
 	}
 
-	var r0 db.LeaderBoard
-	if rf, ok := ret.Get(0).(func(string, string) db.LeaderBoard); ok {
-		r0 = rf(uuid, alias)
+	var r0 db.FeatureStory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (db.FeatureStory, error)); ok {
+		return rf(featureUuid, storyUuid)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) db.FeatureStory); ok {
+		r0 = rf(featureUuid, storyUuid)
 	} else {
-		r0 = ret.Get(0).(db.LeaderBoard)
+		r0 = ret.Get(0).(db.FeatureStory)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(featureUuid, storyUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Database_GetLeaderBoardByUuidAndAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaderBoardByUuidAndAlias'
-type Database_GetLeaderBoardByUuidAndAlias_Call struct {
+// Database_GetFeatureStoryByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureStoryByUuid'
+type Database_GetFeatureStoryByUuid_Call struct {
 	*mock.Call
 }
 
-// GetLeaderBoardByUuidAndAlias is a helper method to define mock.On call
-//   - uuid string
-//   - alias string
-func (_e *Database_Expecter) GetLeaderBoardByUuidAndAlias(uuid interface{}, alias interface{}) *Database_GetLeaderBoardByUuidAndAlias_Call {
-	return &Database_GetLeaderBoardByUuidAndAlias_Call{Call: _e.mock.On("GetLeaderBoardByUuidAndAlias", uuid, alias)}
+// GetFeatureStoryByUuid is a helper method to define mock.On call
+//   - featureUuid string
+//   - storyUuid string
+func (_e *Database_Expecter) GetFeatureStoryByUuid(featureUuid interface{}, storyUuid interface{}) *Database_GetFeatureStoryByUuid_Call {
+	return &Database_GetFeatureStoryByUuid_Call{Call: _e.mock.On("GetFeatureStoryByUuid", featureUuid, storyUuid)}
 }
 
-func (_c *Database_GetLeaderBoardByUuidAndAlias_Call) Run(run func(uuid string, alias string)) *Database_GetLeaderBoardByUuidAndAlias_Call {
+func (_c *Database_GetFeatureStoryByUuid_Call) Run(run func(featureUuid string, storyUuid string)) *Database_GetFeatureStoryByUuid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetLeaderBoardByUuidAndAlias_Call) Return(_a0 db.LeaderBoard) *Database_GetLeaderBoardByUuidAndAlias_Call {
-	_c.Call.Return(_a0)
+func (_c *Database_GetFeatureStoryByUuid_Call) Return(_a0 db.FeatureStory, _a1 error) *Database_GetFeatureStoryByUuid_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Database_GetLeaderBoardByUuidAndAlias_Call) RunAndReturn(run func(string, string) db.LeaderBoard) *Database_GetLeaderBoardByUuidAndAlias_Call {
+func (_c *Database_GetFeatureStoryByUuid_Call) RunAndReturn(run func(string, string) (db.FeatureStory, error)) *Database_GetFeatureStoryByUuid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetListedBots provides a mock function with given fields: r
-func (_m *Database) GetListedBots(r *http.Request) []db.Bot {
-	ret := _m.Called(r)
+// GetFeatureVelocity provides a mock function with given fields: featureUuid, start, end
+func (_m *Database) GetFeatureVelocity(featureUuid string, start time.Time, end time.Time) db.FeatureVelocity {
+	ret := _m.Called(featureUuid, start, end)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetListedBots")
+		panic("no return value specified for GetFeatureVelocity")
 	}
 
-	var r0 []db.Bot
-	if rf, ok := ret.Get(0).(func(*http.Request) []db.Bot); ok {
-		r0 = rf(r)
+	var r0 db.FeatureVelocity
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time) db.FeatureVelocity); ok {
+		r0 = rf(featureUuid, start, end)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Bot)
-		}
+		r0 = ret.Get(0).(db.FeatureVelocity)
 	}
 
 	return r0
 }
 
-// Database_GetListedBots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedBots'
-type Database_GetListedBots_Call struct {
+// Database_GetFeatureVelocity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureVelocity'
+type Database_GetFeatureVelocity_Call struct {
 	*mock.Call
 }
 
-// GetListedBots is a helper method to define mock.On call
-//   - r *http.Request
-func (_e *Database_Expecter) GetListedBots(r interface{}) *Database_GetListedBots_Call {
-	return &Database_GetListedBots_Call{Call: _e.mock.On("GetListedBots", r)}
+// GetFeatureVelocity is a helper method to define mock.On call
+//   - featureUuid string
+//   - start time.Time
+//   - end time.Time
+func (_e *Database_Expecter) GetFeatureVelocity(featureUuid interface{}, start interface{}, end interface{}) *Database_GetFeatureVelocity_Call {
+	return &Database_GetFeatureVelocity_Call{Call: _e.mock.On("GetFeatureVelocity", featureUuid, start, end)}
 }
 
-func (_c *Database_GetListedBots_Call) Run(run func(r *http.Request)) *Database_GetListedBots_Call {
+func (_c *Database_GetFeatureVelocity_Call) Run(run func(featureUuid string, start time.Time, end time.Time)) *Database_GetFeatureVelocity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(string), args[1].(time.Time), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *Database_GetListedBots_Call) Return(_a0 []db.Bot) *Database_GetListedBots_Call {
+func (_c *Database_GetFeatureVelocity_Call) Return(_a0 db.FeatureVelocity) *Database_GetFeatureVelocity_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetListedBots_Call) RunAndReturn(run func(*http.Request) []db.Bot) *Database_GetListedBots_Call {
+func (_c *Database_GetFeatureVelocity_Call) RunAndReturn(run func(string, time.Time, time.Time) db.FeatureVelocity) *Database_GetFeatureVelocity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetListedOffers provides a mock function with given fields: r
-func (_m *Database) GetListedOffers(r *http.Request) ([]db.PeopleExtra, error) {
-	ret := _m.Called(r)
+// GetFeatureWatchers provides a mock function with given fields: featureUuid
+func (_m *Database) GetFeatureWatchers(featureUuid string) []db.FeatureWatcher {
+	ret := _m.Called(featureUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetListedOffers")
+		panic("no return value specified for GetFeatureWatchers")
 	}
 
-	var r0 []db.PeopleExtra
-	var r1 error
-	if rf, ok := ret.Get(0).(func(*http.Request) ([]db.PeopleExtra, error)); ok {
-		return rf(r)
-	}
-	if rf, ok := ret.Get(0).(func(*http.Request) []db.PeopleExtra); ok {
-		r0 = rf(r)
+	var r0 []db.FeatureWatcher
+	if rf, ok := ret.Get(0).(func(string) []db.FeatureWatcher); ok {
+		r0 = rf(featureUuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.PeopleExtra)
+			r0 = ret.Get(0).([]db.FeatureWatcher)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
-		r1 = rf(r)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+	return r0
 }
 
-// Database_GetListedOffers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedOffers'
-type Database_GetListedOffers_Call struct {
+// Database_GetFeatureWatchers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureWatchers'
+type Database_GetFeatureWatchers_Call struct {
 	*mock.Call
 }
 
-// GetListedOffers is a helper method to define mock.On call
-//   - r *http.Request
-func (_e *Database_Expecter) GetListedOffers(r interface{}) *Database_GetListedOffers_Call {
-	return &Database_GetListedOffers_Call{Call: _e.mock.On("GetListedOffers", r)}
+// GetFeatureWatchers is a helper method to define mock.On call
+//   - featureUuid string
+func (_e *Database_Expecter) GetFeatureWatchers(featureUuid interface{}) *Database_GetFeatureWatchers_Call {
+	return &Database_GetFeatureWatchers_Call{Call: _e.mock.On("GetFeatureWatchers", featureUuid)}
 }
 
-func (_c *Database_GetListedOffers_Call) Run(run func(r *http.Request)) *Database_GetListedOffers_Call {
+func (_c *Database_GetFeatureWatchers_Call) Run(run func(featureUuid string)) *Database_GetFeatureWatchers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetListedOffers_Call) Return(_a0 []db.PeopleExtra, _a1 error) *Database_GetListedOffers_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetFeatureWatchers_Call) Return(_a0 []db.FeatureWatcher) *Database_GetFeatureWatchers_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetListedOffers_Call) RunAndReturn(run func(*http.Request) ([]db.PeopleExtra, error)) *Database_GetListedOffers_Call {
+func (_c *Database_GetFeatureWatchers_Call) RunAndReturn(run func(string) []db.FeatureWatcher) *Database_GetFeatureWatchers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetListedPeople provides a mock function with given fields: r
-func (_m *Database) GetListedPeople(r *http.Request) []db.Person {
-	ret := _m.Called(r)
+// GetFeaturesByWorkspaceUuid provides a mock function with given fields: uuid, r
+func (_m *Database) GetFeaturesByWorkspaceUuid(uuid string, r *http.Request) []db.WorkspaceFeatures {
+	ret := _m.Called(uuid, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetListedPeople")
+		panic("no return value specified for GetFeaturesByWorkspaceUuid")
 	}
 
-	var r0 []db.Person
-	if rf, ok := ret.Get(0).(func(*http.Request) []db.Person); ok {
-		r0 = rf(r)
+	var r0 []db.WorkspaceFeatures
+	if rf, ok := ret.Get(0).(func(string, *http.Request) []db.WorkspaceFeatures); ok {
+		r0 = rf(uuid, r)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Person)
+			r0 = ret.Get(0).([]db.WorkspaceFeatures)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetListedPeople_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedPeople'
-type Database_GetListedPeople_Call struct {
+// Database_GetFeaturesByWorkspaceUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeaturesByWorkspaceUuid'
+type Database_GetFeaturesByWorkspaceUuid_Call struct {
 	*mock.Call
 }
 
-// GetListedPeople is a helper method to define mock.On call
+// GetFeaturesByWorkspaceUuid is a helper method to define mock.On call
+//   - uuid string
 //   - r *http.Request
-func (_e *Database_Expecter) GetListedPeople(r interface{}) *Database_GetListedPeople_Call {
-	return &Database_GetListedPeople_Call{Call: _e.mock.On("GetListedPeople", r)}
+func (_e *Database_Expecter) GetFeaturesByWorkspaceUuid(uuid interface{}, r interface{}) *Database_GetFeaturesByWorkspaceUuid_Call {
+	return &Database_GetFeaturesByWorkspaceUuid_Call{Call: _e.mock.On("GetFeaturesByWorkspaceUuid", uuid, r)}
 }
 
-func (_c *Database_GetListedPeople_Call) Run(run func(r *http.Request)) *Database_GetListedPeople_Call {
+func (_c *Database_GetFeaturesByWorkspaceUuid_Call) Run(run func(uuid string, r *http.Request)) *Database_GetFeaturesByWorkspaceUuid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(string), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *Database_GetListedPeople_Call) Return(_a0 []db.Person) *Database_GetListedPeople_Call {
+func (_c *Database_GetFeaturesByWorkspaceUuid_Call) Return(_a0 []db.WorkspaceFeatures) *Database_GetFeaturesByWorkspaceUuid_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetListedPeople_Call) RunAndReturn(run func(*http.Request) []db.Person) *Database_GetListedPeople_Call {
+func (_c *Database_GetFeaturesByWorkspaceUuid_Call) RunAndReturn(run func(string, *http.Request) []db.WorkspaceFeatures) *Database_GetFeaturesByWorkspaceUuid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetListedPosts provides a mock function with given fields: r
-func (_m *Database) GetListedPosts(r *http.Request) ([]db.PeopleExtra, error) {
-	ret := _m.Called(r)
+// GetFeaturesCountByWorkspaceUuid provides a mock function with given fields: uuid, r
+func (_m *Database) GetFeaturesCountByWorkspaceUuid(uuid string, r *http.Request) int64 {
+	ret := _m.Called(uuid, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetListedPosts")
-	}
-
-	var r0 []db.PeopleExtra
-	var r1 error
-	if rf, ok := ret.Get(0).(func(*http.Request) ([]db.PeopleExtra, error)); ok {
-		return rf(r)
-	}
-	if rf, ok := ret.Get(0).(func(*http.Request) []db.PeopleExtra); ok {
-		r0 = rf(r)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.PeopleExtra)
-		}
+		panic("no return value specified for GetFeaturesCountByWorkspaceUuid")
 	}
 
-	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
-		r1 = rf(r)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, *http.Request) int64); ok {
+		r0 = rf(uuid, r)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetListedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedPosts'
-type Database_GetListedPosts_Call struct {
+// Database_GetFeaturesCountByWorkspaceUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeaturesCountByWorkspaceUuid'
+type Database_GetFeaturesCountByWorkspaceUuid_Call struct {
 	*mock.Call
 }
 
-// GetListedPosts is a helper method to define mock.On call
+// GetFeaturesCountByWorkspaceUuid is a helper method to define mock.On call
+//   - uuid string
 //   - r *http.Request
-func (_e *Database_Expecter) GetListedPosts(r interface{}) *Database_GetListedPosts_Call {
-	return &Database_GetListedPosts_Call{Call: _e.mock.On("GetListedPosts", r)}
+func (_e *Database_Expecter) GetFeaturesCountByWorkspaceUuid(uuid interface{}, r interface{}) *Database_GetFeaturesCountByWorkspaceUuid_Call {
+	return &Database_GetFeaturesCountByWorkspaceUuid_Call{Call: _e.mock.On("GetFeaturesCountByWorkspaceUuid", uuid, r)}
 }
 
-func (_c *Database_GetListedPosts_Call) Run(run func(r *http.Request)) *Database_GetListedPosts_Call {
+func (_c *Database_GetFeaturesCountByWorkspaceUuid_Call) Run(run func(uuid string, r *http.Request)) *Database_GetFeaturesCountByWorkspaceUuid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(string), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *Database_GetListedPosts_Call) Return(_a0 []db.PeopleExtra, _a1 error) *Database_GetListedPosts_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetFeaturesCountByWorkspaceUuid_Call) Return(_a0 int64) *Database_GetFeaturesCountByWorkspaceUuid_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetListedPosts_Call) RunAndReturn(run func(*http.Request) ([]db.PeopleExtra, error)) *Database_GetListedPosts_Call {
+func (_c *Database_GetFeaturesCountByWorkspaceUuid_Call) RunAndReturn(run func(string, *http.Request) int64) *Database_GetFeaturesCountByWorkspaceUuid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetListedTribes provides a mock function with given fields: r
-func (_m *Database) GetListedTribes(r *http.Request) []db.Tribe {
-	ret := _m.Called(r)
+// GetFeaturesLastUpdated provides a mock function with given fields: uuid, r
+func (_m *Database) GetFeaturesLastUpdated(uuid string, r *http.Request) time.Time {
+	ret := _m.Called(uuid, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetListedTribes")
+		panic("no return value specified for GetFeaturesLastUpdated")
 	}
 
-	var r0 []db.Tribe
-	if rf, ok := ret.Get(0).(func(*http.Request) []db.Tribe); ok {
-		r0 = rf(r)
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(string, *http.Request) time.Time); ok {
+		r0 = rf(uuid, r)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Tribe)
-		}
+		r0 = ret.Get(0).(time.Time)
 	}
 
 	return r0
 }
 
-// Database_GetListedTribes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedTribes'
-type Database_GetListedTribes_Call struct {
+// Database_GetFeaturesLastUpdated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeaturesLastUpdated'
+type Database_GetFeaturesLastUpdated_Call struct {
 	*mock.Call
 }
 
-// GetListedTribes is a helper method to define mock.On call
+// GetFeaturesLastUpdated is a helper method to define mock.On call
+//   - uuid string
 //   - r *http.Request
-func (_e *Database_Expecter) GetListedTribes(r interface{}) *Database_GetListedTribes_Call {
-	return &Database_GetListedTribes_Call{Call: _e.mock.On("GetListedTribes", r)}
+func (_e *Database_Expecter) GetFeaturesLastUpdated(uuid interface{}, r interface{}) *Database_GetFeaturesLastUpdated_Call {
+	return &Database_GetFeaturesLastUpdated_Call{Call: _e.mock.On("GetFeaturesLastUpdated", uuid, r)}
 }
 
-func (_c *Database_GetListedTribes_Call) Run(run func(r *http.Request)) *Database_GetListedTribes_Call {
+func (_c *Database_GetFeaturesLastUpdated_Call) Run(run func(uuid string, r *http.Request)) *Database_GetFeaturesLastUpdated_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(string), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *Database_GetListedTribes_Call) Return(_a0 []db.Tribe) *Database_GetListedTribes_Call {
+func (_c *Database_GetFeaturesLastUpdated_Call) Return(_a0 time.Time) *Database_GetFeaturesLastUpdated_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetListedTribes_Call) RunAndReturn(run func(*http.Request) []db.Tribe) *Database_GetListedTribes_Call {
+func (_c *Database_GetFeaturesLastUpdated_Call) RunAndReturn(run func(string, *http.Request) time.Time) *Database_GetFeaturesLastUpdated_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLnUser provides a mock function with given fields: lnKey
-func (_m *Database) GetLnUser(lnKey string) int64 {
-	ret := _m.Called(lnKey)
+// GetFilterStatusCount provides a mock function with given fields:
+func (_m *Database) GetFilterStatusCount() db.FilterStattuCount {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLnUser")
+		panic("no return value specified for GetFilterStatusCount")
 	}
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
-		r0 = rf(lnKey)
-	} else {
-		r0 = ret.Get(0).(int64)
+	var r0 db.FilterStattuCount
+	if rf, ok := ret.Get(0).(func() db.FilterStattuCount); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(db.FilterStattuCount)
+	}
+
+	return r0
+}
+
+// Database_GetFilterStatusCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFilterStatusCount'
+type Database_GetFilterStatusCount_Call struct {
+	*mock.Call
+}
+
+// GetFilterStatusCount is a helper method to define mock.On call
+func (_e *Database_Expecter) GetFilterStatusCount() *Database_GetFilterStatusCount_Call {
+	return &Database_GetFilterStatusCount_Call{Call: _e.mock.On("GetFilterStatusCount")}
+}
+
+func (_c *Database_GetFilterStatusCount_Call) Run(run func()) *Database_GetFilterStatusCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Database_GetFilterStatusCount_Call) Return(_a0 db.FilterStattuCount) *Database_GetFilterStatusCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetFilterStatusCount_Call) RunAndReturn(run func() db.FilterStattuCount) *Database_GetFilterStatusCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFirstTribeByFeedURL provides a mock function with given fields: feedURL
+func (_m *Database) GetFirstTribeByFeedURL(feedURL string) db.Tribe {
+	ret := _m.Called(feedURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFirstTribeByFeedURL")
+	}
+
+	var r0 db.Tribe
+	if rf, ok := ret.Get(0).(func(string) db.Tribe); ok {
+		r0 = rf(feedURL)
+	} else {
+		r0 = ret.Get(0).(db.Tribe)
+	}
+
+	return r0
+}
+
+// Database_GetFirstTribeByFeedURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFirstTribeByFeedURL'
+type Database_GetFirstTribeByFeedURL_Call struct {
+	*mock.Call
+}
+
+// GetFirstTribeByFeedURL is a helper method to define mock.On call
+//   - feedURL string
+func (_e *Database_Expecter) GetFirstTribeByFeedURL(feedURL interface{}) *Database_GetFirstTribeByFeedURL_Call {
+	return &Database_GetFirstTribeByFeedURL_Call{Call: _e.mock.On("GetFirstTribeByFeedURL", feedURL)}
+}
+
+func (_c *Database_GetFirstTribeByFeedURL_Call) Run(run func(feedURL string)) *Database_GetFirstTribeByFeedURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetFirstTribeByFeedURL_Call) Return(_a0 db.Tribe) *Database_GetFirstTribeByFeedURL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetFirstTribeByFeedURL_Call) RunAndReturn(run func(string) db.Tribe) *Database_GetFirstTribeByFeedURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInvoice provides a mock function with given fields: payment_request
+func (_m *Database) GetInvoice(payment_request string) db.NewInvoiceList {
+	ret := _m.Called(payment_request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvoice")
+	}
+
+	var r0 db.NewInvoiceList
+	if rf, ok := ret.Get(0).(func(string) db.NewInvoiceList); ok {
+		r0 = rf(payment_request)
+	} else {
+		r0 = ret.Get(0).(db.NewInvoiceList)
+	}
+
+	return r0
+}
+
+// Database_GetInvoice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvoice'
+type Database_GetInvoice_Call struct {
+	*mock.Call
+}
+
+// GetInvoice is a helper method to define mock.On call
+//   - payment_request string
+func (_e *Database_Expecter) GetInvoice(payment_request interface{}) *Database_GetInvoice_Call {
+	return &Database_GetInvoice_Call{Call: _e.mock.On("GetInvoice", payment_request)}
+}
+
+func (_c *Database_GetInvoice_Call) Run(run func(payment_request string)) *Database_GetInvoice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetInvoice_Call) Return(_a0 db.NewInvoiceList) *Database_GetInvoice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetInvoice_Call) RunAndReturn(run func(string) db.NewInvoiceList) *Database_GetInvoice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaderBoard provides a mock function with given fields: uuid
+func (_m *Database) GetLeaderBoard(uuid string) []db.LeaderBoard {
+	ret := _m.Called(uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaderBoard")
+	}
+
+	var r0 []db.LeaderBoard
+	if rf, ok := ret.Get(0).(func(string) []db.LeaderBoard); ok {
+		r0 = rf(uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.LeaderBoard)
+		}
+	}
+
+	return r0
+}
+
+// Database_GetLeaderBoard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaderBoard'
+type Database_GetLeaderBoard_Call struct {
+	*mock.Call
+}
+
+// GetLeaderBoard is a helper method to define mock.On call
+//   - uuid string
+func (_e *Database_Expecter) GetLeaderBoard(uuid interface{}) *Database_GetLeaderBoard_Call {
+	return &Database_GetLeaderBoard_Call{Call: _e.mock.On("GetLeaderBoard", uuid)}
+}
+
+func (_c *Database_GetLeaderBoard_Call) Run(run func(uuid string)) *Database_GetLeaderBoard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetLeaderBoard_Call) Return(_a0 []db.LeaderBoard) *Database_GetLeaderBoard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetLeaderBoard_Call) RunAndReturn(run func(string) []db.LeaderBoard) *Database_GetLeaderBoard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaderBoardByUuidAndAlias provides a mock function with given fields: uuid, alias
+func (_m *Database) GetLeaderBoardByUuidAndAlias(uuid string, alias string) db.LeaderBoard {
+	ret := _m.Called(uuid, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaderBoardByUuidAndAlias")
+	}
+
+	var r0 db.LeaderBoard
+	if rf, ok := ret.Get(0).(func(string, string) db.LeaderBoard); ok {
+		r0 = rf(uuid, alias)
+	} else {
+		r0 = ret.Get(0).(db.LeaderBoard)
+	}
+
+	return r0
+}
+
+// Database_GetLeaderBoardByUuidAndAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaderBoardByUuidAndAlias'
+type Database_GetLeaderBoardByUuidAndAlias_Call struct {
+	*mock.Call
+}
+
+// GetLeaderBoardByUuidAndAlias is a helper method to define mock.On call
+//   - uuid string
+//   - alias string
+func (_e *Database_Expecter) GetLeaderBoardByUuidAndAlias(uuid interface{}, alias interface{}) *Database_GetLeaderBoardByUuidAndAlias_Call {
+	return &Database_GetLeaderBoardByUuidAndAlias_Call{Call: _e.mock.On("GetLeaderBoardByUuidAndAlias", uuid, alias)}
+}
+
+func (_c *Database_GetLeaderBoardByUuidAndAlias_Call) Run(run func(uuid string, alias string)) *Database_GetLeaderBoardByUuidAndAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetLeaderBoardByUuidAndAlias_Call) Return(_a0 db.LeaderBoard) *Database_GetLeaderBoardByUuidAndAlias_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetLeaderBoardByUuidAndAlias_Call) RunAndReturn(run func(string, string) db.LeaderBoard) *Database_GetLeaderBoardByUuidAndAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListedBots provides a mock function with given fields: r
+func (_m *Database) GetListedBots(r *http.Request) []db.Bot {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListedBots")
+	}
+
+	var r0 []db.Bot
+	if rf, ok := ret.Get(0).(func(*http.Request) []db.Bot); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Bot)
+		}
+	}
+
+	return r0
+}
+
+// Database_GetListedBots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedBots'
+type Database_GetListedBots_Call struct {
+	*mock.Call
+}
+
+// GetListedBots is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetListedBots(r interface{}) *Database_GetListedBots_Call {
+	return &Database_GetListedBots_Call{Call: _e.mock.On("GetListedBots", r)}
+}
+
+func (_c *Database_GetListedBots_Call) Run(run func(r *http.Request)) *Database_GetListedBots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetListedBots_Call) Return(_a0 []db.Bot) *Database_GetListedBots_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetListedBots_Call) RunAndReturn(run func(*http.Request) []db.Bot) *Database_GetListedBots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListedOffers provides a mock function with given fields: r
+func (_m *Database) GetListedOffers(r *http.Request) ([]db.PeopleExtra, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListedOffers")
+	}
+
+	var r0 []db.PeopleExtra
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Request) ([]db.PeopleExtra, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request) []db.PeopleExtra); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.PeopleExtra)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
+		r1 = rf(r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetListedOffers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedOffers'
+type Database_GetListedOffers_Call struct {
+	*mock.Call
+}
+
+// GetListedOffers is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetListedOffers(r interface{}) *Database_GetListedOffers_Call {
+	return &Database_GetListedOffers_Call{Call: _e.mock.On("GetListedOffers", r)}
+}
+
+func (_c *Database_GetListedOffers_Call) Run(run func(r *http.Request)) *Database_GetListedOffers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetListedOffers_Call) Return(_a0 []db.PeopleExtra, _a1 error) *Database_GetListedOffers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetListedOffers_Call) RunAndReturn(run func(*http.Request) ([]db.PeopleExtra, error)) *Database_GetListedOffers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListedPeople provides a mock function with given fields: r
+func (_m *Database) GetListedPeople(r *http.Request) []db.Person {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListedPeople")
+	}
+
+	var r0 []db.Person
+	if rf, ok := ret.Get(0).(func(*http.Request) []db.Person); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Person)
+		}
+	}
+
+	return r0
+}
+
+// Database_GetListedPeople_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedPeople'
+type Database_GetListedPeople_Call struct {
+	*mock.Call
+}
+
+// GetListedPeople is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetListedPeople(r interface{}) *Database_GetListedPeople_Call {
+	return &Database_GetListedPeople_Call{Call: _e.mock.On("GetListedPeople", r)}
+}
+
+func (_c *Database_GetListedPeople_Call) Run(run func(r *http.Request)) *Database_GetListedPeople_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetListedPeople_Call) Return(_a0 []db.Person) *Database_GetListedPeople_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetListedPeople_Call) RunAndReturn(run func(*http.Request) []db.Person) *Database_GetListedPeople_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListedPosts provides a mock function with given fields: r
+func (_m *Database) GetListedPosts(r *http.Request) ([]db.PeopleExtra, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListedPosts")
+	}
+
+	var r0 []db.PeopleExtra
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Request) ([]db.PeopleExtra, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request) []db.PeopleExtra); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.PeopleExtra)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
+		r1 = rf(r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetListedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedPosts'
+type Database_GetListedPosts_Call struct {
+	*mock.Call
+}
+
+// GetListedPosts is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetListedPosts(r interface{}) *Database_GetListedPosts_Call {
+	return &Database_GetListedPosts_Call{Call: _e.mock.On("GetListedPosts", r)}
+}
+
+func (_c *Database_GetListedPosts_Call) Run(run func(r *http.Request)) *Database_GetListedPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetListedPosts_Call) Return(_a0 []db.PeopleExtra, _a1 error) *Database_GetListedPosts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetListedPosts_Call) RunAndReturn(run func(*http.Request) ([]db.PeopleExtra, error)) *Database_GetListedPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListedTribes provides a mock function with given fields: r
+func (_m *Database) GetListedTribes(r *http.Request) []db.Tribe {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListedTribes")
+	}
+
+	var r0 []db.Tribe
+	if rf, ok := ret.Get(0).(func(*http.Request) []db.Tribe); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Tribe)
+		}
+	}
+
+	return r0
+}
+
+// Database_GetListedTribes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListedTribes'
+type Database_GetListedTribes_Call struct {
+	*mock.Call
+}
+
+// GetListedTribes is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetListedTribes(r interface{}) *Database_GetListedTribes_Call {
+	return &Database_GetListedTribes_Call{Call: _e.mock.On("GetListedTribes", r)}
+}
+
+func (_c *Database_GetListedTribes_Call) Run(run func(r *http.Request)) *Database_GetListedTribes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetListedTribes_Call) Return(_a0 []db.Tribe) *Database_GetListedTribes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetListedTribes_Call) RunAndReturn(run func(*http.Request) []db.Tribe) *Database_GetListedTribes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLnUser provides a mock function with given fields: lnKey
+func (_m *Database) GetLnUser(lnKey string) int64 {
+	ret := _m.Called(lnKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLnUser")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(lnKey)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// Database_GetLnUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLnUser'
+type Database_GetLnUser_Call struct {
+	*mock.Call
+}
+
+// GetLnUser is a helper method to define mock.On call
+//   - lnKey string
+func (_e *Database_Expecter) GetLnUser(lnKey interface{}) *Database_GetLnUser_Call {
+	return &Database_GetLnUser_Call{Call: _e.mock.On("GetLnUser", lnKey)}
+}
+
+func (_c *Database_GetLnUser_Call) Run(run func(lnKey string)) *Database_GetLnUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetLnUser_Call) Return(_a0 int64) *Database_GetLnUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetLnUser_Call) RunAndReturn(run func(string) int64) *Database_GetLnUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextBountyByCreated provides a mock function with given fields: r
+func (_m *Database) GetNextBountyByCreated(r *http.Request) (uint, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextBountyByCreated")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Request) (uint, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request) uint); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
+		r1 = rf(r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetNextBountyByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextBountyByCreated'
+type Database_GetNextBountyByCreated_Call struct {
+	*mock.Call
+}
+
+// GetNextBountyByCreated is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetNextBountyByCreated(r interface{}) *Database_GetNextBountyByCreated_Call {
+	return &Database_GetNextBountyByCreated_Call{Call: _e.mock.On("GetNextBountyByCreated", r)}
+}
+
+func (_c *Database_GetNextBountyByCreated_Call) Run(run func(r *http.Request)) *Database_GetNextBountyByCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetNextBountyByCreated_Call) Return(_a0 uint, _a1 error) *Database_GetNextBountyByCreated_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetNextBountyByCreated_Call) RunAndReturn(run func(*http.Request) (uint, error)) *Database_GetNextBountyByCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextWorkspaceBountyByCreated provides a mock function with given fields: r
+func (_m *Database) GetNextWorkspaceBountyByCreated(r *http.Request) (uint, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextWorkspaceBountyByCreated")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Request) (uint, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request) uint); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
+		r1 = rf(r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetNextWorkspaceBountyByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextWorkspaceBountyByCreated'
+type Database_GetNextWorkspaceBountyByCreated_Call struct {
+	*mock.Call
+}
+
+// GetNextWorkspaceBountyByCreated is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetNextWorkspaceBountyByCreated(r interface{}) *Database_GetNextWorkspaceBountyByCreated_Call {
+	return &Database_GetNextWorkspaceBountyByCreated_Call{Call: _e.mock.On("GetNextWorkspaceBountyByCreated", r)}
+}
+
+func (_c *Database_GetNextWorkspaceBountyByCreated_Call) Run(run func(r *http.Request)) *Database_GetNextWorkspaceBountyByCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetNextWorkspaceBountyByCreated_Call) Return(_a0 uint, _a1 error) *Database_GetNextWorkspaceBountyByCreated_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetNextWorkspaceBountyByCreated_Call) RunAndReturn(run func(*http.Request) (uint, error)) *Database_GetNextWorkspaceBountyByCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOldestOpenBountyPerFeature provides a mock function with given fields: workspaceUuid
+func (_m *Database) GetOldestOpenBountyPerFeature(workspaceUuid string) ([]db.FeatureOldestBounty, error) {
+	ret := _m.Called(workspaceUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOldestOpenBountyPerFeature")
+	}
+
+	var r0 []db.FeatureOldestBounty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]db.FeatureOldestBounty, error)); ok {
+		return rf(workspaceUuid)
+	}
+	if rf, ok := ret.Get(0).(func(string) []db.FeatureOldestBounty); ok {
+		r0 = rf(workspaceUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.FeatureOldestBounty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workspaceUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetOldestOpenBountyPerFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOldestOpenBountyPerFeature'
+type Database_GetOldestOpenBountyPerFeature_Call struct {
+	*mock.Call
+}
+
+// GetOldestOpenBountyPerFeature is a helper method to define mock.On call
+//   - workspaceUuid string
+func (_e *Database_Expecter) GetOldestOpenBountyPerFeature(workspaceUuid interface{}) *Database_GetOldestOpenBountyPerFeature_Call {
+	return &Database_GetOldestOpenBountyPerFeature_Call{Call: _e.mock.On("GetOldestOpenBountyPerFeature", workspaceUuid)}
+}
+
+func (_c *Database_GetOldestOpenBountyPerFeature_Call) Run(run func(workspaceUuid string)) *Database_GetOldestOpenBountyPerFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetOldestOpenBountyPerFeature_Call) Return(_a0 []db.FeatureOldestBounty, _a1 error) *Database_GetOldestOpenBountyPerFeature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetOldestOpenBountyPerFeature_Call) RunAndReturn(run func(string) ([]db.FeatureOldestBounty, error)) *Database_GetOldestOpenBountyPerFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenGithubIssues provides a mock function with given fields: r
+func (_m *Database) GetOpenGithubIssues(r *http.Request) (int64, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenGithubIssues")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Request) (int64, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request) int64); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
+		r1 = rf(r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetOpenGithubIssues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenGithubIssues'
+type Database_GetOpenGithubIssues_Call struct {
+	*mock.Call
+}
+
+// GetOpenGithubIssues is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetOpenGithubIssues(r interface{}) *Database_GetOpenGithubIssues_Call {
+	return &Database_GetOpenGithubIssues_Call{Call: _e.mock.On("GetOpenGithubIssues", r)}
+}
+
+func (_c *Database_GetOpenGithubIssues_Call) Run(run func(r *http.Request)) *Database_GetOpenGithubIssues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetOpenGithubIssues_Call) Return(_a0 int64, _a1 error) *Database_GetOpenGithubIssues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetOpenGithubIssues_Call) RunAndReturn(run func(*http.Request) (int64, error)) *Database_GetOpenGithubIssues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentHistory provides a mock function with given fields: workspace_uuid, r
+func (_m *Database) GetPaymentHistory(workspace_uuid string, r *http.Request) []db.NewPaymentHistory {
+	ret := _m.Called(workspace_uuid, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentHistory")
+	}
+
+	var r0 []db.NewPaymentHistory
+	if rf, ok := ret.Get(0).(func(string, *http.Request) []db.NewPaymentHistory); ok {
+		r0 = rf(workspace_uuid, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.NewPaymentHistory)
+		}
+	}
+
+	return r0
+}
+
+// Database_GetPaymentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentHistory'
+type Database_GetPaymentHistory_Call struct {
+	*mock.Call
+}
+
+// GetPaymentHistory is a helper method to define mock.On call
+//   - workspace_uuid string
+//   - r *http.Request
+func (_e *Database_Expecter) GetPaymentHistory(workspace_uuid interface{}, r interface{}) *Database_GetPaymentHistory_Call {
+	return &Database_GetPaymentHistory_Call{Call: _e.mock.On("GetPaymentHistory", workspace_uuid, r)}
+}
+
+func (_c *Database_GetPaymentHistory_Call) Run(run func(workspace_uuid string, r *http.Request)) *Database_GetPaymentHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetPaymentHistory_Call) Return(_a0 []db.NewPaymentHistory) *Database_GetPaymentHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPaymentHistory_Call) RunAndReturn(run func(string, *http.Request) []db.NewPaymentHistory) *Database_GetPaymentHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentHistoryByCreated provides a mock function with given fields: created, workspace_uuid
+func (_m *Database) GetPaymentHistoryByCreated(created *time.Time, workspace_uuid string) db.NewPaymentHistory {
+	ret := _m.Called(created, workspace_uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentHistoryByCreated")
+	}
+
+	var r0 db.NewPaymentHistory
+	if rf, ok := ret.Get(0).(func(*time.Time, string) db.NewPaymentHistory); ok {
+		r0 = rf(created, workspace_uuid)
+	} else {
+		r0 = ret.Get(0).(db.NewPaymentHistory)
+	}
+
+	return r0
+}
+
+// Database_GetPaymentHistoryByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentHistoryByCreated'
+type Database_GetPaymentHistoryByCreated_Call struct {
+	*mock.Call
+}
+
+// GetPaymentHistoryByCreated is a helper method to define mock.On call
+//   - created *time.Time
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetPaymentHistoryByCreated(created interface{}, workspace_uuid interface{}) *Database_GetPaymentHistoryByCreated_Call {
+	return &Database_GetPaymentHistoryByCreated_Call{Call: _e.mock.On("GetPaymentHistoryByCreated", created, workspace_uuid)}
+}
+
+func (_c *Database_GetPaymentHistoryByCreated_Call) Run(run func(created *time.Time, workspace_uuid string)) *Database_GetPaymentHistoryByCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*time.Time), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetPaymentHistoryByCreated_Call) Return(_a0 db.NewPaymentHistory) *Database_GetPaymentHistoryByCreated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPaymentHistoryByCreated_Call) RunAndReturn(run func(*time.Time, string) db.NewPaymentHistory) *Database_GetPaymentHistoryByCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPeopleBySearch provides a mock function with given fields: r
+func (_m *Database) GetPeopleBySearch(r *http.Request) []db.Person {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeopleBySearch")
+	}
+
+	var r0 []db.Person
+	if rf, ok := ret.Get(0).(func(*http.Request) []db.Person); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Person)
+		}
+	}
+
+	return r0
+}
+
+// Database_GetPeopleBySearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeopleBySearch'
+type Database_GetPeopleBySearch_Call struct {
+	*mock.Call
+}
+
+// GetPeopleBySearch is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetPeopleBySearch(r interface{}) *Database_GetPeopleBySearch_Call {
+	return &Database_GetPeopleBySearch_Call{Call: _e.mock.On("GetPeopleBySearch", r)}
+}
+
+func (_c *Database_GetPeopleBySearch_Call) Run(run func(r *http.Request)) *Database_GetPeopleBySearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetPeopleBySearch_Call) Return(_a0 []db.Person) *Database_GetPeopleBySearch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPeopleBySearch_Call) RunAndReturn(run func(*http.Request) []db.Person) *Database_GetPeopleBySearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPeopleListShort provides a mock function with given fields: count
+func (_m *Database) GetPeopleListShort(count uint32) *[]db.PersonInShort {
+	ret := _m.Called(count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeopleListShort")
+	}
+
+	var r0 *[]db.PersonInShort
+	if rf, ok := ret.Get(0).(func(uint32) *[]db.PersonInShort); ok {
+		r0 = rf(count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]db.PersonInShort)
+		}
+	}
+
+	return r0
+}
+
+// Database_GetPeopleListShort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeopleListShort'
+type Database_GetPeopleListShort_Call struct {
+	*mock.Call
+}
+
+// GetPeopleListShort is a helper method to define mock.On call
+//   - count uint32
+func (_e *Database_Expecter) GetPeopleListShort(count interface{}) *Database_GetPeopleListShort_Call {
+	return &Database_GetPeopleListShort_Call{Call: _e.mock.On("GetPeopleListShort", count)}
+}
+
+func (_c *Database_GetPeopleListShort_Call) Run(run func(count uint32)) *Database_GetPeopleListShort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint32))
+	})
+	return _c
+}
+
+func (_c *Database_GetPeopleListShort_Call) Return(_a0 *[]db.PersonInShort) *Database_GetPeopleListShort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPeopleListShort_Call) RunAndReturn(run func(uint32) *[]db.PersonInShort) *Database_GetPeopleListShort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPerson provides a mock function with given fields: id
+func (_m *Database) GetPerson(id uint) db.Person {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPerson")
+	}
+
+	var r0 db.Person
+	if rf, ok := ret.Get(0).(func(uint) db.Person); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(db.Person)
+	}
+
+	return r0
+}
+
+// Database_GetPerson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPerson'
+type Database_GetPerson_Call struct {
+	*mock.Call
+}
+
+// GetPerson is a helper method to define mock.On call
+//   - id uint
+func (_e *Database_Expecter) GetPerson(id interface{}) *Database_GetPerson_Call {
+	return &Database_GetPerson_Call{Call: _e.mock.On("GetPerson", id)}
+}
+
+func (_c *Database_GetPerson_Call) Run(run func(id uint)) *Database_GetPerson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *Database_GetPerson_Call) Return(_a0 db.Person) *Database_GetPerson_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPerson_Call) RunAndReturn(run func(uint) db.Person) *Database_GetPerson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonActivityCounts provides a mock function with given fields: pubkey, r
+func (_m *Database) GetPersonActivityCounts(pubkey string, r db.PaymentDateRange) db.PersonActivityCounts {
+	ret := _m.Called(pubkey, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonActivityCounts")
+	}
+
+	var r0 db.PersonActivityCounts
+	if rf, ok := ret.Get(0).(func(string, db.PaymentDateRange) db.PersonActivityCounts); ok {
+		r0 = rf(pubkey, r)
+	} else {
+		r0 = ret.Get(0).(db.PersonActivityCounts)
+	}
+
+	return r0
+}
+
+// Database_GetPersonActivityCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonActivityCounts'
+type Database_GetPersonActivityCounts_Call struct {
+	*mock.Call
+}
+
+// GetPersonActivityCounts is a helper method to define mock.On call
+//   - pubkey string
+//   - r db.PaymentDateRange
+func (_e *Database_Expecter) GetPersonActivityCounts(pubkey interface{}, r interface{}) *Database_GetPersonActivityCounts_Call {
+	return &Database_GetPersonActivityCounts_Call{Call: _e.mock.On("GetPersonActivityCounts", pubkey, r)}
+}
+
+func (_c *Database_GetPersonActivityCounts_Call) Run(run func(pubkey string, r db.PaymentDateRange)) *Database_GetPersonActivityCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(db.PaymentDateRange))
+	})
+	return _c
+}
+
+func (_c *Database_GetPersonActivityCounts_Call) Return(_a0 db.PersonActivityCounts) *Database_GetPersonActivityCounts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPersonActivityCounts_Call) RunAndReturn(run func(string, db.PaymentDateRange) db.PersonActivityCounts) *Database_GetPersonActivityCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonBountyStats provides a mock function with given fields: pubkey, workspace
+func (_m *Database) GetPersonBountyStats(pubkey string, workspace string) db.PersonBountyStats {
+	ret := _m.Called(pubkey, workspace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonBountyStats")
+	}
+
+	var r0 db.PersonBountyStats
+	if rf, ok := ret.Get(0).(func(string, string) db.PersonBountyStats); ok {
+		r0 = rf(pubkey, workspace)
+	} else {
+		r0 = ret.Get(0).(db.PersonBountyStats)
+	}
+
+	return r0
+}
+
+// Database_GetPersonBountyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonBountyStats'
+type Database_GetPersonBountyStats_Call struct {
+	*mock.Call
+}
+
+// GetPersonBountyStats is a helper method to define mock.On call
+//   - pubkey string
+//   - workspace string
+func (_e *Database_Expecter) GetPersonBountyStats(pubkey interface{}, workspace interface{}) *Database_GetPersonBountyStats_Call {
+	return &Database_GetPersonBountyStats_Call{Call: _e.mock.On("GetPersonBountyStats", pubkey, workspace)}
+}
+
+func (_c *Database_GetPersonBountyStats_Call) Run(run func(pubkey string, workspace string)) *Database_GetPersonBountyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetPersonBountyStats_Call) Return(_a0 db.PersonBountyStats) *Database_GetPersonBountyStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPersonBountyStats_Call) RunAndReturn(run func(string, string) db.PersonBountyStats) *Database_GetPersonBountyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonByGithubName provides a mock function with given fields: github_name
+func (_m *Database) GetPersonByGithubName(github_name string) db.Person {
+	ret := _m.Called(github_name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonByGithubName")
+	}
+
+	var r0 db.Person
+	if rf, ok := ret.Get(0).(func(string) db.Person); ok {
+		r0 = rf(github_name)
+	} else {
+		r0 = ret.Get(0).(db.Person)
+	}
+
+	return r0
+}
+
+// Database_GetPersonByGithubName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonByGithubName'
+type Database_GetPersonByGithubName_Call struct {
+	*mock.Call
+}
+
+// GetPersonByGithubName is a helper method to define mock.On call
+//   - github_name string
+func (_e *Database_Expecter) GetPersonByGithubName(github_name interface{}) *Database_GetPersonByGithubName_Call {
+	return &Database_GetPersonByGithubName_Call{Call: _e.mock.On("GetPersonByGithubName", github_name)}
+}
+
+func (_c *Database_GetPersonByGithubName_Call) Run(run func(github_name string)) *Database_GetPersonByGithubName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetPersonByGithubName_Call) Return(_a0 db.Person) *Database_GetPersonByGithubName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPersonByGithubName_Call) RunAndReturn(run func(string) db.Person) *Database_GetPersonByGithubName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonByPubkey provides a mock function with given fields: pubkey
+func (_m *Database) GetPersonByPubkey(pubkey string) db.Person {
+	ret := _m.Called(pubkey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonByPubkey")
+	}
+
+	var r0 db.Person
+	if rf, ok := ret.Get(0).(func(string) db.Person); ok {
+		r0 = rf(pubkey)
+	} else {
+		r0 = ret.Get(0).(db.Person)
+	}
+
+	return r0
+}
+
+// Database_GetPersonByPubkey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonByPubkey'
+type Database_GetPersonByPubkey_Call struct {
+	*mock.Call
+}
+
+// GetPersonByPubkey is a helper method to define mock.On call
+//   - pubkey string
+func (_e *Database_Expecter) GetPersonByPubkey(pubkey interface{}) *Database_GetPersonByPubkey_Call {
+	return &Database_GetPersonByPubkey_Call{Call: _e.mock.On("GetPersonByPubkey", pubkey)}
+}
+
+func (_c *Database_GetPersonByPubkey_Call) Run(run func(pubkey string)) *Database_GetPersonByPubkey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetPersonByPubkey_Call) Return(_a0 db.Person) *Database_GetPersonByPubkey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPersonByPubkey_Call) RunAndReturn(run func(string) db.Person) *Database_GetPersonByPubkey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonByUniqueName provides a mock function with given fields: un
+func (_m *Database) GetPersonByUniqueName(un string) db.Person {
+	ret := _m.Called(un)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonByUniqueName")
+	}
+
+	var r0 db.Person
+	if rf, ok := ret.Get(0).(func(string) db.Person); ok {
+		r0 = rf(un)
+	} else {
+		r0 = ret.Get(0).(db.Person)
+	}
+
+	return r0
+}
+
+// Database_GetPersonByUniqueName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonByUniqueName'
+type Database_GetPersonByUniqueName_Call struct {
+	*mock.Call
+}
+
+// GetPersonByUniqueName is a helper method to define mock.On call
+//   - un string
+func (_e *Database_Expecter) GetPersonByUniqueName(un interface{}) *Database_GetPersonByUniqueName_Call {
+	return &Database_GetPersonByUniqueName_Call{Call: _e.mock.On("GetPersonByUniqueName", un)}
+}
+
+func (_c *Database_GetPersonByUniqueName_Call) Run(run func(un string)) *Database_GetPersonByUniqueName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetPersonByUniqueName_Call) Return(_a0 db.Person) *Database_GetPersonByUniqueName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPersonByUniqueName_Call) RunAndReturn(run func(string) db.Person) *Database_GetPersonByUniqueName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonByUuid provides a mock function with given fields: uuid
+func (_m *Database) GetPersonByUuid(uuid string) db.Person {
+	ret := _m.Called(uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonByUuid")
+	}
+
+	var r0 db.Person
+	if rf, ok := ret.Get(0).(func(string) db.Person); ok {
+		r0 = rf(uuid)
+	} else {
+		r0 = ret.Get(0).(db.Person)
+	}
+
+	return r0
+}
+
+// Database_GetPersonByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonByUuid'
+type Database_GetPersonByUuid_Call struct {
+	*mock.Call
+}
+
+// GetPersonByUuid is a helper method to define mock.On call
+//   - uuid string
+func (_e *Database_Expecter) GetPersonByUuid(uuid interface{}) *Database_GetPersonByUuid_Call {
+	return &Database_GetPersonByUuid_Call{Call: _e.mock.On("GetPersonByUuid", uuid)}
+}
+
+func (_c *Database_GetPersonByUuid_Call) Run(run func(uuid string)) *Database_GetPersonByUuid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetPersonByUuid_Call) Return(_a0 db.Person) *Database_GetPersonByUuid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPersonByUuid_Call) RunAndReturn(run func(string) db.Person) *Database_GetPersonByUuid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPhaseByUuid provides a mock function with given fields: phaseUuid
+func (_m *Database) GetPhaseByUuid(phaseUuid string) (db.FeaturePhase, error) {
+	ret := _m.Called(phaseUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPhaseByUuid")
+	}
+
+	var r0 db.FeaturePhase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (db.FeaturePhase, error)); ok {
+		return rf(phaseUuid)
+	}
+	if rf, ok := ret.Get(0).(func(string) db.FeaturePhase); ok {
+		r0 = rf(phaseUuid)
+	} else {
+		r0 = ret.Get(0).(db.FeaturePhase)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(phaseUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetPhaseByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPhaseByUuid'
+type Database_GetPhaseByUuid_Call struct {
+	*mock.Call
+}
+
+// GetPhaseByUuid is a helper method to define mock.On call
+//   - phaseUuid string
+func (_e *Database_Expecter) GetPhaseByUuid(phaseUuid interface{}) *Database_GetPhaseByUuid_Call {
+	return &Database_GetPhaseByUuid_Call{Call: _e.mock.On("GetPhaseByUuid", phaseUuid)}
+}
+
+func (_c *Database_GetPhaseByUuid_Call) Run(run func(phaseUuid string)) *Database_GetPhaseByUuid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetPhaseByUuid_Call) Return(_a0 db.FeaturePhase, _a1 error) *Database_GetPhaseByUuid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetPhaseByUuid_Call) RunAndReturn(run func(string) (db.FeaturePhase, error)) *Database_GetPhaseByUuid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPhasesByFeatureUuid provides a mock function with given fields: featureUuid
+func (_m *Database) GetPhasesByFeatureUuid(featureUuid string) []db.FeaturePhase {
+	ret := _m.Called(featureUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPhasesByFeatureUuid")
+	}
+
+	var r0 []db.FeaturePhase
+	if rf, ok := ret.Get(0).(func(string) []db.FeaturePhase); ok {
+		r0 = rf(featureUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.FeaturePhase)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetLnUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLnUser'
-type Database_GetLnUser_Call struct {
+// Database_GetPhasesByFeatureUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPhasesByFeatureUuid'
+type Database_GetPhasesByFeatureUuid_Call struct {
+	*mock.Call
+}
+
+// GetPhasesByFeatureUuid is a helper method to define mock.On call
+//   - featureUuid string
+func (_e *Database_Expecter) GetPhasesByFeatureUuid(featureUuid interface{}) *Database_GetPhasesByFeatureUuid_Call {
+	return &Database_GetPhasesByFeatureUuid_Call{Call: _e.mock.On("GetPhasesByFeatureUuid", featureUuid)}
+}
+
+func (_c *Database_GetPhasesByFeatureUuid_Call) Run(run func(featureUuid string)) *Database_GetPhasesByFeatureUuid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetPhasesByFeatureUuid_Call) Return(_a0 []db.FeaturePhase) *Database_GetPhasesByFeatureUuid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetPhasesByFeatureUuid_Call) RunAndReturn(run func(string) []db.FeaturePhase) *Database_GetPhasesByFeatureUuid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPreviousBountyByCreated provides a mock function with given fields: r
+func (_m *Database) GetPreviousBountyByCreated(r *http.Request) (uint, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreviousBountyByCreated")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Request) (uint, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request) uint); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
+		r1 = rf(r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetPreviousBountyByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousBountyByCreated'
+type Database_GetPreviousBountyByCreated_Call struct {
+	*mock.Call
+}
+
+// GetPreviousBountyByCreated is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetPreviousBountyByCreated(r interface{}) *Database_GetPreviousBountyByCreated_Call {
+	return &Database_GetPreviousBountyByCreated_Call{Call: _e.mock.On("GetPreviousBountyByCreated", r)}
+}
+
+func (_c *Database_GetPreviousBountyByCreated_Call) Run(run func(r *http.Request)) *Database_GetPreviousBountyByCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetPreviousBountyByCreated_Call) Return(_a0 uint, _a1 error) *Database_GetPreviousBountyByCreated_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetPreviousBountyByCreated_Call) RunAndReturn(run func(*http.Request) (uint, error)) *Database_GetPreviousBountyByCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPreviousWorkspaceBountyByCreated provides a mock function with given fields: r
+func (_m *Database) GetPreviousWorkspaceBountyByCreated(r *http.Request) (uint, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreviousWorkspaceBountyByCreated")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Request) (uint, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request) uint); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
+		r1 = rf(r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_GetPreviousWorkspaceBountyByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousWorkspaceBountyByCreated'
+type Database_GetPreviousWorkspaceBountyByCreated_Call struct {
+	*mock.Call
+}
+
+// GetPreviousWorkspaceBountyByCreated is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *Database_Expecter) GetPreviousWorkspaceBountyByCreated(r interface{}) *Database_GetPreviousWorkspaceBountyByCreated_Call {
+	return &Database_GetPreviousWorkspaceBountyByCreated_Call{Call: _e.mock.On("GetPreviousWorkspaceBountyByCreated", r)}
+}
+
+func (_c *Database_GetPreviousWorkspaceBountyByCreated_Call) Run(run func(r *http.Request)) *Database_GetPreviousWorkspaceBountyByCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Database_GetPreviousWorkspaceBountyByCreated_Call) Return(_a0 uint, _a1 error) *Database_GetPreviousWorkspaceBountyByCreated_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_GetPreviousWorkspaceBountyByCreated_Call) RunAndReturn(run func(*http.Request) (uint, error)) *Database_GetPreviousWorkspaceBountyByCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTribe provides a mock function with given fields: uuid
+func (_m *Database) GetTribe(uuid string) db.Tribe {
+	ret := _m.Called(uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTribe")
+	}
+
+	var r0 db.Tribe
+	if rf, ok := ret.Get(0).(func(string) db.Tribe); ok {
+		r0 = rf(uuid)
+	} else {
+		r0 = ret.Get(0).(db.Tribe)
+	}
+
+	return r0
+}
+
+// Database_GetTribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribe'
+type Database_GetTribe_Call struct {
+	*mock.Call
+}
+
+// GetTribe is a helper method to define mock.On call
+//   - uuid string
+func (_e *Database_Expecter) GetTribe(uuid interface{}) *Database_GetTribe_Call {
+	return &Database_GetTribe_Call{Call: _e.mock.On("GetTribe", uuid)}
+}
+
+func (_c *Database_GetTribe_Call) Run(run func(uuid string)) *Database_GetTribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetTribe_Call) Return(_a0 db.Tribe) *Database_GetTribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetTribe_Call) RunAndReturn(run func(string) db.Tribe) *Database_GetTribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTribeByIdAndPubkey provides a mock function with given fields: uuid, pubkey
+func (_m *Database) GetTribeByIdAndPubkey(uuid string, pubkey string) db.Tribe {
+	ret := _m.Called(uuid, pubkey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTribeByIdAndPubkey")
+	}
+
+	var r0 db.Tribe
+	if rf, ok := ret.Get(0).(func(string, string) db.Tribe); ok {
+		r0 = rf(uuid, pubkey)
+	} else {
+		r0 = ret.Get(0).(db.Tribe)
+	}
+
+	return r0
+}
+
+// Database_GetTribeByIdAndPubkey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribeByIdAndPubkey'
+type Database_GetTribeByIdAndPubkey_Call struct {
+	*mock.Call
+}
+
+// GetTribeByIdAndPubkey is a helper method to define mock.On call
+//   - uuid string
+//   - pubkey string
+func (_e *Database_Expecter) GetTribeByIdAndPubkey(uuid interface{}, pubkey interface{}) *Database_GetTribeByIdAndPubkey_Call {
+	return &Database_GetTribeByIdAndPubkey_Call{Call: _e.mock.On("GetTribeByIdAndPubkey", uuid, pubkey)}
+}
+
+func (_c *Database_GetTribeByIdAndPubkey_Call) Run(run func(uuid string, pubkey string)) *Database_GetTribeByIdAndPubkey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_GetTribeByIdAndPubkey_Call) Return(_a0 db.Tribe) *Database_GetTribeByIdAndPubkey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetTribeByIdAndPubkey_Call) RunAndReturn(run func(string, string) db.Tribe) *Database_GetTribeByIdAndPubkey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTribeByUniqueName provides a mock function with given fields: un
+func (_m *Database) GetTribeByUniqueName(un string) db.Tribe {
+	ret := _m.Called(un)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTribeByUniqueName")
+	}
+
+	var r0 db.Tribe
+	if rf, ok := ret.Get(0).(func(string) db.Tribe); ok {
+		r0 = rf(un)
+	} else {
+		r0 = ret.Get(0).(db.Tribe)
+	}
+
+	return r0
+}
+
+// Database_GetTribeByUniqueName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribeByUniqueName'
+type Database_GetTribeByUniqueName_Call struct {
 	*mock.Call
 }
 
-// GetLnUser is a helper method to define mock.On call
-//   - lnKey string
-func (_e *Database_Expecter) GetLnUser(lnKey interface{}) *Database_GetLnUser_Call {
-	return &Database_GetLnUser_Call{Call: _e.mock.On("GetLnUser", lnKey)}
+// GetTribeByUniqueName is a helper method to define mock.On call
+//   - un string
+func (_e *Database_Expecter) GetTribeByUniqueName(un interface{}) *Database_GetTribeByUniqueName_Call {
+	return &Database_GetTribeByUniqueName_Call{Call: _e.mock.On("GetTribeByUniqueName", un)}
 }
 
-func (_c *Database_GetLnUser_Call) Run(run func(lnKey string)) *Database_GetLnUser_Call {
+func (_c *Database_GetTribeByUniqueName_Call) Run(run func(un string)) *Database_GetTribeByUniqueName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetLnUser_Call) Return(_a0 int64) *Database_GetLnUser_Call {
+func (_c *Database_GetTribeByUniqueName_Call) Return(_a0 db.Tribe) *Database_GetTribeByUniqueName_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetLnUser_Call) RunAndReturn(run func(string) int64) *Database_GetLnUser_Call {
+func (_c *Database_GetTribeByUniqueName_Call) RunAndReturn(run func(string) db.Tribe) *Database_GetTribeByUniqueName_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetNextBountyByCreated provides a mock function with given fields: r
-func (_m *Database) GetNextBountyByCreated(r *http.Request) (uint, error) {
-	ret := _m.Called(r)
+// GetTribesByAppUrl provides a mock function with given fields: aurl
+func (_m *Database) GetTribesByAppUrl(aurl string) []db.Tribe {
+	ret := _m.Called(aurl)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNextBountyByCreated")
-	}
-
-	var r0 uint
-	var r1 error
-	if rf, ok := ret.Get(0).(func(*http.Request) (uint, error)); ok {
-		return rf(r)
-	}
-	if rf, ok := ret.Get(0).(func(*http.Request) uint); ok {
-		r0 = rf(r)
-	} else {
-		r0 = ret.Get(0).(uint)
+		panic("no return value specified for GetTribesByAppUrl")
 	}
 
-	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
-		r1 = rf(r)
+	var r0 []db.Tribe
+	if rf, ok := ret.Get(0).(func(string) []db.Tribe); ok {
+		r0 = rf(aurl)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Tribe)
+		}
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetNextBountyByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextBountyByCreated'
-type Database_GetNextBountyByCreated_Call struct {
+// Database_GetTribesByAppUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribesByAppUrl'
+type Database_GetTribesByAppUrl_Call struct {
 	*mock.Call
 }
 
-// GetNextBountyByCreated is a helper method to define mock.On call
-//   - r *http.Request
-func (_e *Database_Expecter) GetNextBountyByCreated(r interface{}) *Database_GetNextBountyByCreated_Call {
-	return &Database_GetNextBountyByCreated_Call{Call: _e.mock.On("GetNextBountyByCreated", r)}
+// GetTribesByAppUrl is a helper method to define mock.On call
+//   - aurl string
+func (_e *Database_Expecter) GetTribesByAppUrl(aurl interface{}) *Database_GetTribesByAppUrl_Call {
+	return &Database_GetTribesByAppUrl_Call{Call: _e.mock.On("GetTribesByAppUrl", aurl)}
 }
 
-func (_c *Database_GetNextBountyByCreated_Call) Run(run func(r *http.Request)) *Database_GetNextBountyByCreated_Call {
+func (_c *Database_GetTribesByAppUrl_Call) Run(run func(aurl string)) *Database_GetTribesByAppUrl_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetNextBountyByCreated_Call) Return(_a0 uint, _a1 error) *Database_GetNextBountyByCreated_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetTribesByAppUrl_Call) Return(_a0 []db.Tribe) *Database_GetTribesByAppUrl_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetNextBountyByCreated_Call) RunAndReturn(run func(*http.Request) (uint, error)) *Database_GetNextBountyByCreated_Call {
+func (_c *Database_GetTribesByAppUrl_Call) RunAndReturn(run func(string) []db.Tribe) *Database_GetTribesByAppUrl_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetNextWorkspaceBountyByCreated provides a mock function with given fields: r
-func (_m *Database) GetNextWorkspaceBountyByCreated(r *http.Request) (uint, error) {
-	ret := _m.Called(r)
+// GetTribesByOwner provides a mock function with given fields: pubkey
+func (_m *Database) GetTribesByOwner(pubkey string) []db.Tribe {
+	ret := _m.Called(pubkey)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNextWorkspaceBountyByCreated")
-	}
-
-	var r0 uint
-	var r1 error
-	if rf, ok := ret.Get(0).(func(*http.Request) (uint, error)); ok {
-		return rf(r)
-	}
-	if rf, ok := ret.Get(0).(func(*http.Request) uint); ok {
-		r0 = rf(r)
-	} else {
-		r0 = ret.Get(0).(uint)
+		panic("no return value specified for GetTribesByOwner")
 	}
 
-	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
-		r1 = rf(r)
+	var r0 []db.Tribe
+	if rf, ok := ret.Get(0).(func(string) []db.Tribe); ok {
+		r0 = rf(pubkey)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Tribe)
+		}
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetNextWorkspaceBountyByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextWorkspaceBountyByCreated'
-type Database_GetNextWorkspaceBountyByCreated_Call struct {
+// Database_GetTribesByOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribesByOwner'
+type Database_GetTribesByOwner_Call struct {
 	*mock.Call
 }
 
-// GetNextWorkspaceBountyByCreated is a helper method to define mock.On call
-//   - r *http.Request
-func (_e *Database_Expecter) GetNextWorkspaceBountyByCreated(r interface{}) *Database_GetNextWorkspaceBountyByCreated_Call {
-	return &Database_GetNextWorkspaceBountyByCreated_Call{Call: _e.mock.On("GetNextWorkspaceBountyByCreated", r)}
+// GetTribesByOwner is a helper method to define mock.On call
+//   - pubkey string
+func (_e *Database_Expecter) GetTribesByOwner(pubkey interface{}) *Database_GetTribesByOwner_Call {
+	return &Database_GetTribesByOwner_Call{Call: _e.mock.On("GetTribesByOwner", pubkey)}
 }
 
-func (_c *Database_GetNextWorkspaceBountyByCreated_Call) Run(run func(r *http.Request)) *Database_GetNextWorkspaceBountyByCreated_Call {
+func (_c *Database_GetTribesByOwner_Call) Run(run func(pubkey string)) *Database_GetTribesByOwner_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetNextWorkspaceBountyByCreated_Call) Return(_a0 uint, _a1 error) *Database_GetNextWorkspaceBountyByCreated_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetTribesByOwner_Call) Return(_a0 []db.Tribe) *Database_GetTribesByOwner_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetNextWorkspaceBountyByCreated_Call) RunAndReturn(run func(*http.Request) (uint, error)) *Database_GetNextWorkspaceBountyByCreated_Call {
+func (_c *Database_GetTribesByOwner_Call) RunAndReturn(run func(string) []db.Tribe) *Database_GetTribesByOwner_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOpenGithubIssues provides a mock function with given fields: r
-func (_m *Database) GetOpenGithubIssues(r *http.Request) (int64, error) {
-	ret := _m.Called(r)
+// GetTribesTotal provides a mock function with given fields:
+func (_m *Database) GetTribesTotal() int64 {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOpenGithubIssues")
+		panic("no return value specified for GetTribesTotal")
 	}
 
 	var r0 int64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(*http.Request) (int64, error)); ok {
-		return rf(r)
-	}
-	if rf, ok := ret.Get(0).(func(*http.Request) int64); ok {
-		r0 = rf(r)
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
-		r1 = rf(r)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+	return r0
 }
 
-// Database_GetOpenGithubIssues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenGithubIssues'
-type Database_GetOpenGithubIssues_Call struct {
+// Database_GetTribesTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribesTotal'
+type Database_GetTribesTotal_Call struct {
 	*mock.Call
 }
 
-// GetOpenGithubIssues is a helper method to define mock.On call
-//   - r *http.Request
-func (_e *Database_Expecter) GetOpenGithubIssues(r interface{}) *Database_GetOpenGithubIssues_Call {
-	return &Database_GetOpenGithubIssues_Call{Call: _e.mock.On("GetOpenGithubIssues", r)}
+// GetTribesTotal is a helper method to define mock.On call
+func (_e *Database_Expecter) GetTribesTotal() *Database_GetTribesTotal_Call {
+	return &Database_GetTribesTotal_Call{Call: _e.mock.On("GetTribesTotal")}
 }
 
-func (_c *Database_GetOpenGithubIssues_Call) Run(run func(r *http.Request)) *Database_GetOpenGithubIssues_Call {
+func (_c *Database_GetTribesTotal_Call) Run(run func()) *Database_GetTribesTotal_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run()
 	})
 	return _c
 }
 
-func (_c *Database_GetOpenGithubIssues_Call) Return(_a0 int64, _a1 error) *Database_GetOpenGithubIssues_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetTribesTotal_Call) Return(_a0 int64) *Database_GetTribesTotal_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetOpenGithubIssues_Call) RunAndReturn(run func(*http.Request) (int64, error)) *Database_GetOpenGithubIssues_Call {
+func (_c *Database_GetTribesTotal_Call) RunAndReturn(run func() int64) *Database_GetTribesTotal_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPaymentHistory provides a mock function with given fields: workspace_uuid, r
-func (_m *Database) GetPaymentHistory(workspace_uuid string, r *http.Request) []db.NewPaymentHistory {
-	ret := _m.Called(workspace_uuid, r)
+// GetUnconfirmedGithub provides a mock function with given fields:
+func (_m *Database) GetUnconfirmedGithub() []db.Person {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPaymentHistory")
+		panic("no return value specified for GetUnconfirmedGithub")
 	}
 
-	var r0 []db.NewPaymentHistory
-	if rf, ok := ret.Get(0).(func(string, *http.Request) []db.NewPaymentHistory); ok {
-		r0 = rf(workspace_uuid, r)
+	var r0 []db.Person
+	if rf, ok := ret.Get(0).(func() []db.Person); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.NewPaymentHistory)
+			r0 = ret.Get(0).([]db.Person)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetPaymentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentHistory'
-type Database_GetPaymentHistory_Call struct {
+// Database_GetUnconfirmedGithub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnconfirmedGithub'
+type Database_GetUnconfirmedGithub_Call struct {
 	*mock.Call
 }
 
-// GetPaymentHistory is a helper method to define mock.On call
-//   - workspace_uuid string
-//   - r *http.Request
-func (_e *Database_Expecter) GetPaymentHistory(workspace_uuid interface{}, r interface{}) *Database_GetPaymentHistory_Call {
-	return &Database_GetPaymentHistory_Call{Call: _e.mock.On("GetPaymentHistory", workspace_uuid, r)}
+// GetUnconfirmedGithub is a helper method to define mock.On call
+func (_e *Database_Expecter) GetUnconfirmedGithub() *Database_GetUnconfirmedGithub_Call {
+	return &Database_GetUnconfirmedGithub_Call{Call: _e.mock.On("GetUnconfirmedGithub")}
 }
 
-func (_c *Database_GetPaymentHistory_Call) Run(run func(workspace_uuid string, r *http.Request)) *Database_GetPaymentHistory_Call {
+func (_c *Database_GetUnconfirmedGithub_Call) Run(run func()) *Database_GetUnconfirmedGithub_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(*http.Request))
+		run()
 	})
 	return _c
 }
 
-func (_c *Database_GetPaymentHistory_Call) Return(_a0 []db.NewPaymentHistory) *Database_GetPaymentHistory_Call {
+func (_c *Database_GetUnconfirmedGithub_Call) Return(_a0 []db.Person) *Database_GetUnconfirmedGithub_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPaymentHistory_Call) RunAndReturn(run func(string, *http.Request) []db.NewPaymentHistory) *Database_GetPaymentHistory_Call {
+func (_c *Database_GetUnconfirmedGithub_Call) RunAndReturn(run func() []db.Person) *Database_GetUnconfirmedGithub_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPaymentHistoryByCreated provides a mock function with given fields: created, workspace_uuid
-func (_m *Database) GetPaymentHistoryByCreated(created *time.Time, workspace_uuid string) db.NewPaymentHistory {
-	ret := _m.Called(created, workspace_uuid)
+// GetUnconfirmedTwitter provides a mock function with given fields:
+func (_m *Database) GetUnconfirmedTwitter() []db.Person {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPaymentHistoryByCreated")
+		panic("no return value specified for GetUnconfirmedTwitter")
 	}
 
-	var r0 db.NewPaymentHistory
-	if rf, ok := ret.Get(0).(func(*time.Time, string) db.NewPaymentHistory); ok {
-		r0 = rf(created, workspace_uuid)
+	var r0 []db.Person
+	if rf, ok := ret.Get(0).(func() []db.Person); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(db.NewPaymentHistory)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Person)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetPaymentHistoryByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentHistoryByCreated'
-type Database_GetPaymentHistoryByCreated_Call struct {
+// Database_GetUnconfirmedTwitter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnconfirmedTwitter'
+type Database_GetUnconfirmedTwitter_Call struct {
 	*mock.Call
 }
 
-// GetPaymentHistoryByCreated is a helper method to define mock.On call
-//   - created *time.Time
-//   - workspace_uuid string
-func (_e *Database_Expecter) GetPaymentHistoryByCreated(created interface{}, workspace_uuid interface{}) *Database_GetPaymentHistoryByCreated_Call {
-	return &Database_GetPaymentHistoryByCreated_Call{Call: _e.mock.On("GetPaymentHistoryByCreated", created, workspace_uuid)}
+// GetUnconfirmedTwitter is a helper method to define mock.On call
+func (_e *Database_Expecter) GetUnconfirmedTwitter() *Database_GetUnconfirmedTwitter_Call {
+	return &Database_GetUnconfirmedTwitter_Call{Call: _e.mock.On("GetUnconfirmedTwitter")}
 }
 
-func (_c *Database_GetPaymentHistoryByCreated_Call) Run(run func(created *time.Time, workspace_uuid string)) *Database_GetPaymentHistoryByCreated_Call {
+func (_c *Database_GetUnconfirmedTwitter_Call) Run(run func()) *Database_GetUnconfirmedTwitter_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*time.Time), args[1].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *Database_GetPaymentHistoryByCreated_Call) Return(_a0 db.NewPaymentHistory) *Database_GetPaymentHistoryByCreated_Call {
+func (_c *Database_GetUnconfirmedTwitter_Call) Return(_a0 []db.Person) *Database_GetUnconfirmedTwitter_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPaymentHistoryByCreated_Call) RunAndReturn(run func(*time.Time, string) db.NewPaymentHistory) *Database_GetPaymentHistoryByCreated_Call {
+func (_c *Database_GetUnconfirmedTwitter_Call) RunAndReturn(run func() []db.Person) *Database_GetUnconfirmedTwitter_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPeopleBySearch provides a mock function with given fields: r
-func (_m *Database) GetPeopleBySearch(r *http.Request) []db.Person {
-	ret := _m.Called(r)
+// GetUpstreamFeatures provides a mock function with given fields: featureUuid
+func (_m *Database) GetUpstreamFeatures(featureUuid string) []db.WorkspaceFeatures {
+	ret := _m.Called(featureUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPeopleBySearch")
+		panic("no return value specified for GetUpstreamFeatures")
 	}
 
-	var r0 []db.Person
-	if rf, ok := ret.Get(0).(func(*http.Request) []db.Person); ok {
-		r0 = rf(r)
+	var r0 []db.WorkspaceFeatures
+	if rf, ok := ret.Get(0).(func(string) []db.WorkspaceFeatures); ok {
+		r0 = rf(featureUuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Person)
+			r0 = ret.Get(0).([]db.WorkspaceFeatures)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetPeopleBySearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeopleBySearch'
-type Database_GetPeopleBySearch_Call struct {
+// Database_GetUpstreamFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpstreamFeatures'
+type Database_GetUpstreamFeatures_Call struct {
 	*mock.Call
 }
 
-// GetPeopleBySearch is a helper method to define mock.On call
-//   - r *http.Request
-func (_e *Database_Expecter) GetPeopleBySearch(r interface{}) *Database_GetPeopleBySearch_Call {
-	return &Database_GetPeopleBySearch_Call{Call: _e.mock.On("GetPeopleBySearch", r)}
+// GetUpstreamFeatures is a helper method to define mock.On call
+//   - featureUuid string
+func (_e *Database_Expecter) GetUpstreamFeatures(featureUuid interface{}) *Database_GetUpstreamFeatures_Call {
+	return &Database_GetUpstreamFeatures_Call{Call: _e.mock.On("GetUpstreamFeatures", featureUuid)}
 }
 
-func (_c *Database_GetPeopleBySearch_Call) Run(run func(r *http.Request)) *Database_GetPeopleBySearch_Call {
+func (_c *Database_GetUpstreamFeatures_Call) Run(run func(featureUuid string)) *Database_GetUpstreamFeatures_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPeopleBySearch_Call) Return(_a0 []db.Person) *Database_GetPeopleBySearch_Call {
+func (_c *Database_GetUpstreamFeatures_Call) Return(_a0 []db.WorkspaceFeatures) *Database_GetUpstreamFeatures_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPeopleBySearch_Call) RunAndReturn(run func(*http.Request) []db.Person) *Database_GetPeopleBySearch_Call {
+func (_c *Database_GetUpstreamFeatures_Call) RunAndReturn(run func(string) []db.WorkspaceFeatures) *Database_GetUpstreamFeatures_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPeopleListShort provides a mock function with given fields: count
-func (_m *Database) GetPeopleListShort(count uint32) *[]db.PersonInShort {
-	ret := _m.Called(count)
+// GetUserAssignedWorkspaces provides a mock function with given fields: pubkey
+func (_m *Database) GetUserAssignedWorkspaces(pubkey string) []db.WorkspaceUsers {
+	ret := _m.Called(pubkey)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPeopleListShort")
+		panic("no return value specified for GetUserAssignedWorkspaces")
 	}
 
-	var r0 *[]db.PersonInShort
-	if rf, ok := ret.Get(0).(func(uint32) *[]db.PersonInShort); ok {
-		r0 = rf(count)
+	var r0 []db.WorkspaceUsers
+	if rf, ok := ret.Get(0).(func(string) []db.WorkspaceUsers); ok {
+		r0 = rf(pubkey)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*[]db.PersonInShort)
+			r0 = ret.Get(0).([]db.WorkspaceUsers)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetPeopleListShort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeopleListShort'
-type Database_GetPeopleListShort_Call struct {
+// Database_GetUserAssignedWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAssignedWorkspaces'
+type Database_GetUserAssignedWorkspaces_Call struct {
 	*mock.Call
 }
 
-// GetPeopleListShort is a helper method to define mock.On call
-//   - count uint32
-func (_e *Database_Expecter) GetPeopleListShort(count interface{}) *Database_GetPeopleListShort_Call {
-	return &Database_GetPeopleListShort_Call{Call: _e.mock.On("GetPeopleListShort", count)}
+// GetUserAssignedWorkspaces is a helper method to define mock.On call
+//   - pubkey string
+func (_e *Database_Expecter) GetUserAssignedWorkspaces(pubkey interface{}) *Database_GetUserAssignedWorkspaces_Call {
+	return &Database_GetUserAssignedWorkspaces_Call{Call: _e.mock.On("GetUserAssignedWorkspaces", pubkey)}
 }
 
-func (_c *Database_GetPeopleListShort_Call) Run(run func(count uint32)) *Database_GetPeopleListShort_Call {
+func (_c *Database_GetUserAssignedWorkspaces_Call) Run(run func(pubkey string)) *Database_GetUserAssignedWorkspaces_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint32))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPeopleListShort_Call) Return(_a0 *[]db.PersonInShort) *Database_GetPeopleListShort_Call {
+func (_c *Database_GetUserAssignedWorkspaces_Call) Return(_a0 []db.WorkspaceUsers) *Database_GetUserAssignedWorkspaces_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPeopleListShort_Call) RunAndReturn(run func(uint32) *[]db.PersonInShort) *Database_GetPeopleListShort_Call {
+func (_c *Database_GetUserAssignedWorkspaces_Call) RunAndReturn(run func(string) []db.WorkspaceUsers) *Database_GetUserAssignedWorkspaces_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPerson provides a mock function with given fields: id
-func (_m *Database) GetPerson(id uint) db.Person {
-	ret := _m.Called(id)
+// GetUserBountiesCount provides a mock function with given fields: personKey, tabType
+func (_m *Database) GetUserBountiesCount(personKey string, tabType string) int64 {
+	ret := _m.Called(personKey, tabType)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPerson")
+		panic("no return value specified for GetUserBountiesCount")
 	}
 
-	var r0 db.Person
-	if rf, ok := ret.Get(0).(func(uint) db.Person); ok {
-		r0 = rf(id)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, string) int64); ok {
+		r0 = rf(personKey, tabType)
 	} else {
-		r0 = ret.Get(0).(db.Person)
+		r0 = ret.Get(0).(int64)
 	}
 
 	return r0
 }
 
-// Database_GetPerson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPerson'
-type Database_GetPerson_Call struct {
+// Database_GetUserBountiesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserBountiesCount'
+type Database_GetUserBountiesCount_Call struct {
 	*mock.Call
 }
 
-// GetPerson is a helper method to define mock.On call
-//   - id uint
-func (_e *Database_Expecter) GetPerson(id interface{}) *Database_GetPerson_Call {
-	return &Database_GetPerson_Call{Call: _e.mock.On("GetPerson", id)}
+// GetUserBountiesCount is a helper method to define mock.On call
+//   - personKey string
+//   - tabType string
+func (_e *Database_Expecter) GetUserBountiesCount(personKey interface{}, tabType interface{}) *Database_GetUserBountiesCount_Call {
+	return &Database_GetUserBountiesCount_Call{Call: _e.mock.On("GetUserBountiesCount", personKey, tabType)}
 }
 
-func (_c *Database_GetPerson_Call) Run(run func(id uint)) *Database_GetPerson_Call {
+func (_c *Database_GetUserBountiesCount_Call) Run(run func(personKey string, tabType string)) *Database_GetUserBountiesCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPerson_Call) Return(_a0 db.Person) *Database_GetPerson_Call {
+func (_c *Database_GetUserBountiesCount_Call) Return(_a0 int64) *Database_GetUserBountiesCount_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPerson_Call) RunAndReturn(run func(uint) db.Person) *Database_GetPerson_Call {
+func (_c *Database_GetUserBountiesCount_Call) RunAndReturn(run func(string, string) int64) *Database_GetUserBountiesCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPersonByGithubName provides a mock function with given fields: github_name
-func (_m *Database) GetPersonByGithubName(github_name string) db.Person {
-	ret := _m.Called(github_name)
+// GetUserCreatedWorkspaces provides a mock function with given fields: pubkey
+func (_m *Database) GetUserCreatedWorkspaces(pubkey string) []db.Workspace {
+	ret := _m.Called(pubkey)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPersonByGithubName")
+		panic("no return value specified for GetUserCreatedWorkspaces")
 	}
 
-	var r0 db.Person
-	if rf, ok := ret.Get(0).(func(string) db.Person); ok {
-		r0 = rf(github_name)
+	var r0 []db.Workspace
+	if rf, ok := ret.Get(0).(func(string) []db.Workspace); ok {
+		r0 = rf(pubkey)
 	} else {
-		r0 = ret.Get(0).(db.Person)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Workspace)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetPersonByGithubName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonByGithubName'
-type Database_GetPersonByGithubName_Call struct {
+// Database_GetUserCreatedWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCreatedWorkspaces'
+type Database_GetUserCreatedWorkspaces_Call struct {
 	*mock.Call
 }
 
-// GetPersonByGithubName is a helper method to define mock.On call
-//   - github_name string
-func (_e *Database_Expecter) GetPersonByGithubName(github_name interface{}) *Database_GetPersonByGithubName_Call {
-	return &Database_GetPersonByGithubName_Call{Call: _e.mock.On("GetPersonByGithubName", github_name)}
+// GetUserCreatedWorkspaces is a helper method to define mock.On call
+//   - pubkey string
+func (_e *Database_Expecter) GetUserCreatedWorkspaces(pubkey interface{}) *Database_GetUserCreatedWorkspaces_Call {
+	return &Database_GetUserCreatedWorkspaces_Call{Call: _e.mock.On("GetUserCreatedWorkspaces", pubkey)}
 }
 
-func (_c *Database_GetPersonByGithubName_Call) Run(run func(github_name string)) *Database_GetPersonByGithubName_Call {
+func (_c *Database_GetUserCreatedWorkspaces_Call) Run(run func(pubkey string)) *Database_GetUserCreatedWorkspaces_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPersonByGithubName_Call) Return(_a0 db.Person) *Database_GetPersonByGithubName_Call {
+func (_c *Database_GetUserCreatedWorkspaces_Call) Return(_a0 []db.Workspace) *Database_GetUserCreatedWorkspaces_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPersonByGithubName_Call) RunAndReturn(run func(string) db.Person) *Database_GetPersonByGithubName_Call {
+func (_c *Database_GetUserCreatedWorkspaces_Call) RunAndReturn(run func(string) []db.Workspace) *Database_GetUserCreatedWorkspaces_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPersonByPubkey provides a mock function with given fields: pubkey
-func (_m *Database) GetPersonByPubkey(pubkey string) db.Person {
-	ret := _m.Called(pubkey)
+// GetUserInvoiceData provides a mock function with given fields: payment_request
+func (_m *Database) GetUserInvoiceData(payment_request string) db.UserInvoiceData {
+	ret := _m.Called(payment_request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPersonByPubkey")
+		panic("no return value specified for GetUserInvoiceData")
 	}
 
-	var r0 db.Person
-	if rf, ok := ret.Get(0).(func(string) db.Person); ok {
-		r0 = rf(pubkey)
+	var r0 db.UserInvoiceData
+	if rf, ok := ret.Get(0).(func(string) db.UserInvoiceData); ok {
+		r0 = rf(payment_request)
 	} else {
-		r0 = ret.Get(0).(db.Person)
+		r0 = ret.Get(0).(db.UserInvoiceData)
 	}
 
 	return r0
 }
 
-// Database_GetPersonByPubkey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonByPubkey'
-type Database_GetPersonByPubkey_Call struct {
+// Database_GetUserInvoiceData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserInvoiceData'
+type Database_GetUserInvoiceData_Call struct {
 	*mock.Call
 }
 
-// GetPersonByPubkey is a helper method to define mock.On call
-//   - pubkey string
-func (_e *Database_Expecter) GetPersonByPubkey(pubkey interface{}) *Database_GetPersonByPubkey_Call {
-	return &Database_GetPersonByPubkey_Call{Call: _e.mock.On("GetPersonByPubkey", pubkey)}
+// GetUserInvoiceData is a helper method to define mock.On call
+//   - payment_request string
+func (_e *Database_Expecter) GetUserInvoiceData(payment_request interface{}) *Database_GetUserInvoiceData_Call {
+	return &Database_GetUserInvoiceData_Call{Call: _e.mock.On("GetUserInvoiceData", payment_request)}
 }
 
-func (_c *Database_GetPersonByPubkey_Call) Run(run func(pubkey string)) *Database_GetPersonByPubkey_Call {
+func (_c *Database_GetUserInvoiceData_Call) Run(run func(payment_request string)) *Database_GetUserInvoiceData_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPersonByPubkey_Call) Return(_a0 db.Person) *Database_GetPersonByPubkey_Call {
+func (_c *Database_GetUserInvoiceData_Call) Return(_a0 db.UserInvoiceData) *Database_GetUserInvoiceData_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPersonByPubkey_Call) RunAndReturn(run func(string) db.Person) *Database_GetPersonByPubkey_Call {
+func (_c *Database_GetUserInvoiceData_Call) RunAndReturn(run func(string) db.UserInvoiceData) *Database_GetUserInvoiceData_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPersonByUniqueName provides a mock function with given fields: un
-func (_m *Database) GetPersonByUniqueName(un string) db.Person {
-	ret := _m.Called(un)
+// GetUserRecentActivity provides a mock function with given fields: workspaceUuids, limit
+func (_m *Database) GetUserRecentActivity(workspaceUuids []string, limit int) []db.UserActivity {
+	ret := _m.Called(workspaceUuids, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPersonByUniqueName")
+		panic("no return value specified for GetUserRecentActivity")
 	}
 
-	var r0 db.Person
-	if rf, ok := ret.Get(0).(func(string) db.Person); ok {
-		r0 = rf(un)
+	var r0 []db.UserActivity
+	if rf, ok := ret.Get(0).(func([]string, int) []db.UserActivity); ok {
+		r0 = rf(workspaceUuids, limit)
 	} else {
-		r0 = ret.Get(0).(db.Person)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.UserActivity)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetPersonByUniqueName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonByUniqueName'
-type Database_GetPersonByUniqueName_Call struct {
+// Database_GetUserRecentActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRecentActivity'
+type Database_GetUserRecentActivity_Call struct {
 	*mock.Call
 }
 
-// GetPersonByUniqueName is a helper method to define mock.On call
-//   - un string
-func (_e *Database_Expecter) GetPersonByUniqueName(un interface{}) *Database_GetPersonByUniqueName_Call {
-	return &Database_GetPersonByUniqueName_Call{Call: _e.mock.On("GetPersonByUniqueName", un)}
+// GetUserRecentActivity is a helper method to define mock.On call
+//   - workspaceUuids []string
+//   - limit int
+func (_e *Database_Expecter) GetUserRecentActivity(workspaceUuids interface{}, limit interface{}) *Database_GetUserRecentActivity_Call {
+	return &Database_GetUserRecentActivity_Call{Call: _e.mock.On("GetUserRecentActivity", workspaceUuids, limit)}
 }
 
-func (_c *Database_GetPersonByUniqueName_Call) Run(run func(un string)) *Database_GetPersonByUniqueName_Call {
+func (_c *Database_GetUserRecentActivity_Call) Run(run func(workspaceUuids []string, limit int)) *Database_GetUserRecentActivity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].([]string), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *Database_GetPersonByUniqueName_Call) Return(_a0 db.Person) *Database_GetPersonByUniqueName_Call {
+func (_c *Database_GetUserRecentActivity_Call) Return(_a0 []db.UserActivity) *Database_GetUserRecentActivity_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPersonByUniqueName_Call) RunAndReturn(run func(string) db.Person) *Database_GetPersonByUniqueName_Call {
+func (_c *Database_GetUserRecentActivity_Call) RunAndReturn(run func([]string, int) []db.UserActivity) *Database_GetUserRecentActivity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPersonByUuid provides a mock function with given fields: uuid
-func (_m *Database) GetPersonByUuid(uuid string) db.Person {
-	ret := _m.Called(uuid)
+// GetUserRoles provides a mock function with given fields: uuid, pubkey
+func (_m *Database) GetUserRoles(uuid string, pubkey string) []db.WorkspaceUserRoles {
+	ret := _m.Called(uuid, pubkey)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPersonByUuid")
+		panic("no return value specified for GetUserRoles")
 	}
 
-	var r0 db.Person
-	if rf, ok := ret.Get(0).(func(string) db.Person); ok {
-		r0 = rf(uuid)
+	var r0 []db.WorkspaceUserRoles
+	if rf, ok := ret.Get(0).(func(string, string) []db.WorkspaceUserRoles); ok {
+		r0 = rf(uuid, pubkey)
 	} else {
-		r0 = ret.Get(0).(db.Person)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.WorkspaceUserRoles)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetPersonByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonByUuid'
-type Database_GetPersonByUuid_Call struct {
+// Database_GetUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRoles'
+type Database_GetUserRoles_Call struct {
 	*mock.Call
 }
 
-// GetPersonByUuid is a helper method to define mock.On call
+// GetUserRoles is a helper method to define mock.On call
 //   - uuid string
-func (_e *Database_Expecter) GetPersonByUuid(uuid interface{}) *Database_GetPersonByUuid_Call {
-	return &Database_GetPersonByUuid_Call{Call: _e.mock.On("GetPersonByUuid", uuid)}
+//   - pubkey string
+func (_e *Database_Expecter) GetUserRoles(uuid interface{}, pubkey interface{}) *Database_GetUserRoles_Call {
+	return &Database_GetUserRoles_Call{Call: _e.mock.On("GetUserRoles", uuid, pubkey)}
 }
 
-func (_c *Database_GetPersonByUuid_Call) Run(run func(uuid string)) *Database_GetPersonByUuid_Call {
+func (_c *Database_GetUserRoles_Call) Run(run func(uuid string, pubkey string)) *Database_GetUserRoles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPersonByUuid_Call) Return(_a0 db.Person) *Database_GetPersonByUuid_Call {
+func (_c *Database_GetUserRoles_Call) Return(_a0 []db.WorkspaceUserRoles) *Database_GetUserRoles_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPersonByUuid_Call) RunAndReturn(run func(string) db.Person) *Database_GetPersonByUuid_Call {
+func (_c *Database_GetUserRoles_Call) RunAndReturn(run func(string, string) []db.WorkspaceUserRoles) *Database_GetUserRoles_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPhaseByUuid provides a mock function with given fields: phaseUuid
-func (_m *Database) GetPhaseByUuid(phaseUuid string) (db.FeaturePhase, error) {
-	ret := _m.Called(phaseUuid)
+// GetWorkspaceBounties provides a mock function with given fields: r, workspace_uuid
+func (_m *Database) GetWorkspaceBounties(r *http.Request, workspace_uuid string) []db.NewBounty {
+	ret := _m.Called(r, workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPhaseByUuid")
-	}
-
-	var r0 db.FeaturePhase
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (db.FeaturePhase, error)); ok {
-		return rf(phaseUuid)
-	}
-	if rf, ok := ret.Get(0).(func(string) db.FeaturePhase); ok {
-		r0 = rf(phaseUuid)
-	} else {
-		r0 = ret.Get(0).(db.FeaturePhase)
+		panic("no return value specified for GetWorkspaceBounties")
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(phaseUuid)
+	var r0 []db.NewBounty
+	if rf, ok := ret.Get(0).(func(*http.Request, string) []db.NewBounty); ok {
+		r0 = rf(r, workspace_uuid)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.NewBounty)
+		}
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetPhaseByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPhaseByUuid'
-type Database_GetPhaseByUuid_Call struct {
+// Database_GetWorkspaceBounties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBounties'
+type Database_GetWorkspaceBounties_Call struct {
 	*mock.Call
 }
 
-// GetPhaseByUuid is a helper method to define mock.On call
-//   - phaseUuid string
-func (_e *Database_Expecter) GetPhaseByUuid(phaseUuid interface{}) *Database_GetPhaseByUuid_Call {
-	return &Database_GetPhaseByUuid_Call{Call: _e.mock.On("GetPhaseByUuid", phaseUuid)}
+// GetWorkspaceBounties is a helper method to define mock.On call
+//   - r *http.Request
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceBounties(r interface{}, workspace_uuid interface{}) *Database_GetWorkspaceBounties_Call {
+	return &Database_GetWorkspaceBounties_Call{Call: _e.mock.On("GetWorkspaceBounties", r, workspace_uuid)}
 }
 
-func (_c *Database_GetPhaseByUuid_Call) Run(run func(phaseUuid string)) *Database_GetPhaseByUuid_Call {
+func (_c *Database_GetWorkspaceBounties_Call) Run(run func(r *http.Request, workspace_uuid string)) *Database_GetWorkspaceBounties_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(*http.Request), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPhaseByUuid_Call) Return(_a0 db.FeaturePhase, _a1 error) *Database_GetPhaseByUuid_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetWorkspaceBounties_Call) Return(_a0 []db.NewBounty) *Database_GetWorkspaceBounties_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPhaseByUuid_Call) RunAndReturn(run func(string) (db.FeaturePhase, error)) *Database_GetPhaseByUuid_Call {
+func (_c *Database_GetWorkspaceBounties_Call) RunAndReturn(run func(*http.Request, string) []db.NewBounty) *Database_GetWorkspaceBounties_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPhasesByFeatureUuid provides a mock function with given fields: featureUuid
-func (_m *Database) GetPhasesByFeatureUuid(featureUuid string) []db.FeaturePhase {
-	ret := _m.Called(featureUuid)
+// GetWorkspaceBountiesByStatus provides a mock function with given fields: r, workspace_uuid, status
+func (_m *Database) GetWorkspaceBountiesByStatus(r *http.Request, workspace_uuid string, status string) []db.NewBounty {
+	ret := _m.Called(r, workspace_uuid, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPhasesByFeatureUuid")
+		panic("no return value specified for GetWorkspaceBountiesByStatus")
 	}
-
-	var r0 []db.FeaturePhase
-	if rf, ok := ret.Get(0).(func(string) []db.FeaturePhase); ok {
-		r0 = rf(featureUuid)
+
+	var r0 []db.NewBounty
+	if rf, ok := ret.Get(0).(func(*http.Request, string, string) []db.NewBounty); ok {
+		r0 = rf(r, workspace_uuid, status)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.FeaturePhase)
+			r0 = ret.Get(0).([]db.NewBounty)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetPhasesByFeatureUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPhasesByFeatureUuid'
-type Database_GetPhasesByFeatureUuid_Call struct {
+// Database_GetWorkspaceBountiesByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBountiesByStatus'
+type Database_GetWorkspaceBountiesByStatus_Call struct {
 	*mock.Call
 }
 
-// GetPhasesByFeatureUuid is a helper method to define mock.On call
-//   - featureUuid string
-func (_e *Database_Expecter) GetPhasesByFeatureUuid(featureUuid interface{}) *Database_GetPhasesByFeatureUuid_Call {
-	return &Database_GetPhasesByFeatureUuid_Call{Call: _e.mock.On("GetPhasesByFeatureUuid", featureUuid)}
+// GetWorkspaceBountiesByStatus is a helper method to define mock.On call
+//   - r *http.Request
+//   - workspace_uuid string
+//   - status string
+func (_e *Database_Expecter) GetWorkspaceBountiesByStatus(r interface{}, workspace_uuid interface{}, status interface{}) *Database_GetWorkspaceBountiesByStatus_Call {
+	return &Database_GetWorkspaceBountiesByStatus_Call{Call: _e.mock.On("GetWorkspaceBountiesByStatus", r, workspace_uuid, status)}
 }
 
-func (_c *Database_GetPhasesByFeatureUuid_Call) Run(run func(featureUuid string)) *Database_GetPhasesByFeatureUuid_Call {
+func (_c *Database_GetWorkspaceBountiesByStatus_Call) Run(run func(r *http.Request, workspace_uuid string, status string)) *Database_GetWorkspaceBountiesByStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(*http.Request), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPhasesByFeatureUuid_Call) Return(_a0 []db.FeaturePhase) *Database_GetPhasesByFeatureUuid_Call {
+func (_c *Database_GetWorkspaceBountiesByStatus_Call) Return(_a0 []db.NewBounty) *Database_GetWorkspaceBountiesByStatus_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPhasesByFeatureUuid_Call) RunAndReturn(run func(string) []db.FeaturePhase) *Database_GetPhasesByFeatureUuid_Call {
+func (_c *Database_GetWorkspaceBountiesByStatus_Call) RunAndReturn(run func(*http.Request, string, string) []db.NewBounty) *Database_GetWorkspaceBountiesByStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPreviousBountyByCreated provides a mock function with given fields: r
-func (_m *Database) GetPreviousBountyByCreated(r *http.Request) (uint, error) {
-	ret := _m.Called(r)
+// GetWorkspaceBountiesCount provides a mock function with given fields: r, workspace_uuid
+func (_m *Database) GetWorkspaceBountiesCount(r *http.Request, workspace_uuid string) int64 {
+	ret := _m.Called(r, workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPreviousBountyByCreated")
-	}
-
-	var r0 uint
-	var r1 error
-	if rf, ok := ret.Get(0).(func(*http.Request) (uint, error)); ok {
-		return rf(r)
-	}
-	if rf, ok := ret.Get(0).(func(*http.Request) uint); ok {
-		r0 = rf(r)
-	} else {
-		r0 = ret.Get(0).(uint)
+		panic("no return value specified for GetWorkspaceBountiesCount")
 	}
 
-	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
-		r1 = rf(r)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*http.Request, string) int64); ok {
+		r0 = rf(r, workspace_uuid)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetPreviousBountyByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousBountyByCreated'
-type Database_GetPreviousBountyByCreated_Call struct {
+// Database_GetWorkspaceBountiesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBountiesCount'
+type Database_GetWorkspaceBountiesCount_Call struct {
 	*mock.Call
 }
 
-// GetPreviousBountyByCreated is a helper method to define mock.On call
+// GetWorkspaceBountiesCount is a helper method to define mock.On call
 //   - r *http.Request
-func (_e *Database_Expecter) GetPreviousBountyByCreated(r interface{}) *Database_GetPreviousBountyByCreated_Call {
-	return &Database_GetPreviousBountyByCreated_Call{Call: _e.mock.On("GetPreviousBountyByCreated", r)}
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceBountiesCount(r interface{}, workspace_uuid interface{}) *Database_GetWorkspaceBountiesCount_Call {
+	return &Database_GetWorkspaceBountiesCount_Call{Call: _e.mock.On("GetWorkspaceBountiesCount", r, workspace_uuid)}
 }
 
-func (_c *Database_GetPreviousBountyByCreated_Call) Run(run func(r *http.Request)) *Database_GetPreviousBountyByCreated_Call {
+func (_c *Database_GetWorkspaceBountiesCount_Call) Run(run func(r *http.Request, workspace_uuid string)) *Database_GetWorkspaceBountiesCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(*http.Request), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPreviousBountyByCreated_Call) Return(_a0 uint, _a1 error) *Database_GetPreviousBountyByCreated_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetWorkspaceBountiesCount_Call) Return(_a0 int64) *Database_GetWorkspaceBountiesCount_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPreviousBountyByCreated_Call) RunAndReturn(run func(*http.Request) (uint, error)) *Database_GetPreviousBountyByCreated_Call {
+func (_c *Database_GetWorkspaceBountiesCount_Call) RunAndReturn(run func(*http.Request, string) int64) *Database_GetWorkspaceBountiesCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPreviousWorkspaceBountyByCreated provides a mock function with given fields: r
-func (_m *Database) GetPreviousWorkspaceBountyByCreated(r *http.Request) (uint, error) {
-	ret := _m.Called(r)
+// GetWorkspaceBountiesCountByLanguage provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceBountiesCountByLanguage(workspace_uuid string) map[string]int64 {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPreviousWorkspaceBountyByCreated")
-	}
-
-	var r0 uint
-	var r1 error
-	if rf, ok := ret.Get(0).(func(*http.Request) (uint, error)); ok {
-		return rf(r)
-	}
-	if rf, ok := ret.Get(0).(func(*http.Request) uint); ok {
-		r0 = rf(r)
-	} else {
-		r0 = ret.Get(0).(uint)
+		panic("no return value specified for GetWorkspaceBountiesCountByLanguage")
 	}
 
-	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
-		r1 = rf(r)
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func(string) map[string]int64); ok {
+		r0 = rf(workspace_uuid)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetPreviousWorkspaceBountyByCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousWorkspaceBountyByCreated'
-type Database_GetPreviousWorkspaceBountyByCreated_Call struct {
+// Database_GetWorkspaceBountiesCountByLanguage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBountiesCountByLanguage'
+type Database_GetWorkspaceBountiesCountByLanguage_Call struct {
 	*mock.Call
 }
 
-// GetPreviousWorkspaceBountyByCreated is a helper method to define mock.On call
-//   - r *http.Request
-func (_e *Database_Expecter) GetPreviousWorkspaceBountyByCreated(r interface{}) *Database_GetPreviousWorkspaceBountyByCreated_Call {
-	return &Database_GetPreviousWorkspaceBountyByCreated_Call{Call: _e.mock.On("GetPreviousWorkspaceBountyByCreated", r)}
+// GetWorkspaceBountiesCountByLanguage is a helper method to define mock.On call
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceBountiesCountByLanguage(workspace_uuid interface{}) *Database_GetWorkspaceBountiesCountByLanguage_Call {
+	return &Database_GetWorkspaceBountiesCountByLanguage_Call{Call: _e.mock.On("GetWorkspaceBountiesCountByLanguage", workspace_uuid)}
 }
 
-func (_c *Database_GetPreviousWorkspaceBountyByCreated_Call) Run(run func(r *http.Request)) *Database_GetPreviousWorkspaceBountyByCreated_Call {
+func (_c *Database_GetWorkspaceBountiesCountByLanguage_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceBountiesCountByLanguage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetPreviousWorkspaceBountyByCreated_Call) Return(_a0 uint, _a1 error) *Database_GetPreviousWorkspaceBountyByCreated_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetWorkspaceBountiesCountByLanguage_Call) Return(_a0 map[string]int64) *Database_GetWorkspaceBountiesCountByLanguage_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetPreviousWorkspaceBountyByCreated_Call) RunAndReturn(run func(*http.Request) (uint, error)) *Database_GetPreviousWorkspaceBountyByCreated_Call {
+func (_c *Database_GetWorkspaceBountiesCountByLanguage_Call) RunAndReturn(run func(string) map[string]int64) *Database_GetWorkspaceBountiesCountByLanguage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTribe provides a mock function with given fields: uuid
-func (_m *Database) GetTribe(uuid string) db.Tribe {
-	ret := _m.Called(uuid)
+// GetWorkspaceBountiesStatusTotals provides a mock function with given fields: workspace_uuid, status
+func (_m *Database) GetWorkspaceBountiesStatusTotals(workspace_uuid string, status string) (int64, uint) {
+	ret := _m.Called(workspace_uuid, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTribe")
+		panic("no return value specified for GetWorkspaceBountiesStatusTotals")
 	}
 
-	var r0 db.Tribe
-	if rf, ok := ret.Get(0).(func(string) db.Tribe); ok {
-		r0 = rf(uuid)
+	var r0 int64
+	var r1 uint
+	if rf, ok := ret.Get(0).(func(string, string) (int64, uint)); ok {
+		return rf(workspace_uuid, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) int64); ok {
+		r0 = rf(workspace_uuid, status)
 	} else {
-		r0 = ret.Get(0).(db.Tribe)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, string) uint); ok {
+		r1 = rf(workspace_uuid, status)
+	} else {
+		r1 = ret.Get(1).(uint)
+	}
+
+	return r0, r1
 }
 
-// Database_GetTribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribe'
-type Database_GetTribe_Call struct {
+// Database_GetWorkspaceBountiesStatusTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBountiesStatusTotals'
+type Database_GetWorkspaceBountiesStatusTotals_Call struct {
 	*mock.Call
 }
 
-// GetTribe is a helper method to define mock.On call
-//   - uuid string
-func (_e *Database_Expecter) GetTribe(uuid interface{}) *Database_GetTribe_Call {
-	return &Database_GetTribe_Call{Call: _e.mock.On("GetTribe", uuid)}
+// GetWorkspaceBountiesStatusTotals is a helper method to define mock.On call
+//   - workspace_uuid string
+//   - status string
+func (_e *Database_Expecter) GetWorkspaceBountiesStatusTotals(workspace_uuid interface{}, status interface{}) *Database_GetWorkspaceBountiesStatusTotals_Call {
+	return &Database_GetWorkspaceBountiesStatusTotals_Call{Call: _e.mock.On("GetWorkspaceBountiesStatusTotals", workspace_uuid, status)}
 }
 
-func (_c *Database_GetTribe_Call) Run(run func(uuid string)) *Database_GetTribe_Call {
+func (_c *Database_GetWorkspaceBountiesStatusTotals_Call) Run(run func(workspace_uuid string, status string)) *Database_GetWorkspaceBountiesStatusTotals_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetTribe_Call) Return(_a0 db.Tribe) *Database_GetTribe_Call {
-	_c.Call.Return(_a0)
+func (_c *Database_GetWorkspaceBountiesStatusTotals_Call) Return(_a0 int64, _a1 uint) *Database_GetWorkspaceBountiesStatusTotals_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Database_GetTribe_Call) RunAndReturn(run func(string) db.Tribe) *Database_GetTribe_Call {
+func (_c *Database_GetWorkspaceBountiesStatusTotals_Call) RunAndReturn(run func(string, string) (int64, uint)) *Database_GetWorkspaceBountiesStatusTotals_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTribeByIdAndPubkey provides a mock function with given fields: uuid, pubkey
-func (_m *Database) GetTribeByIdAndPubkey(uuid string, pubkey string) db.Tribe {
-	ret := _m.Called(uuid, pubkey)
+// GetWorkspaceBountyAging provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceBountyAging(workspace_uuid string) []db.BountyAgeBucket {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTribeByIdAndPubkey")
+		panic("no return value specified for GetWorkspaceBountyAging")
 	}
 
-	var r0 db.Tribe
-	if rf, ok := ret.Get(0).(func(string, string) db.Tribe); ok {
-		r0 = rf(uuid, pubkey)
+	var r0 []db.BountyAgeBucket
+	if rf, ok := ret.Get(0).(func(string) []db.BountyAgeBucket); ok {
+		r0 = rf(workspace_uuid)
 	} else {
-		r0 = ret.Get(0).(db.Tribe)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.BountyAgeBucket)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetTribeByIdAndPubkey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribeByIdAndPubkey'
-type Database_GetTribeByIdAndPubkey_Call struct {
+// Database_GetWorkspaceBountyAging_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBountyAging'
+type Database_GetWorkspaceBountyAging_Call struct {
 	*mock.Call
 }
 
-// GetTribeByIdAndPubkey is a helper method to define mock.On call
-//   - uuid string
-//   - pubkey string
-func (_e *Database_Expecter) GetTribeByIdAndPubkey(uuid interface{}, pubkey interface{}) *Database_GetTribeByIdAndPubkey_Call {
-	return &Database_GetTribeByIdAndPubkey_Call{Call: _e.mock.On("GetTribeByIdAndPubkey", uuid, pubkey)}
+// GetWorkspaceBountyAging is a helper method to define mock.On call
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceBountyAging(workspace_uuid interface{}) *Database_GetWorkspaceBountyAging_Call {
+	return &Database_GetWorkspaceBountyAging_Call{Call: _e.mock.On("GetWorkspaceBountyAging", workspace_uuid)}
 }
 
-func (_c *Database_GetTribeByIdAndPubkey_Call) Run(run func(uuid string, pubkey string)) *Database_GetTribeByIdAndPubkey_Call {
+func (_c *Database_GetWorkspaceBountyAging_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceBountyAging_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetTribeByIdAndPubkey_Call) Return(_a0 db.Tribe) *Database_GetTribeByIdAndPubkey_Call {
+func (_c *Database_GetWorkspaceBountyAging_Call) Return(_a0 []db.BountyAgeBucket) *Database_GetWorkspaceBountyAging_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetTribeByIdAndPubkey_Call) RunAndReturn(run func(string, string) db.Tribe) *Database_GetTribeByIdAndPubkey_Call {
+func (_c *Database_GetWorkspaceBountyAging_Call) RunAndReturn(run func(string) []db.BountyAgeBucket) *Database_GetWorkspaceBountyAging_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTribeByUniqueName provides a mock function with given fields: un
-func (_m *Database) GetTribeByUniqueName(un string) db.Tribe {
-	ret := _m.Called(un)
+// GetWorkspaceBountyCount provides a mock function with given fields: uuid
+func (_m *Database) GetWorkspaceBountyCount(uuid string) int64 {
+	ret := _m.Called(uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTribeByUniqueName")
+		panic("no return value specified for GetWorkspaceBountyCount")
 	}
 
-	var r0 db.Tribe
-	if rf, ok := ret.Get(0).(func(string) db.Tribe); ok {
-		r0 = rf(un)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(uuid)
 	} else {
-		r0 = ret.Get(0).(db.Tribe)
+		r0 = ret.Get(0).(int64)
 	}
 
 	return r0
 }
 
-// Database_GetTribeByUniqueName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribeByUniqueName'
-type Database_GetTribeByUniqueName_Call struct {
+// Database_GetWorkspaceBountyCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBountyCount'
+type Database_GetWorkspaceBountyCount_Call struct {
 	*mock.Call
 }
 
-// GetTribeByUniqueName is a helper method to define mock.On call
-//   - un string
-func (_e *Database_Expecter) GetTribeByUniqueName(un interface{}) *Database_GetTribeByUniqueName_Call {
-	return &Database_GetTribeByUniqueName_Call{Call: _e.mock.On("GetTribeByUniqueName", un)}
+// GetWorkspaceBountyCount is a helper method to define mock.On call
+//   - uuid string
+func (_e *Database_Expecter) GetWorkspaceBountyCount(uuid interface{}) *Database_GetWorkspaceBountyCount_Call {
+	return &Database_GetWorkspaceBountyCount_Call{Call: _e.mock.On("GetWorkspaceBountyCount", uuid)}
 }
 
-func (_c *Database_GetTribeByUniqueName_Call) Run(run func(un string)) *Database_GetTribeByUniqueName_Call {
+func (_c *Database_GetWorkspaceBountyCount_Call) Run(run func(uuid string)) *Database_GetWorkspaceBountyCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetTribeByUniqueName_Call) Return(_a0 db.Tribe) *Database_GetTribeByUniqueName_Call {
+func (_c *Database_GetWorkspaceBountyCount_Call) Return(_a0 int64) *Database_GetWorkspaceBountyCount_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetTribeByUniqueName_Call) RunAndReturn(run func(string) db.Tribe) *Database_GetTribeByUniqueName_Call {
+func (_c *Database_GetWorkspaceBountyCount_Call) RunAndReturn(run func(string) int64) *Database_GetWorkspaceBountyCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTribesByAppUrl provides a mock function with given fields: aurl
-func (_m *Database) GetTribesByAppUrl(aurl string) []db.Tribe {
-	ret := _m.Called(aurl)
+// GetWorkspaceBudget provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceBudget(workspace_uuid string) db.NewBountyBudget {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTribesByAppUrl")
+		panic("no return value specified for GetWorkspaceBudget")
 	}
 
-	var r0 []db.Tribe
-	if rf, ok := ret.Get(0).(func(string) []db.Tribe); ok {
-		r0 = rf(aurl)
+	var r0 db.NewBountyBudget
+	if rf, ok := ret.Get(0).(func(string) db.NewBountyBudget); ok {
+		r0 = rf(workspace_uuid)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Tribe)
-		}
+		r0 = ret.Get(0).(db.NewBountyBudget)
 	}
 
 	return r0
 }
 
-// Database_GetTribesByAppUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribesByAppUrl'
-type Database_GetTribesByAppUrl_Call struct {
+// Database_GetWorkspaceBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBudget'
+type Database_GetWorkspaceBudget_Call struct {
 	*mock.Call
 }
 
-// GetTribesByAppUrl is a helper method to define mock.On call
-//   - aurl string
-func (_e *Database_Expecter) GetTribesByAppUrl(aurl interface{}) *Database_GetTribesByAppUrl_Call {
-	return &Database_GetTribesByAppUrl_Call{Call: _e.mock.On("GetTribesByAppUrl", aurl)}
+// GetWorkspaceBudget is a helper method to define mock.On call
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceBudget(workspace_uuid interface{}) *Database_GetWorkspaceBudget_Call {
+	return &Database_GetWorkspaceBudget_Call{Call: _e.mock.On("GetWorkspaceBudget", workspace_uuid)}
 }
 
-func (_c *Database_GetTribesByAppUrl_Call) Run(run func(aurl string)) *Database_GetTribesByAppUrl_Call {
+func (_c *Database_GetWorkspaceBudget_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceBudget_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetTribesByAppUrl_Call) Return(_a0 []db.Tribe) *Database_GetTribesByAppUrl_Call {
+func (_c *Database_GetWorkspaceBudget_Call) Return(_a0 db.NewBountyBudget) *Database_GetWorkspaceBudget_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetTribesByAppUrl_Call) RunAndReturn(run func(string) []db.Tribe) *Database_GetTribesByAppUrl_Call {
+func (_c *Database_GetWorkspaceBudget_Call) RunAndReturn(run func(string) db.NewBountyBudget) *Database_GetWorkspaceBudget_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTribesByOwner provides a mock function with given fields: pubkey
-func (_m *Database) GetTribesByOwner(pubkey string) []db.Tribe {
-	ret := _m.Called(pubkey)
+// GetWorkspaceBudgetByFeature provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceBudgetByFeature(workspace_uuid string) []db.FeatureBudget {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTribesByOwner")
+		panic("no return value specified for GetWorkspaceBudgetByFeature")
 	}
 
-	var r0 []db.Tribe
-	if rf, ok := ret.Get(0).(func(string) []db.Tribe); ok {
-		r0 = rf(pubkey)
+	var r0 []db.FeatureBudget
+	if rf, ok := ret.Get(0).(func(string) []db.FeatureBudget); ok {
+		r0 = rf(workspace_uuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Tribe)
+			r0 = ret.Get(0).([]db.FeatureBudget)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetTribesByOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribesByOwner'
-type Database_GetTribesByOwner_Call struct {
+// Database_GetWorkspaceBudgetByFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBudgetByFeature'
+type Database_GetWorkspaceBudgetByFeature_Call struct {
 	*mock.Call
 }
 
-// GetTribesByOwner is a helper method to define mock.On call
-//   - pubkey string
-func (_e *Database_Expecter) GetTribesByOwner(pubkey interface{}) *Database_GetTribesByOwner_Call {
-	return &Database_GetTribesByOwner_Call{Call: _e.mock.On("GetTribesByOwner", pubkey)}
+// GetWorkspaceBudgetByFeature is a helper method to define mock.On call
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceBudgetByFeature(workspace_uuid interface{}) *Database_GetWorkspaceBudgetByFeature_Call {
+	return &Database_GetWorkspaceBudgetByFeature_Call{Call: _e.mock.On("GetWorkspaceBudgetByFeature", workspace_uuid)}
 }
 
-func (_c *Database_GetTribesByOwner_Call) Run(run func(pubkey string)) *Database_GetTribesByOwner_Call {
+func (_c *Database_GetWorkspaceBudgetByFeature_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceBudgetByFeature_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetTribesByOwner_Call) Return(_a0 []db.Tribe) *Database_GetTribesByOwner_Call {
+func (_c *Database_GetWorkspaceBudgetByFeature_Call) Return(_a0 []db.FeatureBudget) *Database_GetWorkspaceBudgetByFeature_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetTribesByOwner_Call) RunAndReturn(run func(string) []db.Tribe) *Database_GetTribesByOwner_Call {
+func (_c *Database_GetWorkspaceBudgetByFeature_Call) RunAndReturn(run func(string) []db.FeatureBudget) *Database_GetWorkspaceBudgetByFeature_Call {
 	_c.Call.Return(run)
 	return _c
-}
-
-// GetTribesTotal provides a mock function with given fields:
-func (_m *Database) GetTribesTotal() int64 {
-	ret := _m.Called()
+}
+
+// GetWorkspaceBudgetHistory provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceBudgetHistory(workspace_uuid string) []db.BudgetHistoryData {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTribesTotal")
+		panic("no return value specified for GetWorkspaceBudgetHistory")
 	}
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	var r0 []db.BudgetHistoryData
+	if rf, ok := ret.Get(0).(func(string) []db.BudgetHistoryData); ok {
+		r0 = rf(workspace_uuid)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.BudgetHistoryData)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetTribesTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTribesTotal'
-type Database_GetTribesTotal_Call struct {
+// Database_GetWorkspaceBudgetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBudgetHistory'
+type Database_GetWorkspaceBudgetHistory_Call struct {
 	*mock.Call
 }
 
-// GetTribesTotal is a helper method to define mock.On call
-func (_e *Database_Expecter) GetTribesTotal() *Database_GetTribesTotal_Call {
-	return &Database_GetTribesTotal_Call{Call: _e.mock.On("GetTribesTotal")}
+// GetWorkspaceBudgetHistory is a helper method to define mock.On call
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceBudgetHistory(workspace_uuid interface{}) *Database_GetWorkspaceBudgetHistory_Call {
+	return &Database_GetWorkspaceBudgetHistory_Call{Call: _e.mock.On("GetWorkspaceBudgetHistory", workspace_uuid)}
 }
 
-func (_c *Database_GetTribesTotal_Call) Run(run func()) *Database_GetTribesTotal_Call {
+func (_c *Database_GetWorkspaceBudgetHistory_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceBudgetHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetTribesTotal_Call) Return(_a0 int64) *Database_GetTribesTotal_Call {
+func (_c *Database_GetWorkspaceBudgetHistory_Call) Return(_a0 []db.BudgetHistoryData) *Database_GetWorkspaceBudgetHistory_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetTribesTotal_Call) RunAndReturn(run func() int64) *Database_GetTribesTotal_Call {
+func (_c *Database_GetWorkspaceBudgetHistory_Call) RunAndReturn(run func(string) []db.BudgetHistoryData) *Database_GetWorkspaceBudgetHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUnconfirmedGithub provides a mock function with given fields:
-func (_m *Database) GetUnconfirmedGithub() []db.Person {
-	ret := _m.Called()
+// GetWorkspaceByName provides a mock function with given fields: name
+func (_m *Database) GetWorkspaceByName(name string) db.Workspace {
+	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUnconfirmedGithub")
+		panic("no return value specified for GetWorkspaceByName")
 	}
 
-	var r0 []db.Person
-	if rf, ok := ret.Get(0).(func() []db.Person); ok {
-		r0 = rf()
+	var r0 db.Workspace
+	if rf, ok := ret.Get(0).(func(string) db.Workspace); ok {
+		r0 = rf(name)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Person)
-		}
+		r0 = ret.Get(0).(db.Workspace)
 	}
 
 	return r0
 }
 
-// Database_GetUnconfirmedGithub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnconfirmedGithub'
-type Database_GetUnconfirmedGithub_Call struct {
+// Database_GetWorkspaceByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceByName'
+type Database_GetWorkspaceByName_Call struct {
 	*mock.Call
 }
 
-// GetUnconfirmedGithub is a helper method to define mock.On call
-func (_e *Database_Expecter) GetUnconfirmedGithub() *Database_GetUnconfirmedGithub_Call {
-	return &Database_GetUnconfirmedGithub_Call{Call: _e.mock.On("GetUnconfirmedGithub")}
+// GetWorkspaceByName is a helper method to define mock.On call
+//   - name string
+func (_e *Database_Expecter) GetWorkspaceByName(name interface{}) *Database_GetWorkspaceByName_Call {
+	return &Database_GetWorkspaceByName_Call{Call: _e.mock.On("GetWorkspaceByName", name)}
 }
 
-func (_c *Database_GetUnconfirmedGithub_Call) Run(run func()) *Database_GetUnconfirmedGithub_Call {
+func (_c *Database_GetWorkspaceByName_Call) Run(run func(name string)) *Database_GetWorkspaceByName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetUnconfirmedGithub_Call) Return(_a0 []db.Person) *Database_GetUnconfirmedGithub_Call {
+func (_c *Database_GetWorkspaceByName_Call) Return(_a0 db.Workspace) *Database_GetWorkspaceByName_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetUnconfirmedGithub_Call) RunAndReturn(run func() []db.Person) *Database_GetUnconfirmedGithub_Call {
+func (_c *Database_GetWorkspaceByName_Call) RunAndReturn(run func(string) db.Workspace) *Database_GetWorkspaceByName_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUnconfirmedTwitter provides a mock function with given fields:
-func (_m *Database) GetUnconfirmedTwitter() []db.Person {
-	ret := _m.Called()
+// GetWorkspaceByUuid provides a mock function with given fields: uuid
+func (_m *Database) GetWorkspaceByUuid(uuid string) db.Workspace {
+	ret := _m.Called(uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUnconfirmedTwitter")
+		panic("no return value specified for GetWorkspaceByUuid")
 	}
 
-	var r0 []db.Person
-	if rf, ok := ret.Get(0).(func() []db.Person); ok {
-		r0 = rf()
+	var r0 db.Workspace
+	if rf, ok := ret.Get(0).(func(string) db.Workspace); ok {
+		r0 = rf(uuid)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Person)
-		}
+		r0 = ret.Get(0).(db.Workspace)
 	}
 
 	return r0
 }
 
-// Database_GetUnconfirmedTwitter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnconfirmedTwitter'
-type Database_GetUnconfirmedTwitter_Call struct {
+// Database_GetWorkspaceByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceByUuid'
+type Database_GetWorkspaceByUuid_Call struct {
 	*mock.Call
 }
 
-// GetUnconfirmedTwitter is a helper method to define mock.On call
-func (_e *Database_Expecter) GetUnconfirmedTwitter() *Database_GetUnconfirmedTwitter_Call {
-	return &Database_GetUnconfirmedTwitter_Call{Call: _e.mock.On("GetUnconfirmedTwitter")}
+// GetWorkspaceByUuid is a helper method to define mock.On call
+//   - uuid string
+func (_e *Database_Expecter) GetWorkspaceByUuid(uuid interface{}) *Database_GetWorkspaceByUuid_Call {
+	return &Database_GetWorkspaceByUuid_Call{Call: _e.mock.On("GetWorkspaceByUuid", uuid)}
 }
 
-func (_c *Database_GetUnconfirmedTwitter_Call) Run(run func()) *Database_GetUnconfirmedTwitter_Call {
+func (_c *Database_GetWorkspaceByUuid_Call) Run(run func(uuid string)) *Database_GetWorkspaceByUuid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetUnconfirmedTwitter_Call) Return(_a0 []db.Person) *Database_GetUnconfirmedTwitter_Call {
+func (_c *Database_GetWorkspaceByUuid_Call) Return(_a0 db.Workspace) *Database_GetWorkspaceByUuid_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetUnconfirmedTwitter_Call) RunAndReturn(run func() []db.Person) *Database_GetUnconfirmedTwitter_Call {
+func (_c *Database_GetWorkspaceByUuid_Call) RunAndReturn(run func(string) db.Workspace) *Database_GetWorkspaceByUuid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserAssignedWorkspaces provides a mock function with given fields: pubkey
-func (_m *Database) GetUserAssignedWorkspaces(pubkey string) []db.WorkspaceUsers {
-	ret := _m.Called(pubkey)
+// GetWorkspaceDeletionPreview provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceDeletionPreview(workspace_uuid string) db.WorkspaceDeletionPreview {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserAssignedWorkspaces")
+		panic("no return value specified for GetWorkspaceDeletionPreview")
 	}
 
-	var r0 []db.WorkspaceUsers
-	if rf, ok := ret.Get(0).(func(string) []db.WorkspaceUsers); ok {
-		r0 = rf(pubkey)
+	var r0 db.WorkspaceDeletionPreview
+	if rf, ok := ret.Get(0).(func(string) db.WorkspaceDeletionPreview); ok {
+		r0 = rf(workspace_uuid)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.WorkspaceUsers)
-		}
+		r0 = ret.Get(0).(db.WorkspaceDeletionPreview)
 	}
 
 	return r0
 }
 
-// Database_GetUserAssignedWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAssignedWorkspaces'
-type Database_GetUserAssignedWorkspaces_Call struct {
+// Database_GetWorkspaceDeletionPreview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceDeletionPreview'
+type Database_GetWorkspaceDeletionPreview_Call struct {
 	*mock.Call
 }
 
-// GetUserAssignedWorkspaces is a helper method to define mock.On call
-//   - pubkey string
-func (_e *Database_Expecter) GetUserAssignedWorkspaces(pubkey interface{}) *Database_GetUserAssignedWorkspaces_Call {
-	return &Database_GetUserAssignedWorkspaces_Call{Call: _e.mock.On("GetUserAssignedWorkspaces", pubkey)}
+// GetWorkspaceDeletionPreview is a helper method to define mock.On call
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceDeletionPreview(workspace_uuid interface{}) *Database_GetWorkspaceDeletionPreview_Call {
+	return &Database_GetWorkspaceDeletionPreview_Call{Call: _e.mock.On("GetWorkspaceDeletionPreview", workspace_uuid)}
 }
 
-func (_c *Database_GetUserAssignedWorkspaces_Call) Run(run func(pubkey string)) *Database_GetUserAssignedWorkspaces_Call {
+func (_c *Database_GetWorkspaceDeletionPreview_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceDeletionPreview_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetUserAssignedWorkspaces_Call) Return(_a0 []db.WorkspaceUsers) *Database_GetUserAssignedWorkspaces_Call {
+func (_c *Database_GetWorkspaceDeletionPreview_Call) Return(_a0 db.WorkspaceDeletionPreview) *Database_GetWorkspaceDeletionPreview_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetUserAssignedWorkspaces_Call) RunAndReturn(run func(string) []db.WorkspaceUsers) *Database_GetUserAssignedWorkspaces_Call {
+func (_c *Database_GetWorkspaceDeletionPreview_Call) RunAndReturn(run func(string) db.WorkspaceDeletionPreview) *Database_GetWorkspaceDeletionPreview_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserBountiesCount provides a mock function with given fields: personKey, tabType
-func (_m *Database) GetUserBountiesCount(personKey string, tabType string) int64 {
-	ret := _m.Called(personKey, tabType)
+// GetWorkspaceFeatureStatuses provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceFeatureStatuses(workspace_uuid string) []db.WorkspaceFeatureStatus {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserBountiesCount")
+		panic("no return value specified for GetWorkspaceFeatureStatuses")
 	}
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string, string) int64); ok {
-		r0 = rf(personKey, tabType)
+	var r0 []db.WorkspaceFeatureStatus
+	if rf, ok := ret.Get(0).(func(string) []db.WorkspaceFeatureStatus); ok {
+		r0 = rf(workspace_uuid)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.WorkspaceFeatureStatus)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetUserBountiesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserBountiesCount'
-type Database_GetUserBountiesCount_Call struct {
+// Database_GetWorkspaceFeatureStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceFeatureStatuses'
+type Database_GetWorkspaceFeatureStatuses_Call struct {
 	*mock.Call
 }
 
-// GetUserBountiesCount is a helper method to define mock.On call
-//   - personKey string
-//   - tabType string
-func (_e *Database_Expecter) GetUserBountiesCount(personKey interface{}, tabType interface{}) *Database_GetUserBountiesCount_Call {
-	return &Database_GetUserBountiesCount_Call{Call: _e.mock.On("GetUserBountiesCount", personKey, tabType)}
+// GetWorkspaceFeatureStatuses is a helper method to define mock.On call
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceFeatureStatuses(workspace_uuid interface{}) *Database_GetWorkspaceFeatureStatuses_Call {
+	return &Database_GetWorkspaceFeatureStatuses_Call{Call: _e.mock.On("GetWorkspaceFeatureStatuses", workspace_uuid)}
 }
 
-func (_c *Database_GetUserBountiesCount_Call) Run(run func(personKey string, tabType string)) *Database_GetUserBountiesCount_Call {
+func (_c *Database_GetWorkspaceFeatureStatuses_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceFeatureStatuses_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetUserBountiesCount_Call) Return(_a0 int64) *Database_GetUserBountiesCount_Call {
+func (_c *Database_GetWorkspaceFeatureStatuses_Call) Return(_a0 []db.WorkspaceFeatureStatus) *Database_GetWorkspaceFeatureStatuses_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetUserBountiesCount_Call) RunAndReturn(run func(string, string) int64) *Database_GetUserBountiesCount_Call {
+func (_c *Database_GetWorkspaceFeatureStatuses_Call) RunAndReturn(run func(string) []db.WorkspaceFeatureStatus) *Database_GetWorkspaceFeatureStatuses_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserCreatedWorkspaces provides a mock function with given fields: pubkey
-func (_m *Database) GetUserCreatedWorkspaces(pubkey string) []db.Workspace {
-	ret := _m.Called(pubkey)
+// GetWorkspaceFeaturesCount provides a mock function with given fields: uuid
+func (_m *Database) GetWorkspaceFeaturesCount(uuid string) int64 {
+	ret := _m.Called(uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserCreatedWorkspaces")
+		panic("no return value specified for GetWorkspaceFeaturesCount")
 	}
 
-	var r0 []db.Workspace
-	if rf, ok := ret.Get(0).(func(string) []db.Workspace); ok {
-		r0 = rf(pubkey)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(uuid)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Workspace)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
 	return r0
 }
 
-// Database_GetUserCreatedWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCreatedWorkspaces'
-type Database_GetUserCreatedWorkspaces_Call struct {
+// Database_GetWorkspaceFeaturesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceFeaturesCount'
+type Database_GetWorkspaceFeaturesCount_Call struct {
 	*mock.Call
 }
 
-// GetUserCreatedWorkspaces is a helper method to define mock.On call
-//   - pubkey string
-func (_e *Database_Expecter) GetUserCreatedWorkspaces(pubkey interface{}) *Database_GetUserCreatedWorkspaces_Call {
-	return &Database_GetUserCreatedWorkspaces_Call{Call: _e.mock.On("GetUserCreatedWorkspaces", pubkey)}
+// GetWorkspaceFeaturesCount is a helper method to define mock.On call
+//   - uuid string
+func (_e *Database_Expecter) GetWorkspaceFeaturesCount(uuid interface{}) *Database_GetWorkspaceFeaturesCount_Call {
+	return &Database_GetWorkspaceFeaturesCount_Call{Call: _e.mock.On("GetWorkspaceFeaturesCount", uuid)}
 }
 
-func (_c *Database_GetUserCreatedWorkspaces_Call) Run(run func(pubkey string)) *Database_GetUserCreatedWorkspaces_Call {
+func (_c *Database_GetWorkspaceFeaturesCount_Call) Run(run func(uuid string)) *Database_GetWorkspaceFeaturesCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetUserCreatedWorkspaces_Call) Return(_a0 []db.Workspace) *Database_GetUserCreatedWorkspaces_Call {
+func (_c *Database_GetWorkspaceFeaturesCount_Call) Return(_a0 int64) *Database_GetWorkspaceFeaturesCount_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetUserCreatedWorkspaces_Call) RunAndReturn(run func(string) []db.Workspace) *Database_GetUserCreatedWorkspaces_Call {
+func (_c *Database_GetWorkspaceFeaturesCount_Call) RunAndReturn(run func(string) int64) *Database_GetWorkspaceFeaturesCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserInvoiceData provides a mock function with given fields: payment_request
-func (_m *Database) GetUserInvoiceData(payment_request string) db.UserInvoiceData {
-	ret := _m.Called(payment_request)
+// GetWorkspaceInviteByToken provides a mock function with given fields: token
+func (_m *Database) GetWorkspaceInviteByToken(token string) db.WorkspaceInvite {
+	ret := _m.Called(token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserInvoiceData")
+		panic("no return value specified for GetWorkspaceInviteByToken")
 	}
 
-	var r0 db.UserInvoiceData
-	if rf, ok := ret.Get(0).(func(string) db.UserInvoiceData); ok {
-		r0 = rf(payment_request)
+	var r0 db.WorkspaceInvite
+	if rf, ok := ret.Get(0).(func(string) db.WorkspaceInvite); ok {
+		r0 = rf(token)
 	} else {
-		r0 = ret.Get(0).(db.UserInvoiceData)
+		r0 = ret.Get(0).(db.WorkspaceInvite)
 	}
 
 	return r0
 }
 
-// Database_GetUserInvoiceData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserInvoiceData'
-type Database_GetUserInvoiceData_Call struct {
+// Database_GetWorkspaceInviteByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceInviteByToken'
+type Database_GetWorkspaceInviteByToken_Call struct {
 	*mock.Call
 }
 
-// GetUserInvoiceData is a helper method to define mock.On call
-//   - payment_request string
-func (_e *Database_Expecter) GetUserInvoiceData(payment_request interface{}) *Database_GetUserInvoiceData_Call {
-	return &Database_GetUserInvoiceData_Call{Call: _e.mock.On("GetUserInvoiceData", payment_request)}
+// GetWorkspaceInviteByToken is a helper method to define mock.On call
+//   - token string
+func (_e *Database_Expecter) GetWorkspaceInviteByToken(token interface{}) *Database_GetWorkspaceInviteByToken_Call {
+	return &Database_GetWorkspaceInviteByToken_Call{Call: _e.mock.On("GetWorkspaceInviteByToken", token)}
 }
 
-func (_c *Database_GetUserInvoiceData_Call) Run(run func(payment_request string)) *Database_GetUserInvoiceData_Call {
+func (_c *Database_GetWorkspaceInviteByToken_Call) Run(run func(token string)) *Database_GetWorkspaceInviteByToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetUserInvoiceData_Call) Return(_a0 db.UserInvoiceData) *Database_GetUserInvoiceData_Call {
+func (_c *Database_GetWorkspaceInviteByToken_Call) Return(_a0 db.WorkspaceInvite) *Database_GetWorkspaceInviteByToken_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetUserInvoiceData_Call) RunAndReturn(run func(string) db.UserInvoiceData) *Database_GetUserInvoiceData_Call {
+func (_c *Database_GetWorkspaceInviteByToken_Call) RunAndReturn(run func(string) db.WorkspaceInvite) *Database_GetWorkspaceInviteByToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceBounties provides a mock function with given fields: r, workspace_uuid
-func (_m *Database) GetWorkspaceBounties(r *http.Request, workspace_uuid string) []db.NewBounty {
-	ret := _m.Called(r, workspace_uuid)
+// GetWorkspaceInvoices provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceInvoices(workspace_uuid string) []db.NewInvoiceList {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceBounties")
+		panic("no return value specified for GetWorkspaceInvoices")
 	}
 
-	var r0 []db.NewBounty
-	if rf, ok := ret.Get(0).(func(*http.Request, string) []db.NewBounty); ok {
-		r0 = rf(r, workspace_uuid)
+	var r0 []db.NewInvoiceList
+	if rf, ok := ret.Get(0).(func(string) []db.NewInvoiceList); ok {
+		r0 = rf(workspace_uuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.NewBounty)
+			r0 = ret.Get(0).([]db.NewInvoiceList)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetWorkspaceBounties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBounties'
-type Database_GetWorkspaceBounties_Call struct {
+// Database_GetWorkspaceInvoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceInvoices'
+type Database_GetWorkspaceInvoices_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceBounties is a helper method to define mock.On call
-//   - r *http.Request
+// GetWorkspaceInvoices is a helper method to define mock.On call
 //   - workspace_uuid string
-func (_e *Database_Expecter) GetWorkspaceBounties(r interface{}, workspace_uuid interface{}) *Database_GetWorkspaceBounties_Call {
-	return &Database_GetWorkspaceBounties_Call{Call: _e.mock.On("GetWorkspaceBounties", r, workspace_uuid)}
+func (_e *Database_Expecter) GetWorkspaceInvoices(workspace_uuid interface{}) *Database_GetWorkspaceInvoices_Call {
+	return &Database_GetWorkspaceInvoices_Call{Call: _e.mock.On("GetWorkspaceInvoices", workspace_uuid)}
 }
 
-func (_c *Database_GetWorkspaceBounties_Call) Run(run func(r *http.Request, workspace_uuid string)) *Database_GetWorkspaceBounties_Call {
+func (_c *Database_GetWorkspaceInvoices_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceInvoices_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request), args[1].(string))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBounties_Call) Return(_a0 []db.NewBounty) *Database_GetWorkspaceBounties_Call {
+func (_c *Database_GetWorkspaceInvoices_Call) Return(_a0 []db.NewInvoiceList) *Database_GetWorkspaceInvoices_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBounties_Call) RunAndReturn(run func(*http.Request, string) []db.NewBounty) *Database_GetWorkspaceBounties_Call {
+func (_c *Database_GetWorkspaceInvoices_Call) RunAndReturn(run func(string) []db.NewInvoiceList) *Database_GetWorkspaceInvoices_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceBountiesCount provides a mock function with given fields: r, workspace_uuid
-func (_m *Database) GetWorkspaceBountiesCount(r *http.Request, workspace_uuid string) int64 {
-	ret := _m.Called(r, workspace_uuid)
+// GetWorkspaceInvoicesCount provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceInvoicesCount(workspace_uuid string) int64 {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceBountiesCount")
+		panic("no return value specified for GetWorkspaceInvoicesCount")
 	}
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(*http.Request, string) int64); ok {
-		r0 = rf(r, workspace_uuid)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(workspace_uuid)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
@@ -5424,554 +7891,574 @@ func (_m *Database) GetWorkspaceBountiesCount(r *http.Request, workspace_uuid st
 	return r0
 }
 
-// Database_GetWorkspaceBountiesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBountiesCount'
-type Database_GetWorkspaceBountiesCount_Call struct {
+// Database_GetWorkspaceInvoicesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceInvoicesCount'
+type Database_GetWorkspaceInvoicesCount_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceBountiesCount is a helper method to define mock.On call
-//   - r *http.Request
+// GetWorkspaceInvoicesCount is a helper method to define mock.On call
 //   - workspace_uuid string
-func (_e *Database_Expecter) GetWorkspaceBountiesCount(r interface{}, workspace_uuid interface{}) *Database_GetWorkspaceBountiesCount_Call {
-	return &Database_GetWorkspaceBountiesCount_Call{Call: _e.mock.On("GetWorkspaceBountiesCount", r, workspace_uuid)}
+func (_e *Database_Expecter) GetWorkspaceInvoicesCount(workspace_uuid interface{}) *Database_GetWorkspaceInvoicesCount_Call {
+	return &Database_GetWorkspaceInvoicesCount_Call{Call: _e.mock.On("GetWorkspaceInvoicesCount", workspace_uuid)}
 }
 
-func (_c *Database_GetWorkspaceBountiesCount_Call) Run(run func(r *http.Request, workspace_uuid string)) *Database_GetWorkspaceBountiesCount_Call {
+func (_c *Database_GetWorkspaceInvoicesCount_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceInvoicesCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*http.Request), args[1].(string))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBountiesCount_Call) Return(_a0 int64) *Database_GetWorkspaceBountiesCount_Call {
+func (_c *Database_GetWorkspaceInvoicesCount_Call) Return(_a0 int64) *Database_GetWorkspaceInvoicesCount_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBountiesCount_Call) RunAndReturn(run func(*http.Request, string) int64) *Database_GetWorkspaceBountiesCount_Call {
+func (_c *Database_GetWorkspaceInvoicesCount_Call) RunAndReturn(run func(string) int64) *Database_GetWorkspaceInvoicesCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceBountyCount provides a mock function with given fields: uuid
-func (_m *Database) GetWorkspaceBountyCount(uuid string) int64 {
-	ret := _m.Called(uuid)
+// GetWorkspacePendingBounties provides a mock function with given fields: r, workspace_uuid
+func (_m *Database) GetWorkspacePendingBounties(r *http.Request, workspace_uuid string) []db.NewBounty {
+	ret := _m.Called(r, workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceBountyCount")
+		panic("no return value specified for GetWorkspacePendingBounties")
 	}
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
-		r0 = rf(uuid)
+	var r0 []db.NewBounty
+	if rf, ok := ret.Get(0).(func(*http.Request, string) []db.NewBounty); ok {
+		r0 = rf(r, workspace_uuid)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.NewBounty)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetWorkspaceBountyCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBountyCount'
-type Database_GetWorkspaceBountyCount_Call struct {
+// Database_GetWorkspacePendingBounties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspacePendingBounties'
+type Database_GetWorkspacePendingBounties_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceBountyCount is a helper method to define mock.On call
-//   - uuid string
-func (_e *Database_Expecter) GetWorkspaceBountyCount(uuid interface{}) *Database_GetWorkspaceBountyCount_Call {
-	return &Database_GetWorkspaceBountyCount_Call{Call: _e.mock.On("GetWorkspaceBountyCount", uuid)}
+// GetWorkspacePendingBounties is a helper method to define mock.On call
+//   - r *http.Request
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspacePendingBounties(r interface{}, workspace_uuid interface{}) *Database_GetWorkspacePendingBounties_Call {
+	return &Database_GetWorkspacePendingBounties_Call{Call: _e.mock.On("GetWorkspacePendingBounties", r, workspace_uuid)}
 }
 
-func (_c *Database_GetWorkspaceBountyCount_Call) Run(run func(uuid string)) *Database_GetWorkspaceBountyCount_Call {
+func (_c *Database_GetWorkspacePendingBounties_Call) Run(run func(r *http.Request, workspace_uuid string)) *Database_GetWorkspacePendingBounties_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(*http.Request), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBountyCount_Call) Return(_a0 int64) *Database_GetWorkspaceBountyCount_Call {
+func (_c *Database_GetWorkspacePendingBounties_Call) Return(_a0 []db.NewBounty) *Database_GetWorkspacePendingBounties_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBountyCount_Call) RunAndReturn(run func(string) int64) *Database_GetWorkspaceBountyCount_Call {
+func (_c *Database_GetWorkspacePendingBounties_Call) RunAndReturn(run func(*http.Request, string) []db.NewBounty) *Database_GetWorkspacePendingBounties_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceBudget provides a mock function with given fields: workspace_uuid
-func (_m *Database) GetWorkspaceBudget(workspace_uuid string) db.NewBountyBudget {
+// GetWorkspacePendingBountiesCount provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspacePendingBountiesCount(workspace_uuid string) int64 {
 	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceBudget")
+		panic("no return value specified for GetWorkspacePendingBountiesCount")
 	}
 
-	var r0 db.NewBountyBudget
-	if rf, ok := ret.Get(0).(func(string) db.NewBountyBudget); ok {
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
 		r0 = rf(workspace_uuid)
 	} else {
-		r0 = ret.Get(0).(db.NewBountyBudget)
+		r0 = ret.Get(0).(int64)
 	}
 
 	return r0
 }
 
-// Database_GetWorkspaceBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBudget'
-type Database_GetWorkspaceBudget_Call struct {
+// Database_GetWorkspacePendingBountiesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspacePendingBountiesCount'
+type Database_GetWorkspacePendingBountiesCount_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceBudget is a helper method to define mock.On call
+// GetWorkspacePendingBountiesCount is a helper method to define mock.On call
 //   - workspace_uuid string
-func (_e *Database_Expecter) GetWorkspaceBudget(workspace_uuid interface{}) *Database_GetWorkspaceBudget_Call {
-	return &Database_GetWorkspaceBudget_Call{Call: _e.mock.On("GetWorkspaceBudget", workspace_uuid)}
+func (_e *Database_Expecter) GetWorkspacePendingBountiesCount(workspace_uuid interface{}) *Database_GetWorkspacePendingBountiesCount_Call {
+	return &Database_GetWorkspacePendingBountiesCount_Call{Call: _e.mock.On("GetWorkspacePendingBountiesCount", workspace_uuid)}
 }
 
-func (_c *Database_GetWorkspaceBudget_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceBudget_Call {
+func (_c *Database_GetWorkspacePendingBountiesCount_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspacePendingBountiesCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBudget_Call) Return(_a0 db.NewBountyBudget) *Database_GetWorkspaceBudget_Call {
+func (_c *Database_GetWorkspacePendingBountiesCount_Call) Return(_a0 int64) *Database_GetWorkspacePendingBountiesCount_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBudget_Call) RunAndReturn(run func(string) db.NewBountyBudget) *Database_GetWorkspaceBudget_Call {
+func (_c *Database_GetWorkspacePendingBountiesCount_Call) RunAndReturn(run func(string) int64) *Database_GetWorkspacePendingBountiesCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceBudgetHistory provides a mock function with given fields: workspace_uuid
-func (_m *Database) GetWorkspaceBudgetHistory(workspace_uuid string) []db.BudgetHistoryData {
+// GetWorkspacePendingPayments provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspacePendingPayments(workspace_uuid string) []db.PendingPayment {
 	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceBudgetHistory")
+		panic("no return value specified for GetWorkspacePendingPayments")
 	}
 
-	var r0 []db.BudgetHistoryData
-	if rf, ok := ret.Get(0).(func(string) []db.BudgetHistoryData); ok {
+	var r0 []db.PendingPayment
+	if rf, ok := ret.Get(0).(func(string) []db.PendingPayment); ok {
 		r0 = rf(workspace_uuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.BudgetHistoryData)
+			r0 = ret.Get(0).([]db.PendingPayment)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetWorkspaceBudgetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceBudgetHistory'
-type Database_GetWorkspaceBudgetHistory_Call struct {
+// Database_GetWorkspacePendingPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspacePendingPayments'
+type Database_GetWorkspacePendingPayments_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceBudgetHistory is a helper method to define mock.On call
+// GetWorkspacePendingPayments is a helper method to define mock.On call
 //   - workspace_uuid string
-func (_e *Database_Expecter) GetWorkspaceBudgetHistory(workspace_uuid interface{}) *Database_GetWorkspaceBudgetHistory_Call {
-	return &Database_GetWorkspaceBudgetHistory_Call{Call: _e.mock.On("GetWorkspaceBudgetHistory", workspace_uuid)}
+func (_e *Database_Expecter) GetWorkspacePendingPayments(workspace_uuid interface{}) *Database_GetWorkspacePendingPayments_Call {
+	return &Database_GetWorkspacePendingPayments_Call{Call: _e.mock.On("GetWorkspacePendingPayments", workspace_uuid)}
 }
 
-func (_c *Database_GetWorkspaceBudgetHistory_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceBudgetHistory_Call {
+func (_c *Database_GetWorkspacePendingPayments_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspacePendingPayments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBudgetHistory_Call) Return(_a0 []db.BudgetHistoryData) *Database_GetWorkspaceBudgetHistory_Call {
+func (_c *Database_GetWorkspacePendingPayments_Call) Return(_a0 []db.PendingPayment) *Database_GetWorkspacePendingPayments_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceBudgetHistory_Call) RunAndReturn(run func(string) []db.BudgetHistoryData) *Database_GetWorkspaceBudgetHistory_Call {
+func (_c *Database_GetWorkspacePendingPayments_Call) RunAndReturn(run func(string) []db.PendingPayment) *Database_GetWorkspacePendingPayments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceByName provides a mock function with given fields: name
-func (_m *Database) GetWorkspaceByName(name string) db.Workspace {
-	ret := _m.Called(name)
+// GetWorkspacePhases provides a mock function with given fields: workspaceUuid, r
+func (_m *Database) GetWorkspacePhases(workspaceUuid string, r *http.Request) []db.WorkspacePhase {
+	ret := _m.Called(workspaceUuid, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceByName")
+		panic("no return value specified for GetWorkspacePhases")
 	}
 
-	var r0 db.Workspace
-	if rf, ok := ret.Get(0).(func(string) db.Workspace); ok {
-		r0 = rf(name)
+	var r0 []db.WorkspacePhase
+	if rf, ok := ret.Get(0).(func(string, *http.Request) []db.WorkspacePhase); ok {
+		r0 = rf(workspaceUuid, r)
 	} else {
-		r0 = ret.Get(0).(db.Workspace)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.WorkspacePhase)
+		}
 	}
 
 	return r0
 }
 
-// Database_GetWorkspaceByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceByName'
-type Database_GetWorkspaceByName_Call struct {
+// Database_GetWorkspacePhases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspacePhases'
+type Database_GetWorkspacePhases_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceByName is a helper method to define mock.On call
-//   - name string
-func (_e *Database_Expecter) GetWorkspaceByName(name interface{}) *Database_GetWorkspaceByName_Call {
-	return &Database_GetWorkspaceByName_Call{Call: _e.mock.On("GetWorkspaceByName", name)}
+// GetWorkspacePhases is a helper method to define mock.On call
+//   - workspaceUuid string
+//   - r *http.Request
+func (_e *Database_Expecter) GetWorkspacePhases(workspaceUuid interface{}, r interface{}) *Database_GetWorkspacePhases_Call {
+	return &Database_GetWorkspacePhases_Call{Call: _e.mock.On("GetWorkspacePhases", workspaceUuid, r)}
 }
 
-func (_c *Database_GetWorkspaceByName_Call) Run(run func(name string)) *Database_GetWorkspaceByName_Call {
+func (_c *Database_GetWorkspacePhases_Call) Run(run func(workspaceUuid string, r *http.Request)) *Database_GetWorkspacePhases_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceByName_Call) Return(_a0 db.Workspace) *Database_GetWorkspaceByName_Call {
+func (_c *Database_GetWorkspacePhases_Call) Return(_a0 []db.WorkspacePhase) *Database_GetWorkspacePhases_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceByName_Call) RunAndReturn(run func(string) db.Workspace) *Database_GetWorkspaceByName_Call {
+func (_c *Database_GetWorkspacePhases_Call) RunAndReturn(run func(string, *http.Request) []db.WorkspacePhase) *Database_GetWorkspacePhases_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceByUuid provides a mock function with given fields: uuid
-func (_m *Database) GetWorkspaceByUuid(uuid string) db.Workspace {
-	ret := _m.Called(uuid)
+// GetWorkspacePhasesCount provides a mock function with given fields: workspaceUuid
+func (_m *Database) GetWorkspacePhasesCount(workspaceUuid string) int64 {
+	ret := _m.Called(workspaceUuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceByUuid")
+		panic("no return value specified for GetWorkspacePhasesCount")
 	}
 
-	var r0 db.Workspace
-	if rf, ok := ret.Get(0).(func(string) db.Workspace); ok {
-		r0 = rf(uuid)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(workspaceUuid)
 	} else {
-		r0 = ret.Get(0).(db.Workspace)
+		r0 = ret.Get(0).(int64)
 	}
 
 	return r0
 }
 
-// Database_GetWorkspaceByUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceByUuid'
-type Database_GetWorkspaceByUuid_Call struct {
+// Database_GetWorkspacePhasesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspacePhasesCount'
+type Database_GetWorkspacePhasesCount_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceByUuid is a helper method to define mock.On call
-//   - uuid string
-func (_e *Database_Expecter) GetWorkspaceByUuid(uuid interface{}) *Database_GetWorkspaceByUuid_Call {
-	return &Database_GetWorkspaceByUuid_Call{Call: _e.mock.On("GetWorkspaceByUuid", uuid)}
+// GetWorkspacePhasesCount is a helper method to define mock.On call
+//   - workspaceUuid string
+func (_e *Database_Expecter) GetWorkspacePhasesCount(workspaceUuid interface{}) *Database_GetWorkspacePhasesCount_Call {
+	return &Database_GetWorkspacePhasesCount_Call{Call: _e.mock.On("GetWorkspacePhasesCount", workspaceUuid)}
 }
 
-func (_c *Database_GetWorkspaceByUuid_Call) Run(run func(uuid string)) *Database_GetWorkspaceByUuid_Call {
+func (_c *Database_GetWorkspacePhasesCount_Call) Run(run func(workspaceUuid string)) *Database_GetWorkspacePhasesCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceByUuid_Call) Return(_a0 db.Workspace) *Database_GetWorkspaceByUuid_Call {
+func (_c *Database_GetWorkspacePhasesCount_Call) Return(_a0 int64) *Database_GetWorkspacePhasesCount_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceByUuid_Call) RunAndReturn(run func(string) db.Workspace) *Database_GetWorkspaceByUuid_Call {
+func (_c *Database_GetWorkspacePhasesCount_Call) RunAndReturn(run func(string) int64) *Database_GetWorkspacePhasesCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceFeaturesCount provides a mock function with given fields: uuid
-func (_m *Database) GetWorkspaceFeaturesCount(uuid string) int64 {
-	ret := _m.Called(uuid)
+// GetWorkspaceRepoByWorkspaceUuidAndRepoUuid provides a mock function with given fields: workspace_uuid, uuid
+func (_m *Database) GetWorkspaceRepoByWorkspaceUuidAndRepoUuid(workspace_uuid string, uuid string) (db.WorkspaceRepositories, error) {
+	ret := _m.Called(workspace_uuid, uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceFeaturesCount")
+		panic("no return value specified for GetWorkspaceRepoByWorkspaceUuidAndRepoUuid")
 	}
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
-		r0 = rf(uuid)
+	var r0 db.WorkspaceRepositories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (db.WorkspaceRepositories, error)); ok {
+		return rf(workspace_uuid, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) db.WorkspaceRepositories); ok {
+		r0 = rf(workspace_uuid, uuid)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(db.WorkspaceRepositories)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(workspace_uuid, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Database_GetWorkspaceFeaturesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceFeaturesCount'
-type Database_GetWorkspaceFeaturesCount_Call struct {
+// Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceRepoByWorkspaceUuidAndRepoUuid'
+type Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceFeaturesCount is a helper method to define mock.On call
+// GetWorkspaceRepoByWorkspaceUuidAndRepoUuid is a helper method to define mock.On call
+//   - workspace_uuid string
 //   - uuid string
-func (_e *Database_Expecter) GetWorkspaceFeaturesCount(uuid interface{}) *Database_GetWorkspaceFeaturesCount_Call {
-	return &Database_GetWorkspaceFeaturesCount_Call{Call: _e.mock.On("GetWorkspaceFeaturesCount", uuid)}
+func (_e *Database_Expecter) GetWorkspaceRepoByWorkspaceUuidAndRepoUuid(workspace_uuid interface{}, uuid interface{}) *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call {
+	return &Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call{Call: _e.mock.On("GetWorkspaceRepoByWorkspaceUuidAndRepoUuid", workspace_uuid, uuid)}
 }
 
-func (_c *Database_GetWorkspaceFeaturesCount_Call) Run(run func(uuid string)) *Database_GetWorkspaceFeaturesCount_Call {
+func (_c *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call) Run(run func(workspace_uuid string, uuid string)) *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceFeaturesCount_Call) Return(_a0 int64) *Database_GetWorkspaceFeaturesCount_Call {
-	_c.Call.Return(_a0)
+func (_c *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call) Return(_a0 db.WorkspaceRepositories, _a1 error) *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceFeaturesCount_Call) RunAndReturn(run func(string) int64) *Database_GetWorkspaceFeaturesCount_Call {
+func (_c *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call) RunAndReturn(run func(string, string) (db.WorkspaceRepositories, error)) *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceInvoices provides a mock function with given fields: workspace_uuid
-func (_m *Database) GetWorkspaceInvoices(workspace_uuid string) []db.NewInvoiceList {
-	ret := _m.Called(workspace_uuid)
+// GetWorkspaceRepositorByWorkspaceUuid provides a mock function with given fields: uuid
+func (_m *Database) GetWorkspaceRepositorByWorkspaceUuid(uuid string) []db.WorkspaceRepositories {
+	ret := _m.Called(uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceInvoices")
+		panic("no return value specified for GetWorkspaceRepositorByWorkspaceUuid")
 	}
 
-	var r0 []db.NewInvoiceList
-	if rf, ok := ret.Get(0).(func(string) []db.NewInvoiceList); ok {
-		r0 = rf(workspace_uuid)
+	var r0 []db.WorkspaceRepositories
+	if rf, ok := ret.Get(0).(func(string) []db.WorkspaceRepositories); ok {
+		r0 = rf(uuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.NewInvoiceList)
+			r0 = ret.Get(0).([]db.WorkspaceRepositories)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetWorkspaceInvoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceInvoices'
-type Database_GetWorkspaceInvoices_Call struct {
+// Database_GetWorkspaceRepositorByWorkspaceUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceRepositorByWorkspaceUuid'
+type Database_GetWorkspaceRepositorByWorkspaceUuid_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceInvoices is a helper method to define mock.On call
-//   - workspace_uuid string
-func (_e *Database_Expecter) GetWorkspaceInvoices(workspace_uuid interface{}) *Database_GetWorkspaceInvoices_Call {
-	return &Database_GetWorkspaceInvoices_Call{Call: _e.mock.On("GetWorkspaceInvoices", workspace_uuid)}
+// GetWorkspaceRepositorByWorkspaceUuid is a helper method to define mock.On call
+//   - uuid string
+func (_e *Database_Expecter) GetWorkspaceRepositorByWorkspaceUuid(uuid interface{}) *Database_GetWorkspaceRepositorByWorkspaceUuid_Call {
+	return &Database_GetWorkspaceRepositorByWorkspaceUuid_Call{Call: _e.mock.On("GetWorkspaceRepositorByWorkspaceUuid", uuid)}
 }
 
-func (_c *Database_GetWorkspaceInvoices_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceInvoices_Call {
+func (_c *Database_GetWorkspaceRepositorByWorkspaceUuid_Call) Run(run func(uuid string)) *Database_GetWorkspaceRepositorByWorkspaceUuid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceInvoices_Call) Return(_a0 []db.NewInvoiceList) *Database_GetWorkspaceInvoices_Call {
+func (_c *Database_GetWorkspaceRepositorByWorkspaceUuid_Call) Return(_a0 []db.WorkspaceRepositories) *Database_GetWorkspaceRepositorByWorkspaceUuid_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceInvoices_Call) RunAndReturn(run func(string) []db.NewInvoiceList) *Database_GetWorkspaceInvoices_Call {
+func (_c *Database_GetWorkspaceRepositorByWorkspaceUuid_Call) RunAndReturn(run func(string) []db.WorkspaceRepositories) *Database_GetWorkspaceRepositorByWorkspaceUuid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceInvoicesCount provides a mock function with given fields: workspace_uuid
-func (_m *Database) GetWorkspaceInvoicesCount(workspace_uuid string) int64 {
+// GetWorkspaceStatusBudget provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceStatusBudget(workspace_uuid string) db.StatusBudget {
 	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceInvoicesCount")
+		panic("no return value specified for GetWorkspaceStatusBudget")
 	}
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
+	var r0 db.StatusBudget
+	if rf, ok := ret.Get(0).(func(string) db.StatusBudget); ok {
 		r0 = rf(workspace_uuid)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(db.StatusBudget)
 	}
 
 	return r0
 }
 
-// Database_GetWorkspaceInvoicesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceInvoicesCount'
-type Database_GetWorkspaceInvoicesCount_Call struct {
+// Database_GetWorkspaceStatusBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceStatusBudget'
+type Database_GetWorkspaceStatusBudget_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceInvoicesCount is a helper method to define mock.On call
+// GetWorkspaceStatusBudget is a helper method to define mock.On call
 //   - workspace_uuid string
-func (_e *Database_Expecter) GetWorkspaceInvoicesCount(workspace_uuid interface{}) *Database_GetWorkspaceInvoicesCount_Call {
-	return &Database_GetWorkspaceInvoicesCount_Call{Call: _e.mock.On("GetWorkspaceInvoicesCount", workspace_uuid)}
+func (_e *Database_Expecter) GetWorkspaceStatusBudget(workspace_uuid interface{}) *Database_GetWorkspaceStatusBudget_Call {
+	return &Database_GetWorkspaceStatusBudget_Call{Call: _e.mock.On("GetWorkspaceStatusBudget", workspace_uuid)}
 }
 
-func (_c *Database_GetWorkspaceInvoicesCount_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceInvoicesCount_Call {
+func (_c *Database_GetWorkspaceStatusBudget_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceStatusBudget_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceInvoicesCount_Call) Return(_a0 int64) *Database_GetWorkspaceInvoicesCount_Call {
+func (_c *Database_GetWorkspaceStatusBudget_Call) Return(_a0 db.StatusBudget) *Database_GetWorkspaceStatusBudget_Call {
 	_c.Call.Return(_a0)
 	return _c
-}
-
-func (_c *Database_GetWorkspaceInvoicesCount_Call) RunAndReturn(run func(string) int64) *Database_GetWorkspaceInvoicesCount_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// GetWorkspaceRepoByWorkspaceUuidAndRepoUuid provides a mock function with given fields: workspace_uuid, uuid
-func (_m *Database) GetWorkspaceRepoByWorkspaceUuidAndRepoUuid(workspace_uuid string, uuid string) (db.WorkspaceRepositories, error) {
-	ret := _m.Called(workspace_uuid, uuid)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceRepoByWorkspaceUuidAndRepoUuid")
-	}
-
-	var r0 db.WorkspaceRepositories
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (db.WorkspaceRepositories, error)); ok {
-		return rf(workspace_uuid, uuid)
-	}
-	if rf, ok := ret.Get(0).(func(string, string) db.WorkspaceRepositories); ok {
-		r0 = rf(workspace_uuid, uuid)
-	} else {
-		r0 = ret.Get(0).(db.WorkspaceRepositories)
+}
+
+func (_c *Database_GetWorkspaceStatusBudget_Call) RunAndReturn(run func(string) db.StatusBudget) *Database_GetWorkspaceStatusBudget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkspaceStoryCounts provides a mock function with given fields: workspaceUuid
+func (_m *Database) GetWorkspaceStoryCounts(workspaceUuid string) map[string]int64 {
+	ret := _m.Called(workspaceUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceStoryCounts")
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(workspace_uuid, uuid)
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func(string) map[string]int64); ok {
+		r0 = rf(workspaceUuid)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceRepoByWorkspaceUuidAndRepoUuid'
-type Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call struct {
+// Database_GetWorkspaceStoryCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceStoryCounts'
+type Database_GetWorkspaceStoryCounts_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceRepoByWorkspaceUuidAndRepoUuid is a helper method to define mock.On call
-//   - workspace_uuid string
-//   - uuid string
-func (_e *Database_Expecter) GetWorkspaceRepoByWorkspaceUuidAndRepoUuid(workspace_uuid interface{}, uuid interface{}) *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call {
-	return &Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call{Call: _e.mock.On("GetWorkspaceRepoByWorkspaceUuidAndRepoUuid", workspace_uuid, uuid)}
+// GetWorkspaceStoryCounts is a helper method to define mock.On call
+//   - workspaceUuid string
+func (_e *Database_Expecter) GetWorkspaceStoryCounts(workspaceUuid interface{}) *Database_GetWorkspaceStoryCounts_Call {
+	return &Database_GetWorkspaceStoryCounts_Call{Call: _e.mock.On("GetWorkspaceStoryCounts", workspaceUuid)}
 }
 
-func (_c *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call) Run(run func(workspace_uuid string, uuid string)) *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call {
+func (_c *Database_GetWorkspaceStoryCounts_Call) Run(run func(workspaceUuid string)) *Database_GetWorkspaceStoryCounts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call) Return(_a0 db.WorkspaceRepositories, _a1 error) *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Database_GetWorkspaceStoryCounts_Call) Return(_a0 map[string]int64) *Database_GetWorkspaceStoryCounts_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call) RunAndReturn(run func(string, string) (db.WorkspaceRepositories, error)) *Database_GetWorkspaceRepoByWorkspaceUuidAndRepoUuid_Call {
+func (_c *Database_GetWorkspaceStoryCounts_Call) RunAndReturn(run func(string) map[string]int64) *Database_GetWorkspaceStoryCounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceRepositorByWorkspaceUuid provides a mock function with given fields: uuid
-func (_m *Database) GetWorkspaceRepositorByWorkspaceUuid(uuid string) []db.WorkspaceRepositories {
-	ret := _m.Called(uuid)
+// GetWorkspaceTags provides a mock function with given fields: workspace_uuid
+func (_m *Database) GetWorkspaceTags(workspace_uuid string) []db.WorkspaceTag {
+	ret := _m.Called(workspace_uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceRepositorByWorkspaceUuid")
+		panic("no return value specified for GetWorkspaceTags")
 	}
 
-	var r0 []db.WorkspaceRepositories
-	if rf, ok := ret.Get(0).(func(string) []db.WorkspaceRepositories); ok {
-		r0 = rf(uuid)
+	var r0 []db.WorkspaceTag
+	if rf, ok := ret.Get(0).(func(string) []db.WorkspaceTag); ok {
+		r0 = rf(workspace_uuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.WorkspaceRepositories)
+			r0 = ret.Get(0).([]db.WorkspaceTag)
 		}
 	}
 
 	return r0
 }
 
-// Database_GetWorkspaceRepositorByWorkspaceUuid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceRepositorByWorkspaceUuid'
-type Database_GetWorkspaceRepositorByWorkspaceUuid_Call struct {
+// Database_GetWorkspaceTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceTags'
+type Database_GetWorkspaceTags_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceRepositorByWorkspaceUuid is a helper method to define mock.On call
-//   - uuid string
-func (_e *Database_Expecter) GetWorkspaceRepositorByWorkspaceUuid(uuid interface{}) *Database_GetWorkspaceRepositorByWorkspaceUuid_Call {
-	return &Database_GetWorkspaceRepositorByWorkspaceUuid_Call{Call: _e.mock.On("GetWorkspaceRepositorByWorkspaceUuid", uuid)}
+// GetWorkspaceTags is a helper method to define mock.On call
+//   - workspace_uuid string
+func (_e *Database_Expecter) GetWorkspaceTags(workspace_uuid interface{}) *Database_GetWorkspaceTags_Call {
+	return &Database_GetWorkspaceTags_Call{Call: _e.mock.On("GetWorkspaceTags", workspace_uuid)}
 }
 
-func (_c *Database_GetWorkspaceRepositorByWorkspaceUuid_Call) Run(run func(uuid string)) *Database_GetWorkspaceRepositorByWorkspaceUuid_Call {
+func (_c *Database_GetWorkspaceTags_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceRepositorByWorkspaceUuid_Call) Return(_a0 []db.WorkspaceRepositories) *Database_GetWorkspaceRepositorByWorkspaceUuid_Call {
+func (_c *Database_GetWorkspaceTags_Call) Return(_a0 []db.WorkspaceTag) *Database_GetWorkspaceTags_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceRepositorByWorkspaceUuid_Call) RunAndReturn(run func(string) []db.WorkspaceRepositories) *Database_GetWorkspaceRepositorByWorkspaceUuid_Call {
+func (_c *Database_GetWorkspaceTags_Call) RunAndReturn(run func(string) []db.WorkspaceTag) *Database_GetWorkspaceTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspaceStatusBudget provides a mock function with given fields: workspace_uuid
-func (_m *Database) GetWorkspaceStatusBudget(workspace_uuid string) db.StatusBudget {
-	ret := _m.Called(workspace_uuid)
+// GetWorkspaceTeam provides a mock function with given fields: uuid, r
+func (_m *Database) GetWorkspaceTeam(uuid string, r *http.Request) ([]db.WorkspaceTeamMember, error) {
+	ret := _m.Called(uuid, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspaceStatusBudget")
+		panic("no return value specified for GetWorkspaceTeam")
 	}
 
-	var r0 db.StatusBudget
-	if rf, ok := ret.Get(0).(func(string) db.StatusBudget); ok {
-		r0 = rf(workspace_uuid)
+	var r0 []db.WorkspaceTeamMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *http.Request) ([]db.WorkspaceTeamMember, error)); ok {
+		return rf(uuid, r)
+	}
+	if rf, ok := ret.Get(0).(func(string, *http.Request) []db.WorkspaceTeamMember); ok {
+		r0 = rf(uuid, r)
 	} else {
-		r0 = ret.Get(0).(db.StatusBudget)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.WorkspaceTeamMember)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, *http.Request) error); ok {
+		r1 = rf(uuid, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Database_GetWorkspaceStatusBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceStatusBudget'
-type Database_GetWorkspaceStatusBudget_Call struct {
+// Database_GetWorkspaceTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceTeam'
+type Database_GetWorkspaceTeam_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaceStatusBudget is a helper method to define mock.On call
-//   - workspace_uuid string
-func (_e *Database_Expecter) GetWorkspaceStatusBudget(workspace_uuid interface{}) *Database_GetWorkspaceStatusBudget_Call {
-	return &Database_GetWorkspaceStatusBudget_Call{Call: _e.mock.On("GetWorkspaceStatusBudget", workspace_uuid)}
+// GetWorkspaceTeam is a helper method to define mock.On call
+//   - uuid string
+//   - r *http.Request
+func (_e *Database_Expecter) GetWorkspaceTeam(uuid interface{}, r interface{}) *Database_GetWorkspaceTeam_Call {
+	return &Database_GetWorkspaceTeam_Call{Call: _e.mock.On("GetWorkspaceTeam", uuid, r)}
 }
 
-func (_c *Database_GetWorkspaceStatusBudget_Call) Run(run func(workspace_uuid string)) *Database_GetWorkspaceStatusBudget_Call {
+func (_c *Database_GetWorkspaceTeam_Call) Run(run func(uuid string, r *http.Request)) *Database_GetWorkspaceTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *Database_GetWorkspaceStatusBudget_Call) Return(_a0 db.StatusBudget) *Database_GetWorkspaceStatusBudget_Call {
-	_c.Call.Return(_a0)
+func (_c *Database_GetWorkspaceTeam_Call) Return(_a0 []db.WorkspaceTeamMember, _a1 error) *Database_GetWorkspaceTeam_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Database_GetWorkspaceStatusBudget_Call) RunAndReturn(run func(string) db.StatusBudget) *Database_GetWorkspaceStatusBudget_Call {
+func (_c *Database_GetWorkspaceTeam_Call) RunAndReturn(run func(string, *http.Request) ([]db.WorkspaceTeamMember, error)) *Database_GetWorkspaceTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6220,6 +8707,169 @@ func (_c *Database_GetWorkspacesCount_Call) RunAndReturn(run func() int64) *Data
 	return _c
 }
 
+// GetWorkspacesFeaturesCount provides a mock function with given fields: uuids
+func (_m *Database) GetWorkspacesFeaturesCount(uuids []string) map[string]int64 {
+	ret := _m.Called(uuids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspacesFeaturesCount")
+	}
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func([]string) map[string]int64); ok {
+		r0 = rf(uuids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	return r0
+}
+
+// Database_GetWorkspacesFeaturesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspacesFeaturesCount'
+type Database_GetWorkspacesFeaturesCount_Call struct {
+	*mock.Call
+}
+
+// GetWorkspacesFeaturesCount is a helper method to define mock.On call
+//   - uuids []string
+func (_e *Database_Expecter) GetWorkspacesFeaturesCount(uuids interface{}) *Database_GetWorkspacesFeaturesCount_Call {
+	return &Database_GetWorkspacesFeaturesCount_Call{Call: _e.mock.On("GetWorkspacesFeaturesCount", uuids)}
+}
+
+func (_c *Database_GetWorkspacesFeaturesCount_Call) Run(run func(uuids []string)) *Database_GetWorkspacesFeaturesCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *Database_GetWorkspacesFeaturesCount_Call) Return(_a0 map[string]int64) *Database_GetWorkspacesFeaturesCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_GetWorkspacesFeaturesCount_Call) RunAndReturn(run func([]string) map[string]int64) *Database_GetWorkspacesFeaturesCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveFeatureStory provides a mock function with given fields: featureUuid, storyUuid, targetFeatureUuid
+func (_m *Database) MoveFeatureStory(featureUuid string, storyUuid string, targetFeatureUuid string) (db.FeatureStory, error) {
+	ret := _m.Called(featureUuid, storyUuid, targetFeatureUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveFeatureStory")
+	}
+
+	var r0 db.FeatureStory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (db.FeatureStory, error)); ok {
+		return rf(featureUuid, storyUuid, targetFeatureUuid)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) db.FeatureStory); ok {
+		r0 = rf(featureUuid, storyUuid, targetFeatureUuid)
+	} else {
+		r0 = ret.Get(0).(db.FeatureStory)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(featureUuid, storyUuid, targetFeatureUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_MoveFeatureStory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveFeatureStory'
+type Database_MoveFeatureStory_Call struct {
+	*mock.Call
+}
+
+// MoveFeatureStory is a helper method to define mock.On call
+//   - featureUuid string
+//   - storyUuid string
+//   - targetFeatureUuid string
+func (_e *Database_Expecter) MoveFeatureStory(featureUuid interface{}, storyUuid interface{}, targetFeatureUuid interface{}) *Database_MoveFeatureStory_Call {
+	return &Database_MoveFeatureStory_Call{Call: _e.mock.On("MoveFeatureStory", featureUuid, storyUuid, targetFeatureUuid)}
+}
+
+func (_c *Database_MoveFeatureStory_Call) Run(run func(featureUuid string, storyUuid string, targetFeatureUuid string)) *Database_MoveFeatureStory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Database_MoveFeatureStory_Call) Return(_a0 db.FeatureStory, _a1 error) *Database_MoveFeatureStory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_MoveFeatureStory_Call) RunAndReturn(run func(string, string, string) (db.FeatureStory, error)) *Database_MoveFeatureStory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveFeatureToWorkspace provides a mock function with given fields: featureUuid, targetWorkspaceUuid
+func (_m *Database) MoveFeatureToWorkspace(featureUuid string, targetWorkspaceUuid string) (db.WorkspaceFeatures, error) {
+	ret := _m.Called(featureUuid, targetWorkspaceUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveFeatureToWorkspace")
+	}
+
+	var r0 db.WorkspaceFeatures
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (db.WorkspaceFeatures, error)); ok {
+		return rf(featureUuid, targetWorkspaceUuid)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) db.WorkspaceFeatures); ok {
+		r0 = rf(featureUuid, targetWorkspaceUuid)
+	} else {
+		r0 = ret.Get(0).(db.WorkspaceFeatures)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(featureUuid, targetWorkspaceUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_MoveFeatureToWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveFeatureToWorkspace'
+type Database_MoveFeatureToWorkspace_Call struct {
+	*mock.Call
+}
+
+// MoveFeatureToWorkspace is a helper method to define mock.On call
+//   - featureUuid string
+//   - targetWorkspaceUuid string
+func (_e *Database_Expecter) MoveFeatureToWorkspace(featureUuid interface{}, targetWorkspaceUuid interface{}) *Database_MoveFeatureToWorkspace_Call {
+	return &Database_MoveFeatureToWorkspace_Call{Call: _e.mock.On("MoveFeatureToWorkspace", featureUuid, targetWorkspaceUuid)}
+}
+
+func (_c *Database_MoveFeatureToWorkspace_Call) Run(run func(featureUuid string, targetWorkspaceUuid string)) *Database_MoveFeatureToWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_MoveFeatureToWorkspace_Call) Return(_a0 db.WorkspaceFeatures, _a1 error) *Database_MoveFeatureToWorkspace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_MoveFeatureToWorkspace_Call) RunAndReturn(run func(string, string) (db.WorkspaceFeatures, error)) *Database_MoveFeatureToWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewHuntersPaid provides a mock function with given fields: r, workspace
 func (_m *Database) NewHuntersPaid(r db.PaymentDateRange, workspace string) int64 {
 	ret := _m.Called(r, workspace)
@@ -6584,7 +9234,101 @@ func (_c *Database_ProcessUpdateBudget_Call) Return(_a0 error) *Database_Process
 	return _c
 }
 
-func (_c *Database_ProcessUpdateBudget_Call) RunAndReturn(run func(db.NewInvoiceList) error) *Database_ProcessUpdateBudget_Call {
+func (_c *Database_ProcessUpdateBudget_Call) RunAndReturn(run func(db.NewInvoiceList) error) *Database_ProcessUpdateBudget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeemWorkspaceInvite provides a mock function with given fields: invite, pubkey
+func (_m *Database) RedeemWorkspaceInvite(invite db.WorkspaceInvite, pubkey string) error {
+	ret := _m.Called(invite, pubkey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeemWorkspaceInvite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.WorkspaceInvite, string) error); ok {
+		r0 = rf(invite, pubkey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Database_RedeemWorkspaceInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeemWorkspaceInvite'
+type Database_RedeemWorkspaceInvite_Call struct {
+	*mock.Call
+}
+
+// RedeemWorkspaceInvite is a helper method to define mock.On call
+//   - invite db.WorkspaceInvite
+//   - pubkey string
+func (_e *Database_Expecter) RedeemWorkspaceInvite(invite interface{}, pubkey interface{}) *Database_RedeemWorkspaceInvite_Call {
+	return &Database_RedeemWorkspaceInvite_Call{Call: _e.mock.On("RedeemWorkspaceInvite", invite, pubkey)}
+}
+
+func (_c *Database_RedeemWorkspaceInvite_Call) Run(run func(invite db.WorkspaceInvite, pubkey string)) *Database_RedeemWorkspaceInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.WorkspaceInvite), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_RedeemWorkspaceInvite_Call) Return(_a0 error) *Database_RedeemWorkspaceInvite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_RedeemWorkspaceInvite_Call) RunAndReturn(run func(db.WorkspaceInvite, string) error) *Database_RedeemWorkspaceInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveWorkspaceUsers provides a mock function with given fields: workspace_uuid, pubkeys
+func (_m *Database) RemoveWorkspaceUsers(workspace_uuid string, pubkeys []string) error {
+	ret := _m.Called(workspace_uuid, pubkeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveWorkspaceUsers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(workspace_uuid, pubkeys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Database_RemoveWorkspaceUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveWorkspaceUsers'
+type Database_RemoveWorkspaceUsers_Call struct {
+	*mock.Call
+}
+
+// RemoveWorkspaceUsers is a helper method to define mock.On call
+//   - workspace_uuid string
+//   - pubkeys []string
+func (_e *Database_Expecter) RemoveWorkspaceUsers(workspace_uuid interface{}, pubkeys interface{}) *Database_RemoveWorkspaceUsers_Call {
+	return &Database_RemoveWorkspaceUsers_Call{Call: _e.mock.On("RemoveWorkspaceUsers", workspace_uuid, pubkeys)}
+}
+
+func (_c *Database_RemoveWorkspaceUsers_Call) Run(run func(workspace_uuid string, pubkeys []string)) *Database_RemoveWorkspaceUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *Database_RemoveWorkspaceUsers_Call) Return(_a0 error) *Database_RemoveWorkspaceUsers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_RemoveWorkspaceUsers_Call) RunAndReturn(run func(string, []string) error) *Database_RemoveWorkspaceUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6784,6 +9528,122 @@ func (_c *Database_SearchTribes_Call) RunAndReturn(run func(string) []db.Tribe)
 	return _c
 }
 
+// SearchWorkspacesBounties provides a mock function with given fields: r, workspace_uuids
+func (_m *Database) SearchWorkspacesBounties(r *http.Request, workspace_uuids []string) ([]db.NewBounty, int64) {
+	ret := _m.Called(r, workspace_uuids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchWorkspacesBounties")
+	}
+
+	var r0 []db.NewBounty
+	var r1 int64
+	if rf, ok := ret.Get(0).(func(*http.Request, []string) ([]db.NewBounty, int64)); ok {
+		return rf(r, workspace_uuids)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request, []string) []db.NewBounty); ok {
+		r0 = rf(r, workspace_uuids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.NewBounty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request, []string) int64); ok {
+		r1 = rf(r, workspace_uuids)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	return r0, r1
+}
+
+// Database_SearchWorkspacesBounties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchWorkspacesBounties'
+type Database_SearchWorkspacesBounties_Call struct {
+	*mock.Call
+}
+
+// SearchWorkspacesBounties is a helper method to define mock.On call
+//   - r *http.Request
+//   - workspace_uuids []string
+func (_e *Database_Expecter) SearchWorkspacesBounties(r interface{}, workspace_uuids interface{}) *Database_SearchWorkspacesBounties_Call {
+	return &Database_SearchWorkspacesBounties_Call{Call: _e.mock.On("SearchWorkspacesBounties", r, workspace_uuids)}
+}
+
+func (_c *Database_SearchWorkspacesBounties_Call) Run(run func(r *http.Request, workspace_uuids []string)) *Database_SearchWorkspacesBounties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *Database_SearchWorkspacesBounties_Call) Return(_a0 []db.NewBounty, _a1 int64) *Database_SearchWorkspacesBounties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_SearchWorkspacesBounties_Call) RunAndReturn(run func(*http.Request, []string) ([]db.NewBounty, int64)) *Database_SearchWorkspacesBounties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFeaturePinned provides a mock function with given fields: uuid, pinned
+func (_m *Database) SetFeaturePinned(uuid string, pinned bool) (db.WorkspaceFeatures, error) {
+	ret := _m.Called(uuid, pinned)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFeaturePinned")
+	}
+
+	var r0 db.WorkspaceFeatures
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) (db.WorkspaceFeatures, error)); ok {
+		return rf(uuid, pinned)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) db.WorkspaceFeatures); ok {
+		r0 = rf(uuid, pinned)
+	} else {
+		r0 = ret.Get(0).(db.WorkspaceFeatures)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(uuid, pinned)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_SetFeaturePinned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFeaturePinned'
+type Database_SetFeaturePinned_Call struct {
+	*mock.Call
+}
+
+// SetFeaturePinned is a helper method to define mock.On call
+//   - uuid string
+//   - pinned bool
+func (_e *Database_Expecter) SetFeaturePinned(uuid interface{}, pinned interface{}) *Database_SetFeaturePinned_Call {
+	return &Database_SetFeaturePinned_Call{Call: _e.mock.On("SetFeaturePinned", uuid, pinned)}
+}
+
+func (_c *Database_SetFeaturePinned_Call) Run(run func(uuid string, pinned bool)) *Database_SetFeaturePinned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *Database_SetFeaturePinned_Call) Return(_a0 db.WorkspaceFeatures, _a1 error) *Database_SetFeaturePinned_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_SetFeaturePinned_Call) RunAndReturn(run func(string, bool) (db.WorkspaceFeatures, error)) *Database_SetFeaturePinned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TotalAssignedBounties provides a mock function with given fields: r, workspace
 func (_m *Database) TotalAssignedBounties(r db.PaymentDateRange, workspace string) int64 {
 	ret := _m.Called(r, workspace)
@@ -7066,6 +9926,53 @@ func (_c *Database_TotalSatsPosted_Call) RunAndReturn(run func(db.PaymentDateRan
 	return _c
 }
 
+// UnwatchFeature provides a mock function with given fields: featureUuid, pubkey
+func (_m *Database) UnwatchFeature(featureUuid string, pubkey string) error {
+	ret := _m.Called(featureUuid, pubkey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnwatchFeature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(featureUuid, pubkey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Database_UnwatchFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnwatchFeature'
+type Database_UnwatchFeature_Call struct {
+	*mock.Call
+}
+
+// UnwatchFeature is a helper method to define mock.On call
+//   - featureUuid string
+//   - pubkey string
+func (_e *Database_Expecter) UnwatchFeature(featureUuid interface{}, pubkey interface{}) *Database_UnwatchFeature_Call {
+	return &Database_UnwatchFeature_Call{Call: _e.mock.On("UnwatchFeature", featureUuid, pubkey)}
+}
+
+func (_c *Database_UnwatchFeature_Call) Run(run func(featureUuid string, pubkey string)) *Database_UnwatchFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Database_UnwatchFeature_Call) Return(_a0 error) *Database_UnwatchFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Database_UnwatchFeature_Call) RunAndReturn(run func(string, string) error) *Database_UnwatchFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateBot provides a mock function with given fields: uuid, u
 func (_m *Database) UpdateBot(uuid string, u map[string]interface{}) bool {
 	ret := _m.Called(uuid, u)
@@ -7366,6 +10273,121 @@ func (_c *Database_UpdateChannel_Call) RunAndReturn(run func(uint, map[string]in
 	return _c
 }
 
+// UpdateFeaturePhaseStatus provides a mock function with given fields: featureUuid, phaseUuid, status
+func (_m *Database) UpdateFeaturePhaseStatus(featureUuid string, phaseUuid string, status db.PhaseStatus) (db.FeaturePhase, error) {
+	ret := _m.Called(featureUuid, phaseUuid, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeaturePhaseStatus")
+	}
+
+	var r0 db.FeaturePhase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, db.PhaseStatus) (db.FeaturePhase, error)); ok {
+		return rf(featureUuid, phaseUuid, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, db.PhaseStatus) db.FeaturePhase); ok {
+		r0 = rf(featureUuid, phaseUuid, status)
+	} else {
+		r0 = ret.Get(0).(db.FeaturePhase)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, db.PhaseStatus) error); ok {
+		r1 = rf(featureUuid, phaseUuid, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_UpdateFeaturePhaseStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeaturePhaseStatus'
+type Database_UpdateFeaturePhaseStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateFeaturePhaseStatus is a helper method to define mock.On call
+//   - featureUuid string
+//   - phaseUuid string
+//   - status db.PhaseStatus
+func (_e *Database_Expecter) UpdateFeaturePhaseStatus(featureUuid interface{}, phaseUuid interface{}, status interface{}) *Database_UpdateFeaturePhaseStatus_Call {
+	return &Database_UpdateFeaturePhaseStatus_Call{Call: _e.mock.On("UpdateFeaturePhaseStatus", featureUuid, phaseUuid, status)}
+}
+
+func (_c *Database_UpdateFeaturePhaseStatus_Call) Run(run func(featureUuid string, phaseUuid string, status db.PhaseStatus)) *Database_UpdateFeaturePhaseStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(db.PhaseStatus))
+	})
+	return _c
+}
+
+func (_c *Database_UpdateFeaturePhaseStatus_Call) Return(_a0 db.FeaturePhase, _a1 error) *Database_UpdateFeaturePhaseStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_UpdateFeaturePhaseStatus_Call) RunAndReturn(run func(string, string, db.PhaseStatus) (db.FeaturePhase, error)) *Database_UpdateFeaturePhaseStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeatureStatus provides a mock function with given fields: uuid, status
+func (_m *Database) UpdateFeatureStatus(uuid string, status db.FeatureStatus) (db.WorkspaceFeatures, error) {
+	ret := _m.Called(uuid, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeatureStatus")
+	}
+
+	var r0 db.WorkspaceFeatures
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, db.FeatureStatus) (db.WorkspaceFeatures, error)); ok {
+		return rf(uuid, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, db.FeatureStatus) db.WorkspaceFeatures); ok {
+		r0 = rf(uuid, status)
+	} else {
+		r0 = ret.Get(0).(db.WorkspaceFeatures)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, db.FeatureStatus) error); ok {
+		r1 = rf(uuid, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_UpdateFeatureStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeatureStatus'
+type Database_UpdateFeatureStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateFeatureStatus is a helper method to define mock.On call
+//   - uuid string
+//   - status db.FeatureStatus
+func (_e *Database_Expecter) UpdateFeatureStatus(uuid interface{}, status interface{}) *Database_UpdateFeatureStatus_Call {
+	return &Database_UpdateFeatureStatus_Call{Call: _e.mock.On("UpdateFeatureStatus", uuid, status)}
+}
+
+func (_c *Database_UpdateFeatureStatus_Call) Run(run func(uuid string, status db.FeatureStatus)) *Database_UpdateFeatureStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(db.FeatureStatus))
+	})
+	return _c
+}
+
+func (_c *Database_UpdateFeatureStatus_Call) Return(_a0 db.WorkspaceFeatures, _a1 error) *Database_UpdateFeatureStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_UpdateFeatureStatus_Call) RunAndReturn(run func(string, db.FeatureStatus) (db.WorkspaceFeatures, error)) *Database_UpdateFeatureStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateGithubConfirmed provides a mock function with given fields: id, confirmed
 func (_m *Database) UpdateGithubConfirmed(id uint, confirmed bool) {
 	_m.Called(id, confirmed)
@@ -7782,6 +10804,63 @@ func (_c *Database_UpdateWorkspaceForDeletion_Call) RunAndReturn(run func(string
 	return _c
 }
 
+// UpdateWorkspaceProductBrief provides a mock function with given fields: uuid, brief
+func (_m *Database) UpdateWorkspaceProductBrief(uuid string, brief db.WorkspaceProductBrief) (db.Workspace, error) {
+	ret := _m.Called(uuid, brief)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWorkspaceProductBrief")
+	}
+
+	var r0 db.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, db.WorkspaceProductBrief) (db.Workspace, error)); ok {
+		return rf(uuid, brief)
+	}
+	if rf, ok := ret.Get(0).(func(string, db.WorkspaceProductBrief) db.Workspace); ok {
+		r0 = rf(uuid, brief)
+	} else {
+		r0 = ret.Get(0).(db.Workspace)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, db.WorkspaceProductBrief) error); ok {
+		r1 = rf(uuid, brief)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_UpdateWorkspaceProductBrief_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkspaceProductBrief'
+type Database_UpdateWorkspaceProductBrief_Call struct {
+	*mock.Call
+}
+
+// UpdateWorkspaceProductBrief is a helper method to define mock.On call
+//   - uuid string
+//   - brief db.WorkspaceProductBrief
+func (_e *Database_Expecter) UpdateWorkspaceProductBrief(uuid interface{}, brief interface{}) *Database_UpdateWorkspaceProductBrief_Call {
+	return &Database_UpdateWorkspaceProductBrief_Call{Call: _e.mock.On("UpdateWorkspaceProductBrief", uuid, brief)}
+}
+
+func (_c *Database_UpdateWorkspaceProductBrief_Call) Run(run func(uuid string, brief db.WorkspaceProductBrief)) *Database_UpdateWorkspaceProductBrief_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(db.WorkspaceProductBrief))
+	})
+	return _c
+}
+
+func (_c *Database_UpdateWorkspaceProductBrief_Call) Return(_a0 db.Workspace, _a1 error) *Database_UpdateWorkspaceProductBrief_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_UpdateWorkspaceProductBrief_Call) RunAndReturn(run func(string, db.WorkspaceProductBrief) (db.Workspace, error)) *Database_UpdateWorkspaceProductBrief_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UserHasAccess provides a mock function with given fields: pubKeyFromAuth, uuid, role
 func (_m *Database) UserHasAccess(pubKeyFromAuth string, uuid string, role string) bool {
 	ret := _m.Called(pubKeyFromAuth, uuid, role)
@@ -7877,6 +10956,62 @@ func (_c *Database_UserHasManageBountyRoles_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// WatchFeature provides a mock function with given fields: watcher
+func (_m *Database) WatchFeature(watcher db.FeatureWatcher) (db.FeatureWatcher, error) {
+	ret := _m.Called(watcher)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchFeature")
+	}
+
+	var r0 db.FeatureWatcher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.FeatureWatcher) (db.FeatureWatcher, error)); ok {
+		return rf(watcher)
+	}
+	if rf, ok := ret.Get(0).(func(db.FeatureWatcher) db.FeatureWatcher); ok {
+		r0 = rf(watcher)
+	} else {
+		r0 = ret.Get(0).(db.FeatureWatcher)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.FeatureWatcher) error); ok {
+		r1 = rf(watcher)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Database_WatchFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchFeature'
+type Database_WatchFeature_Call struct {
+	*mock.Call
+}
+
+// WatchFeature is a helper method to define mock.On call
+//   - watcher db.FeatureWatcher
+func (_e *Database_Expecter) WatchFeature(watcher interface{}) *Database_WatchFeature_Call {
+	return &Database_WatchFeature_Call{Call: _e.mock.On("WatchFeature", watcher)}
+}
+
+func (_c *Database_WatchFeature_Call) Run(run func(watcher db.FeatureWatcher)) *Database_WatchFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.FeatureWatcher))
+	})
+	return _c
+}
+
+func (_c *Database_WatchFeature_Call) Return(_a0 db.FeatureWatcher, _a1 error) *Database_WatchFeature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Database_WatchFeature_Call) RunAndReturn(run func(db.FeatureWatcher) (db.FeatureWatcher, error)) *Database_WatchFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithdrawBudget provides a mock function with given fields: sender_pubkey, workspace_uuid, amount
 func (_m *Database) WithdrawBudget(sender_pubkey string, workspace_uuid string, amount uint) {
 	_m.Called(sender_pubkey, workspace_uuid, amount)
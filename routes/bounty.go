@@ -34,6 +34,9 @@ func BountyRoutes() chi.Router {
 	r.Group(func(r chi.Router) {
 		r.Use(auth.PubKeyContext)
 		r.Post("/pay/{id}", bountyHandler.MakeBountyPayment)
+		r.Post("/assign/{id}", bountyHandler.AssignBountyToSelf)
+		r.Post("/approve/{id}", bountyHandler.ApproveBounty)
+		r.Get("/phase_context/{bountyId}", bountyHandler.GetBountyPhaseContext)
 		r.Post("/budget/withdraw", bountyHandler.BountyBudgetWithdraw)
 		r.Post("/budget_workspace/withdraw", bountyHandler.NewBountyBudgetWithdraw)
 
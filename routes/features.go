@@ -5,6 +5,7 @@ import (
 	"github.com/stakwork/sphinx-tribes/auth"
 	"github.com/stakwork/sphinx-tribes/db"
 	"github.com/stakwork/sphinx-tribes/handlers"
+	"github.com/stakwork/sphinx-tribes/utils"
 )
 
 func FeatureRoutes() chi.Router {
@@ -18,19 +19,52 @@ func FeatureRoutes() chi.Router {
 		// Old route for to getting features for workspace uuid
 		r.Get("/forworkspace/{workspace_uuid}", featureHandlers.GetFeaturesByWorkspaceUuid)
 		r.Get("/workspace/count/{uuid}", featureHandlers.GetWorkspaceFeaturesCount)
+		r.Post("/workspace/{workspace_uuid}/archive_stale", featureHandlers.ArchiveStaleFeatures)
+		r.Post("/workspace/{workspace_uuid}/archive_all", featureHandlers.ArchiveAllWorkspaceFeatures)
+		r.Get("/workspace/{workspace_uuid}/oldest_open_bounty", featureHandlers.GetOldestOpenBountyPerFeature)
+		r.Get("/workspace/{workspace_uuid}/phases", featureHandlers.GetWorkspacePhases)
+		r.Get("/workspace/{workspace_uuid}/story_counts", featureHandlers.GetWorkspaceStoryCounts)
 		r.Delete("/{uuid}", featureHandlers.DeleteFeature)
+		r.Post("/{feature_uuid}/move_workspace", featureHandlers.MoveFeatureToWorkspace)
+		r.Put("/{feature_uuid}/status", featureHandlers.UpdateFeatureStatus)
+		r.Post("/{feature_uuid}/pin", featureHandlers.PinFeature)
+		r.Delete("/{feature_uuid}/pin", featureHandlers.UnpinFeature)
 
 		r.Post("/phase", featureHandlers.CreateOrEditFeaturePhase)
+		r.Get("/{feature_uuid}/tree", featureHandlers.GetFeatureTree)
 		r.Get("/{feature_uuid}/phase", featureHandlers.GetFeaturePhases)
 		r.Get("/{feature_uuid}/phase/{phase_uuid}", featureHandlers.GetFeaturePhaseByUUID)
+		r.Get("/{feature_uuid}/phase/{phase_uuid}/detail", featureHandlers.GetPhaseDetail)
+		r.Put("/{feature_uuid}/phase/{phase_uuid}/status", featureHandlers.UpdatePhaseStatus)
 		r.Delete("/{feature_uuid}/phase/{phase_uuid}", featureHandlers.DeleteFeaturePhase)
 
 		r.Post("/story", featureHandlers.CreateOrEditStory)
 		r.Get("/{feature_uuid}/story", featureHandlers.GetStoriesByFeatureUuid)
 		r.Get("/{feature_uuid}/story/{story_uuid}", featureHandlers.GetStoryByUuid)
 		r.Delete("/{feature_uuid}/story/{story_uuid}", featureHandlers.DeleteStory)
+		r.Post("/{feature_uuid}/story/{story_uuid}/move", featureHandlers.MoveStoryToFeature)
+		r.Post("/{feature_uuid}/stories/delete", featureHandlers.DeleteFeatureStories)
+		r.Get("/{feature_uuid}/bounty", featureHandlers.GetBountiesByFeatureUuid)
 		r.Get("/{feature_uuid}/phase/{phase_uuid}/bounty", featureHandlers.GetBountiesByFeatureAndPhaseUuid)
 		r.Get("/{feature_uuid}/phase/{phase_uuid}/bounty/count", featureHandlers.GetBountiesCountByFeatureAndPhaseUuid)
+		r.Post("/{feature_uuid}/phase/{phase_uuid}/bounty/assign", featureHandlers.AssignPhaseBounties)
+
+		r.Post("/dependency", featureHandlers.AddFeatureDependency)
+		r.Get("/{feature_uuid}/dependencies", featureHandlers.GetFeatureDependencies)
+		r.Delete("/{feature_uuid}/dependency/{depends_on_uuid}", featureHandlers.RemoveFeatureDependency)
+
+		r.Post("/{feature_uuid}/watch", featureHandlers.WatchFeature)
+		r.Delete("/{feature_uuid}/watch", featureHandlers.UnwatchFeature)
+		r.Get("/{feature_uuid}/watchers", featureHandlers.GetFeatureWatchers)
+
+		r.Post("/{feature_uuid}/burndown", featureHandlers.GetFeatureBurndown)
+		r.Post("/{feature_uuid}/velocity", featureHandlers.GetFeatureVelocity)
+		r.Get("/{feature_uuid}/estimated_hours", featureHandlers.GetFeatureEstimatedHours)
+		r.Get("/{feature_uuid}/activity", featureHandlers.GetFeatureActivity)
+
+		r.Post("/{feature_uuid}/snapshot", featureHandlers.SnapshotFeature)
+		r.Get("/{feature_uuid}/snapshots", featureHandlers.GetFeatureSnapshots)
+		r.With(utils.ValidateUuidParams("snapshot_uuid")).Get("/{feature_uuid}/snapshot/{snapshot_uuid}", featureHandlers.GetFeatureSnapshot)
 
 	})
 	return r
@@ -15,6 +15,7 @@ import (
 	"github.com/stakwork/sphinx-tribes/auth"
 	"github.com/stakwork/sphinx-tribes/db"
 	"github.com/stakwork/sphinx-tribes/handlers"
+	"github.com/stakwork/sphinx-tribes/utils"
 )
 
 // NewRouter creates a chi router
@@ -141,12 +142,14 @@ func getFromAuth(path string) (*extractResponse, error) {
 func initChi() *chi.Mux {
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
+	r.Use(utils.RequestIDHeader)
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 	cors := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-User", "authorization", "x-jwt", "Referer", "User-Agent"},
+		ExposedHeaders:   []string{"X-Total-Count", "X-Request-Id"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	})
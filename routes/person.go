@@ -12,6 +12,8 @@ func PersonRoutes() chi.Router {
 	peopleHandler := handlers.NewPeopleHandler(db.DB)
 	r.Group(func(r chi.Router) {
 		r.Get("/{pubkey}", peopleHandler.GetPersonByPubkey)
+		r.Get("/{pubkey}/bounty_stats", peopleHandler.GetPersonBountyStats)
+		r.Get("/{pubkey}/activity", peopleHandler.GetPersonActivityCounts)
 		r.Get("/id/{id}", peopleHandler.GetPersonById)
 		r.Get("/uuid/{uuid}", peopleHandler.GetPersonByUuid)
 		r.Get("/uuid/{uuid}/assets", handlers.GetPersonAssetsByUuid)
@@ -13,37 +13,67 @@ func WorkspaceRoutes() chi.Router {
 	r.Group(func(r chi.Router) {
 		r.Get("/", handlers.GetWorkspaces)
 		r.Get("/count", handlers.GetWorkspacesCount)
-		r.Get("/{uuid}", handlers.GetWorkspaceByUuid)
 		r.Get("/users/{uuid}", handlers.GetWorkspaceUsers)
 		r.Get("/users/{uuid}/count", handlers.GetWorkspaceUsersCount)
 		r.Get("/bounties/{uuid}", workspaceHandlers.GetWorkspaceBounties)
 		r.Get("/bounties/{uuid}/count", workspaceHandlers.GetWorkspaceBountiesCount)
+		r.Get("/bounties/{uuid}/status/{status}", workspaceHandlers.GetWorkspaceBountiesByStatus)
+		r.Get("/bounties/{uuid}/languages", workspaceHandlers.GetWorkspaceBountiesCountByLanguage)
 		r.Get("/user/{userId}", handlers.GetUserWorkspaces)
 		r.Get("/user/dropdown/{userId}", workspaceHandlers.GetUserDropdownWorkspaces)
 	})
+	r.Group(func(r chi.Router) {
+		r.Use(auth.OptionalPubKeyContext)
+
+		r.Get("/{uuid}", workspaceHandlers.GetWorkspaceByUuid)
+	})
 	r.Group(func(r chi.Router) {
 		r.Use(auth.PubKeyContext)
 
 		r.Post("/", workspaceHandlers.CreateOrEditWorkspace)
-		r.Post("/users/{uuid}", handlers.CreateWorkspaceUser)
+		r.Post("/users/{uuid}", workspaceHandlers.CreateWorkspaceUser)
 		r.Delete("/users/{uuid}", handlers.DeleteWorkspaceUser)
+		r.Post("/users/remove/{uuid}", workspaceHandlers.RemoveWorkspaceUsers)
 		r.Post("/users/role/{uuid}/{user}", handlers.AddUserRoles)
+		r.Post("/invite/{uuid}", workspaceHandlers.CreateWorkspaceInvite)
+		r.Post("/invite/redeem/{token}", workspaceHandlers.RedeemWorkspaceInvite)
+		r.Post("/tags/{uuid}", workspaceHandlers.CreateWorkspaceTag)
+		r.Delete("/tags/{uuid}/{name}", workspaceHandlers.DeleteWorkspaceTag)
+		r.Post("/feature_statuses/{uuid}", workspaceHandlers.CreateWorkspaceFeatureStatus)
+		r.Delete("/feature_statuses/{uuid}/{name}", workspaceHandlers.DeleteWorkspaceFeatureStatus)
 
 		r.Get("/foruser/{uuid}", handlers.GetWorkspaceUser)
+		r.Get("/team/{uuid}", workspaceHandlers.GetWorkspaceTeam)
+		r.Get("/assignable/{uuid}", workspaceHandlers.GetAssignableMembers)
+		r.Get("/user/activity", workspaceHandlers.GetUserRecentActivity)
+		r.Get("/user/bounties/search", workspaceHandlers.SearchUserBounties)
+		r.Get("/tags/{uuid}", workspaceHandlers.ListWorkspaceTags)
+		r.Get("/feature_statuses/{uuid}", workspaceHandlers.ListWorkspaceFeatureStatuses)
 		r.Get("/bounty/roles", handlers.GetBountyRoles)
 		r.Get("/users/role/{uuid}/{user}", handlers.GetUserRoles)
+		r.Get("/users/permissions/{uuid}/{user}", workspaceHandlers.GetEffectivePermissions)
 		r.Get("/budget/{uuid}", workspaceHandlers.GetWorkspaceBudget)
 		r.Get("/budget/history/{uuid}", workspaceHandlers.GetWorkspaceBudgetHistory)
-		r.Get("/payments/{uuid}", handlers.GetPaymentHistory)
+		r.Get("/budget/features/{uuid}", workspaceHandlers.GetWorkspaceBudgetByFeature)
+		r.Get("/bounties/aging/{uuid}", workspaceHandlers.GetWorkspaceBountyAging)
+		r.Get("/bounties/pending/{uuid}", workspaceHandlers.GetWorkspacePendingBounties)
+		r.Get("/payments/{uuid}", workspaceHandlers.GetPaymentHistory)
+		r.Get("/payments/pending/{uuid}", workspaceHandlers.GetWorkspacePendingPaymentsSummary)
 		r.Get("/poll/invoices/{uuid}", workspaceHandlers.PollBudgetInvoices)
 		r.Get("/poll/user/invoices", workspaceHandlers.PollUserWorkspacesBudget)
 		r.Get("/invoices/count/{uuid}", handlers.GetInvoicesCount)
+		r.Get("/invoices/{uuid}", workspaceHandlers.ListWorkspaceInvoices)
+		r.Post("/invoices/cleanup/{uuid}", workspaceHandlers.CleanupExpiredInvoices)
 		r.Get("/user/invoices/count", handlers.GetAllUserInvoicesCount)
 		r.Delete("/delete/{uuid}", workspaceHandlers.DeleteWorkspace)
+		r.Post("/restore/{uuid}", workspaceHandlers.RestoreWorkspace)
 
 		r.Post("/mission", workspaceHandlers.UpdateWorkspace)
 		r.Post("/tactics", workspaceHandlers.UpdateWorkspace)
 		r.Post("/schematicurl", workspaceHandlers.UpdateWorkspace)
+		r.Get("/product_brief/{uuid}", workspaceHandlers.GetWorkspaceProductBrief)
+		r.Patch("/product_brief/{uuid}", workspaceHandlers.UpdateWorkspaceProductBrief)
+		r.Get("/stakwork/test/{uuid}", workspaceHandlers.TestStakworkConnection)
 
 		r.Post("/repositories", workspaceHandlers.CreateOrEditWorkspaceRepository)
 		r.Get("/repositories/{uuid}", workspaceHandlers.GetWorkspaceRepositorByWorkspaceUuid)
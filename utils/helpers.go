@@ -3,10 +3,15 @@ package utils
 import (
 	"crypto/rand"
 	"encoding/base32"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 	"strconv"
 	"time"
 
+	"github.com/go-chi/chi"
 	decodepay "github.com/nbd-wtf/ln-decodepay"
 )
 
@@ -77,3 +82,45 @@ func GetDateDaysDifference(createdDate int64, paidDate *time.Time) int64 {
 	days := int64(difference.Hours() / 24)
 	return days
 }
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// xidRegex only checks the length and base32hex alphabet of an xid. Stored
+// uuids that xid.FromString rejects, like older fixtures, still pass.
+var xidRegex = regexp.MustCompile(`^[0-9a-v]{20}$`)
+
+// IsValidUuid reports whether id looks like an xid, which new records use, or
+// a hyphenated UUID, which older records and tests use
+func IsValidUuid(id string) bool {
+	return uuidRegex.MatchString(id) || xidRegex.MatchString(id)
+}
+
+// ParseUUIDParam returns the named route param, or an error when it is not a
+// valid uuid
+func ParseUUIDParam(r *http.Request, name string) (string, error) {
+	id := chi.URLParam(r, name)
+	if !IsValidUuid(id) {
+		return "", errors.New("invalid " + name)
+	}
+	return id, nil
+}
+
+// ValidateUuidParams rejects requests where one of the named route params is
+// a malformed uuid with a 400 before they reach the handler. Only pass params
+// the server generates; feature, phase and story uuids can be set by clients
+// and must not be checked. Route params are only set once the route is
+// matched, so this has to be used with chi's With or inside a Group.
+func ValidateUuidParams(params ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, param := range params {
+				if _, err := ParseUUIDParam(r, param); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					json.NewEncoder(w).Encode("Error: " + err.Error())
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package utils
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/go-chi/chi"
+	"github.com/rs/xid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -78,3 +82,52 @@ func TestGetInvoiceExpired(t *testing.T) {
 	isInvoiceExpired := GetInvoiceExpired(expiredInvoice)
 	assert.Equal(t, true, isInvoiceExpired)
 }
+
+func TestIsValidUuid(t *testing.T) {
+	assert.True(t, IsValidUuid(xid.New().String()))
+	assert.True(t, IsValidUuid("6f1c3c5e-8f5b-4f8e-9d0a-2b7c1e4a9d3f"))
+	// stored fixture uuid that xid.FromString rejects
+	assert.True(t, IsValidUuid("com1l5on1e49tucv350h"))
+
+	assert.False(t, IsValidUuid(""))
+	assert.False(t, IsValidUuid("not-a-uuid"))
+	assert.False(t, IsValidUuid("6f1c3c5e-8f5b-4f8e-9d0a"))
+	assert.False(t, IsValidUuid("' OR 1=1 --"))
+}
+
+func TestValidateUuidParams(t *testing.T) {
+	r := chi.NewRouter()
+	r.Group(func(r chi.Router) {
+		r.With(ValidateUuidParams("snapshot_uuid")).Get("/{feature_uuid}/snapshot/{snapshot_uuid}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+	})
+
+	t.Run("should pass valid uuid params through", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/"+xid.New().String()+"/snapshot/"+xid.New().String(), nil)
+		r.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should accept an existing non canonical xid", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/com1l5on1e49tucv350h/snapshot/com1l5on1e49tucv350h", nil)
+		r.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should return 400 for a malformed uuid param", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/"+xid.New().String()+"/snapshot/bad-snapshot", nil)
+		r.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should not check params it was not given", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/client-feature/snapshot/"+xid.New().String(), nil)
+		r.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
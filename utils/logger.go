@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/go-chi/chi/middleware"
+)
+
+// Log is the shared handler logger. Every line carries the request id set by
+// chi's RequestID middleware so a request can be followed through the logs.
+var Log = NewLogger(os.Stdout)
+
+type Logger struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func NewLogger(out io.Writer) *Logger {
+	return &Logger{out: out}
+}
+
+func (l *Logger) Info(ctx context.Context, format string, args ...interface{}) {
+	l.write(ctx, "info", format, args...)
+}
+
+func (l *Logger) Error(ctx context.Context, format string, args ...interface{}) {
+	l.write(ctx, "error", format, args...)
+}
+
+func (l *Logger) write(ctx context.Context, level string, format string, args ...interface{}) {
+	requestId := middleware.GetReqID(ctx)
+	if requestId == "" {
+		requestId = "-"
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(l.out, "level=%s request_id=%s msg=%q\n", level, requestId, fmt.Sprintf(format, args...))
+}
+
+// RequestIDHeader returns the request id in the X-Request-Id response header
+// so clients can quote it when reporting a failed call. It has to run after
+// chi's RequestID middleware.
+func RequestIDHeader(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requestId := middleware.GetReqID(r.Context()); requestId != "" {
+			w.Header().Set(middleware.RequestIDHeader, requestId)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogRequestId(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewLogger(&out)
+
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(RequestIDHeader)
+	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+		logger.Info(r.Context(), "handling %s", "request")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("should write the request id into the log fields", func(t *testing.T) {
+		out.Reset()
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(middleware.RequestIDHeader, "trace-123")
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, "trace-123", rr.Header().Get(middleware.RequestIDHeader))
+		assert.Equal(t, "level=info request_id=trace-123 msg=\"handling request\"\n", out.String())
+	})
+
+	t.Run("should generate an id when the client sends none", func(t *testing.T) {
+		out.Reset()
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		r.ServeHTTP(rr, req)
+
+		requestId := rr.Header().Get(middleware.RequestIDHeader)
+		assert.NotEmpty(t, requestId)
+		assert.Contains(t, out.String(), "request_id="+requestId+" ")
+	})
+
+	t.Run("should mark lines logged outside a request", func(t *testing.T) {
+		out.Reset()
+		logger.Error(context.Background(), "failed: %v", "boom")
+		assert.Equal(t, "level=error request_id=- msg=\"failed: boom\"\n", out.String())
+	})
+}